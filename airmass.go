@@ -0,0 +1,35 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// AirMass calculates the relative optical air mass towards an object at
+// the altitude, in degrees: the length of its light's path through the
+// atmosphere relative to the path from the zenith, by the formula of
+// Kasten and Young (1989). It is 1 at the zenith, 2 at 30°, and about 38
+// at the horizon, which the formula still fits; below the horizon it is
+// +Inf. Multiply by the pressure over 1013.25 mb for the absolute air mass
+// at altitude.
+func AirMass(altitude float64) float64 {
+	if altitude < 0 {
+		return math.Inf(1)
+	}
+	return 1 / (math.Sin(degToRad*altitude) + 0.50572*math.Pow(altitude+6.07995, -1.6364))
+}
+
+// AirMass calculates the relative optical air mass towards the sun at t,
+// from its geometric altitude, for irradiance and ultraviolet models and
+// extinction corrections. It returns ErrSunDown if the sun's centre is
+// below the horizon.
+func (o Observer) AirMass(t time.Time) (float64, error) {
+	if err := o.validate(t); err != nil {
+		return math.NaN(), err
+	}
+	_, altitude := o.sunPosition(t)
+	if altitude < 0 {
+		return math.Inf(1), ErrSunDown
+	}
+	return AirMass(altitude), nil
+}
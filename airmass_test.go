@@ -0,0 +1,35 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAirMass(t *testing.T) {
+	for _, test := range []struct {
+		altitude, want float64
+	}{
+		{90, 0.99971},
+		{30, 1.99429},
+		{10, 5.586},
+		{0, 37.92},
+		{-1, math.Inf(1)},
+	} {
+		if got := AirMass(test.altitude); math.Abs(got-test.want) > 0.01 && !(math.IsInf(got, 1) && math.IsInf(test.want, 1)) {
+			t.Errorf("AirMass(%v): got %v, want %v", test.altitude, got, test.want)
+		}
+	}
+
+	o := NewObserver(51.4769, -0.0005)
+	noon := p("2024-06-20T12:02:00Z")
+	got, err := o.AirMass(noon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := AirMass(61.96); math.Abs(got-want) > 0.001 {
+		t.Errorf("got %v at midsummer noon, want %v", got, want)
+	}
+	if _, err := o.AirMass(p("2024-06-20T23:00:00Z")); err != ErrSunDown {
+		t.Errorf("got %v at night, want ErrSunDown", err)
+	}
+}
@@ -0,0 +1,123 @@
+package astrotime
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// Algorithm selects how an Observer calculates the position of the sun, for
+// sunrise, sunset, twilight and solar noon as well as for the sun's altitude
+// and azimuth.
+type Algorithm int
+
+const (
+	// AlgorithmNOAA is the algorithm of NOAA's solar calculator, built on
+	// the low-accuracy solar series of Meeus. It is the default. Results are
+	// good to about a minute at middle latitudes, limited mostly by the time
+	// of day the sun's position is sampled at.
+	AlgorithmNOAA Algorithm = iota
+	// AlgorithmVSOP87 takes the apparent position of the sun from the
+	// truncated VSOP87 theory of Meeus chapter 25, corrected for nutation
+	// and aberration, in Terrestrial Time, and iterates on the hour angle
+	// until the event converges. Results are good to a couple of seconds,
+	// short of the uncertainty of refraction at the horizon.
+	AlgorithmVSOP87
+)
+
+var algorithmNames = [...]string{
+	AlgorithmNOAA:   "NOAA",
+	AlgorithmVSOP87: "VSOP87",
+}
+
+func (a Algorithm) String() string {
+	if a < 0 || int(a) >= len(algorithmNames) {
+		return "Algorithm(" + strconv.Itoa(int(a)) + ")"
+	}
+	return algorithmNames[a]
+}
+
+// WithAlgorithm sets the algorithm for the sun's position, AlgorithmNOAA by
+// default. It applies to the event times and to everything taken from the
+// sun's altitude and azimuth, such as Phase, Shadow and AirMass. AlgorithmVSOP87 always works in Terrestrial Time, whatever
+// WithDeltaT says.
+func WithAlgorithm(a Algorithm) Option {
+	return func(o *Observer) {
+		o.algorithm = a
+	}
+}
+
+// sunApparent calculates the apparent geocentric right ascension and
+// declination of the sun in degrees at the Julian ephemeris date jde, by
+// the higher-accuracy method of Meeus chapter 25.
+func sunApparent(jde float64) (ra, dec float64) {
+	l, b, r := earthHeliocentric(jde)
+	t := julianCentury(jde)
+	lon, lat := l+180, -b
+
+	// Conversion to the FK5 system.
+	lp := degToRad * (lon - 1.397*t - 0.00031*t*t)
+	lon -= 0.09033 / 3600
+	lat += 0.03916 / 3600 * (math.Cos(lp) - math.Sin(lp))
+
+	dpsi, deps := nutation(t)
+	lon += dpsi - 20.4898/3600/r
+	return eclipticToEquatorial(lon, lat, eclipticMeanObliquity(t)+deps)
+}
+
+// sunEvent calculates the time on the day t, in t's location, at which the
+// hour angle of the sun's centre, in degrees west of the meridian, is the
+// one reaching the zenith angle zenith on the side: −1 for the rising sun,
+// +1 for the setting sun, or 0 for solar noon, ignoring zenith. It iterates
+// Newton-fashion on the VSOP87 position from an estimate at local noon.
+func (o Observer) sunEvent(t time.Time, latitude, longitude, zenith float64, side float64) (time.Time, error) {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	jd0 := julianDate(day)
+	phi := degToRad * latitude
+	minutes := 720 - 4*longitude
+	for range 10 {
+		jd := jd0 + minutes/1440
+		ra, dec := sunApparent(o.ttFromUT(jd))
+		d := degToRad * dec
+		var target float64
+		if side != 0 {
+			cosH := (math.Cos(degToRad*zenith) - math.Sin(phi)*math.Sin(d)) / (math.Cos(phi) * math.Cos(d))
+			switch {
+			case cosH > 1:
+				return time.Time{}, ErrAlwaysBelow
+			case cosH < -1:
+				return time.Time{}, ErrAlwaysAbove
+			}
+			target = side * radToDeg * math.Acos(cosH)
+		}
+		ha := gast(jd) + longitude - ra
+		// The sun's hour angle grows by 360° a day, or 0.25° a minute.
+		step := (math.Mod(math.Mod(target-ha, 360)+540, 360) - 180) / 0.25
+		minutes += step
+		if math.Abs(step) < 1e-4 {
+			break
+		}
+	}
+	return day.Add(time.Duration(minutes * float64(time.Minute))).In(t.Location()), nil
+}
+
+// solarNoon calculates the time of solar noon on the day t with the
+// observer's algorithm.
+func (o Observer) solarNoon(t time.Time) time.Time {
+	if o.algorithm == AlgorithmVSOP87 {
+		noon, _ := o.sunEvent(t, o.Lat, o.Lon, 0, 0)
+		return noon
+	}
+	return solarNoon(t, o.Lon)
+}
+
+// sunPosition calculates the azimuth, clockwise from north, and geometric
+// altitude of the sun in degrees at t with the observer's algorithm.
+func (o Observer) sunPosition(t time.Time) (azimuth, altitude float64) {
+	if o.algorithm == AlgorithmVSOP87 {
+		jd := julianDate(t.UTC())
+		ra, dec := sunApparent(o.ttFromUT(jd))
+		return o.fixedHorizontal(jd, ra, dec)
+	}
+	return sunPosition(t, o.Lat, o.Lon)
+}
@@ -0,0 +1,87 @@
+package astrotime
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSunApparent(t *testing.T) {
+	// Meeus example 25.b: 1992 October 13.0 TD, α = 198.378178°,
+	// δ = −7.783871° from the truncated series, within 0.2″ of the full
+	// VSOP87 theory.
+	ra, dec := sunApparent(2448908.5)
+	if math.Abs(ra-198.378178)*3600 > 0.01 || math.Abs(dec+7.783871)*3600 > 0.01 {
+		t.Errorf("got %.6f°, %.6f°, want 198.378178°, -7.783871°", ra, dec)
+	}
+}
+
+func TestAlgorithmVSOP87(t *testing.T) {
+	for n, place := range places {
+		o := NewObserver(place.lat, place.lon)
+		v := NewObserver(place.lat, place.lon, WithAlgorithm(AlgorithmVSOP87))
+		for _, d := range place.times {
+			// NOAA's algorithm is at its best for a query at 0h UT, where it
+			// samples the sun's position from the right day.
+			midnight := time.Date(d.day.Year(), d.day.Month(), d.day.Day(), 0, 0, 0, 0, time.UTC)
+			want, err := o.Sunset(midnight)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, at := range []time.Time{midnight, d.day} {
+				got, err := v.Sunset(at)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if d := got.Sub(want); d.Abs() > 2*time.Second {
+					t.Errorf("%s on %v: got sunset %s, want %s", n, at, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestAlgorithmVSOP87Polar(t *testing.T) {
+	v := NewObserver(78.22, 15.65, WithAlgorithm(AlgorithmVSOP87))
+	if _, err := v.Sunrise(p("2017-06-21T12:00:00Z")); !errors.Is(err, ErrAlwaysAbove) {
+		t.Errorf("got %v in the midnight sun, want ErrAlwaysAbove", err)
+	}
+	if _, err := v.Sunset(p("2017-12-21T12:00:00Z")); !errors.Is(err, ErrAlwaysBelow) {
+		t.Errorf("got %v in the polar night, want ErrAlwaysBelow", err)
+	}
+	noon, err := v.EventTime(p("2017-12-21T12:00:00Z"), EventSolarNoon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 62.6 minutes ahead of Greenwich, less 1.8 minutes' equation of time.
+	if want := p("2017-12-21T10:55:33Z"); noon.Sub(want).Abs() > 2*time.Second {
+		t.Errorf("got solar noon %s, want about %s", noon, want)
+	}
+}
+
+func TestAlgorithmString(t *testing.T) {
+	if got := AlgorithmVSOP87.String(); got != "VSOP87" {
+		t.Errorf("got %q, want VSOP87", got)
+	}
+	if got := Algorithm(5).String(); got != "Algorithm(5)" {
+		t.Errorf("got %q, want Algorithm(5)", got)
+	}
+}
+
+func TestAlgorithmVSOP87Position(t *testing.T) {
+	// The sun's centre stands 50′ below the horizon at sunset, the
+	// refraction and semi-diameter, when both come from the same algorithm.
+	for n, place := range places {
+		o := NewObserver(place.lat, place.lon, WithAlgorithm(AlgorithmVSOP87), WithPrecision(time.Nanosecond))
+		for _, d := range place.times {
+			set, err := o.Sunset(d.day)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, alt := o.sunPosition(set); math.Abs(alt+0.833) > 0.001 {
+				t.Errorf("%s on %v: got altitude %.4f at sunset, want -0.8330", n, d.day, alt)
+			}
+		}
+	}
+}
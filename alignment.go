@@ -0,0 +1,60 @@
+package astrotime
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Alignment is a sunrise or sunset whose direction lines up with a
+// bearing, such as that of a street.
+type Alignment struct {
+	// Time is the sunrise or sunset.
+	Time time.Time
+
+	// Azimuth is the direction of the sun then, in degrees clockwise from
+	// north, and Offset how far it is from the bearing, positive clockwise.
+	Azimuth, Offset float64
+}
+
+// Alignments finds the sunrises or sunsets, as kind is EventSunrise or
+// EventSunset, of a calendar year in the observer's time zone, or UTC if it
+// has none, whose azimuth is within tolerance degrees of the bearing, in
+// degrees clockwise from north. With the bearing of a street grid it finds
+// the days the sun rises or sets along the streets, as it does at
+// Manhattanhenge, where the cross streets run to 299°: within half a
+// degree, the sunsets of four evenings in late May and four in mid July,
+// over a level horizon. The alignments are in order, usually in runs of
+// consecutive days, twice a year for bearings the sun reaches; the closest
+// of a run has the least Offset.
+func (o Observer) Alignments(year int, kind EventKind, bearing, tolerance float64) ([]Alignment, error) {
+	azimuth := o.SunsetAzimuth
+	switch kind {
+	case EventSunrise:
+		azimuth = o.SunriseAzimuth
+	case EventSunset:
+	default:
+		return nil, fmt.Errorf("astrotime: no alignments for %v, only sunrise and sunset", kind)
+	}
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, o.zone())
+	if err := o.validate(start); err != nil {
+		return nil, err
+	}
+	var alignments []Alignment
+	for day := start; day.Year() == year; day = day.AddDate(0, 0, 1) {
+		az, err := azimuth(day)
+		if err != nil {
+			continue
+		}
+		offset := math.Mod(az-bearing+540, 360) - 180
+		if math.Abs(offset) > tolerance {
+			continue
+		}
+		t, err := o.EventTime(day, kind)
+		if err != nil {
+			continue
+		}
+		alignments = append(alignments, Alignment{Time: t, Azimuth: az, Offset: offset})
+	}
+	return alignments, nil
+}
@@ -0,0 +1,53 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAlignments(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	o := Observer{Lat: 40.758, Lon: -73.9855, Location: ny}
+	for _, test := range []struct {
+		kind    EventKind
+		bearing float64
+		want    []string
+	}{
+		{EventSunset, 299, []string{
+			"2024-05-22T20:13:18-04:00", "2024-05-23T20:14:11-04:00", "2024-05-24T20:15:03-04:00", "2024-05-25T20:15:54-04:00",
+			"2024-07-15T20:25:46-04:00", "2024-07-16T20:25:08-04:00", "2024-07-17T20:24:28-04:00", "2024-07-18T20:23:47-04:00",
+		}},
+		{EventSunrise, 119, []string{
+			"2024-01-07T07:20:10-05:00", "2024-01-08T07:20:03-05:00", "2024-01-09T07:19:54-05:00", "2024-01-10T07:19:43-05:00", "2024-01-11T07:19:30-05:00",
+			"2024-12-01T07:01:04-05:00", "2024-12-02T07:02:05-05:00", "2024-12-03T07:03:04-05:00", "2024-12-04T07:04:02-05:00", "2024-12-05T07:04:59-05:00",
+		}},
+		// The sunset never reaches due north.
+		{EventSunset, 0, nil},
+	} {
+		got, err := o.Alignments(2024, test.kind, test.bearing, 0.5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(test.want) {
+			t.Fatalf("%v at %v°: got %d alignments, want %d", test.kind, test.bearing, len(got), len(test.want))
+		}
+		for i, a := range got {
+			if !a.Time.Equal(p(test.want[i])) {
+				t.Errorf("%v at %v°: alignment %d at %s, want %s", test.kind, test.bearing, i, a.Time, test.want[i])
+			}
+			if math.Abs(a.Offset) > 0.5 || math.Abs(a.Azimuth-test.bearing-a.Offset) > 1e-9 {
+				t.Errorf("%v at %v°: alignment %d has azimuth %v, offset %v", test.kind, test.bearing, i, a.Azimuth, a.Offset)
+			}
+		}
+	}
+	if _, err := o.Alignments(2024, EventSolarNoon, 180, 1); err == nil {
+		t.Error("got no error for solar noon")
+	}
+	if _, err := o.Alignments(3001, EventSunset, 299, 1); err != ErrDateOutOfRange {
+		t.Errorf("got %v, want ErrDateOutOfRange", err)
+	}
+}
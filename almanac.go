@@ -0,0 +1,121 @@
+package astrotime
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// AlmanacEntry holds the data a nautical almanac tabulates for a body at an
+// instant, for the reduction of sextant sights. Angles are in degrees.
+type AlmanacEntry struct {
+	Time time.Time
+
+	// GHA is the Greenwich hour angle, from 0° to 360° westwards of the
+	// meridian of Greenwich, and Dec the declination, positive north. They
+	// are apparent and geocentric.
+	GHA, Dec float64
+
+	// SD is the semidiameter of the body, for sights of its upper or lower
+	// limb, and HP its horizontal parallax, for the correction of its
+	// altitude to the centre of the earth.
+	SD, HP float64
+}
+
+// sunSemidiameter and sunParallax are the semidiameter and equatorial
+// horizontal parallax of the sun, in degrees, at one astronomical unit.
+const (
+	sunSemidiameter = 959.63 / 3600
+	sunParallax     = 8.794 / 3600
+)
+
+// SunAlmanac returns the almanac data of the sun at t, to about a second of
+// arc, a tenth of the precision of the printed almanac.
+func SunAlmanac(t time.Time) AlmanacEntry {
+	jd := julianDate(t.UTC())
+	jde := ttFromUT(jd)
+	ra, dec := sunApparent(jde)
+	r := earthRadiusVector(jde)
+	return AlmanacEntry{
+		Time: t,
+		GHA:  hourAngle(gast(jd) - ra),
+		Dec:  dec,
+		SD:   sunSemidiameter / r,
+		HP:   sunParallax / r,
+	}
+}
+
+// MoonAlmanac returns the almanac data of the moon at t, to a few seconds
+// of arc for the abridged lunar theory of Meeus chapter 47.
+func MoonAlmanac(t time.Time) AlmanacEntry {
+	jd := julianDate(t.UTC())
+	ra, dec, dist := moonEquatorial(ttFromUT(jd))
+	return AlmanacEntry{
+		Time: t,
+		GHA:  hourAngle(gast(jd) - ra),
+		Dec:  dec,
+		SD:   radToDeg * math.Asin(moonRadius/dist),
+		HP:   radToDeg * math.Asin(earthRadius/dist),
+	}
+}
+
+// AriesGHA returns the Greenwich hour angle of the first point of Aries at
+// t, in degrees: the apparent sidereal time, to which the almanac adds a
+// star's sidereal hour angle, 360° less its right ascension, for the
+// star's GHA.
+func AriesGHA(t time.Time) float64 {
+	return GAST(t)
+}
+
+// hourAngle reduces an hour angle in degrees to the range [0°, 360°).
+func hourAngle(h float64) float64 {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+// Reduce reduces a sight of the body from the assumed position at the
+// latitude and longitude, east positive: it returns the computed altitude
+// Hc of the body's centre, geocentric and without refraction, and its true
+// azimuth Zn, clockwise from north, for comparison with the corrected
+// sextant altitude.
+func (e AlmanacEntry) Reduce(latitude, longitude float64) (hc, zn float64) {
+	zn, hc = equatorialToHorizontal(latitude, e.GHA+longitude, e.Dec)
+	return hc, zn
+}
+
+// String returns the entry as the almanac prints it, such as "GHA
+// 179°13.8′ Dec S23°03.5′ SD 16.3′ HP 0.1′".
+func (e AlmanacEntry) String() string {
+	return "GHA " + FormatArc(e.GHA) + " Dec " + FormatDeclination(e.Dec) +
+		" SD " + strconv.FormatFloat(60*e.SD, 'f', 1, 64) + "′" +
+		" HP " + strconv.FormatFloat(60*e.HP, 'f', 1, 64) + "′"
+}
+
+// FormatArc formats an angle in degrees and decimal minutes of arc to a
+// tenth, as in the almanac and the sight reduction tables, such as
+// "179°13.8′". Negative angles take a minus sign.
+func FormatArc(degrees float64) string {
+	sign := ""
+	if degrees < 0 {
+		sign, degrees = "-", -degrees
+	}
+	tenths := int64(math.Round(degrees * 600))
+	deg, min := tenths/600, tenths%600
+	m := strconv.FormatInt(min/10, 10) + "." + strconv.FormatInt(min%10, 10)
+	if min < 100 {
+		m = "0" + m
+	}
+	return sign + strconv.FormatInt(deg, 10) + "°" + m + "′"
+}
+
+// FormatDeclination formats a declination in degrees as the almanac does,
+// named north or south, such as "S23°03.5′".
+func FormatDeclination(degrees float64) string {
+	if degrees < 0 || degrees == 0 && math.Signbit(degrees) {
+		return "S" + FormatArc(-degrees)
+	}
+	return "N" + FormatArc(degrees)
+}
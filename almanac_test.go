@@ -0,0 +1,71 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSunAlmanac(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	e := SunAlmanac(at)
+	if got, want := e.String(), "GHA 179°13.8′ Dec S23°03.5′ SD 16.3′ HP 0.1′"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	// The GHA is the subsolar point's longitude turned west.
+	lat, lon := SubsolarPoint(at)
+	if d := math.Mod(e.GHA+lon+360, 360); d > 1e-9 && d < 360-1e-9 || math.Abs(e.Dec-lat) > 1e-9 {
+		t.Errorf("got GHA %v, Dec %v, want %v, %v", e.GHA, e.Dec, hourAngle(-lon), lat)
+	}
+}
+
+func TestMoonAlmanac(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 47.a: the moon on 1992 April
+	// 12 at 0h TD, 59s later than UT, at declination 13.768368° and
+	// parallax 0.991990°.
+	e := MoonAlmanac(time.Date(1992, 4, 11, 23, 59, 1, 0, time.UTC))
+	if math.Abs(e.Dec-13.768368) > 1e-4 {
+		t.Errorf("got Dec %.6f, want 13.768368", e.Dec)
+	}
+	if math.Abs(e.HP-0.991990) > 1e-5 {
+		t.Errorf("got HP %.6f, want 0.991990", e.HP)
+	}
+	if got, want := e.String(), "GHA 65°30.7′ Dec N13°46.1′ SD 16.2′ HP 59.5′"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestAlmanacReduce(t *testing.T) {
+	at := time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC)
+	e := SunAlmanac(at)
+	hc, zn := e.Reduce(40, -70)
+	az, alt := Observer{Lat: 40, Lon: -70}.fixedHorizontal(julianDate(at), hourAngle(GAST(at)-e.GHA), e.Dec)
+	if math.Abs(hc-alt) > 1e-9 || math.Abs(zn-az) > 1e-9 {
+		t.Errorf("got Hc %v, Zn %v, want %v, %v", hc, zn, alt, az)
+	}
+	if math.Abs(hc-29.415) > 1e-3 || math.Abs(zn-82.936) > 1e-3 {
+		t.Errorf("got Hc %.3f, Zn %.3f, want 29.415, 82.936", hc, zn)
+	}
+}
+
+func TestFormatArc(t *testing.T) {
+	tests := []struct {
+		degrees float64
+		arc     string
+		dec     string
+	}{
+		{0, "0°00.0′", "N0°00.0′"},
+		{0.99999, "1°00.0′", "N1°00.0′"},
+		{179.23, "179°13.8′", "N179°13.8′"},
+		{-5.5, "-5°30.0′", "S5°30.0′"},
+		{-23.0583, "-23°03.5′", "S23°03.5′"},
+	}
+	for _, tt := range tests {
+		if got := FormatArc(tt.degrees); got != tt.arc {
+			t.Errorf("FormatArc(%v) = %q, want %q", tt.degrees, got, tt.arc)
+		}
+		if got := FormatDeclination(tt.degrees); got != tt.dec {
+			t.Errorf("FormatDeclination(%v) = %q, want %q", tt.degrees, got, tt.dec)
+		}
+	}
+}
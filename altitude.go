@@ -0,0 +1,20 @@
+package astrotime
+
+import "time"
+
+// AltitudeRising calculates the time on the day t at which the rising sun's
+// centre crosses altitude degrees, negative below the horizon, for
+// calculations built on depression angles other than those of the
+// twilights, such as the dawn of a religious calendar. Like the twilights
+// it ignores refraction and the observer's elevation, and it is not
+// cached. It returns ErrAlwaysAbove or ErrAlwaysBelow if the sun does not
+// cross the altitude that day.
+func (o Observer) AltitudeRising(t time.Time, altitude float64) (time.Time, error) {
+	return o.twilight(t, o.sunrise, 90-altitude)
+}
+
+// AltitudeSetting calculates the time on the day t at which the setting
+// sun's centre crosses altitude degrees, as AltitudeRising.
+func (o Observer) AltitudeSetting(t time.Time, altitude float64) (time.Time, error) {
+	return o.twilight(t, o.sunset, 90-altitude)
+}
@@ -0,0 +1,56 @@
+package astrotime
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAltitudeRisingSetting(t *testing.T) {
+	o := Observer{Lat: 51.4769, Lon: -0.0005}
+	day := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+
+	// At the twilight depressions they agree with the twilights.
+	for _, tt := range []struct {
+		kind     EventKind
+		altitude float64
+		rising   bool
+	}{
+		{EventCivilDawn, -6, true},
+		{EventNauticalDusk, -12, false},
+		{EventAstronomicalDawn, -18, true},
+	} {
+		want, err := o.EventTime(day, tt.kind)
+		if err != nil {
+			t.Fatal(err)
+		}
+		calc := o.AltitudeSetting
+		if tt.rising {
+			calc = o.AltitudeRising
+		}
+		if got, err := calc(day, tt.altitude); err != nil || !got.Equal(want) {
+			t.Errorf("%v: got %v, %v, want %v", tt.kind, got, err, want)
+		}
+	}
+
+	// The sun stands at the altitude at the times found.
+	for _, altitude := range []float64{-16, 10, 30} {
+		for _, calc := range []func(time.Time, float64) (time.Time, error){o.AltitudeRising, o.AltitudeSetting} {
+			s, err := calc(day, altitude)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, alt := sunPosition(s, o.Lat, o.Lon); math.Abs(alt-altitude) > 0.02 {
+				t.Errorf("altitude %v: sun at %.3f° at %v", altitude, alt, s)
+			}
+		}
+	}
+
+	if _, err := o.AltitudeRising(day, 40); !errors.Is(err, ErrAlwaysBelow) {
+		t.Errorf("got %v, want ErrAlwaysBelow for 40° at the equinox", err)
+	}
+	if _, err := (Observer{Lat: 91}).AltitudeSetting(day, 0); err == nil {
+		t.Error("invalid latitude accepted")
+	}
+}
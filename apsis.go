@@ -0,0 +1,128 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// moonRadius is the mean radius of the moon in kilometers.
+const moonRadius = 1737.4
+
+// supermoonFraction is how close to perigee, as a fraction of the distance
+// between perigee and apogee, a full moon must be to count as a supermoon.
+const supermoonFraction = 0.1
+
+// MoonDistance calculates the distance between the centres of the earth and
+// the moon at t, in kilometers.
+func MoonDistance(t time.Time) float64 {
+	_, _, dist := moonPosition(julianCentury(ttFromUT(julianDate(t.UTC()))))
+	return dist
+}
+
+// MoonAngularDiameter calculates the apparent diameter of the moon's disc at
+// t, in degrees, as seen from the centre of the earth. It ranges from about
+// 0.49° at apogee to 0.56° at perigee.
+func MoonAngularDiameter(t time.Time) float64 {
+	return 2 * radToDeg * math.Asin(moonRadius/MoonDistance(t))
+}
+
+// meanApsis returns the Julian ephemeris date of the mean perigee, for
+// integer k, or mean apogee, for k half-way between integers, counted from
+// the perigee of 1999 December 22.
+func meanApsis(k float64) float64 {
+	t := k / 1325.55
+	return 2451534.6698 + 27.55454989*k + t*t*(-0.0006691+t*(-0.000001098+t*0.0000000052))
+}
+
+// moonApsis finds the instant of the perigee, or apogee if apogee is set,
+// of the orbit numbered k.
+func moonApsis(k float64, apogee bool) time.Time {
+	// Distance to minimize: the sign flips to find a maximum.
+	sign := 1.0
+	if apogee {
+		sign = -1
+		k += 0.5
+	}
+	dist := func(jde float64) float64 {
+		_, _, d := moonPosition(julianCentury(jde))
+		return sign * d
+	}
+
+	// The true apsis is within a few days of the mean one; scan for the
+	// bracketing samples, then refine with a golden-section search.
+	const step = 0.125
+	mean := meanApsis(k)
+	best, bestDist := mean, dist(mean)
+	for jde := mean - 4; jde <= mean+4; jde += step {
+		if d := dist(jde); d < bestDist {
+			best, bestDist = jde, d
+		}
+	}
+	const phi = 0.6180339887498949
+	lo, hi := best-step, best+step
+	for hi-lo > 1e-5 {
+		a := hi - phi*(hi-lo)
+		b := lo + phi*(hi-lo)
+		if dist(a) < dist(b) {
+			hi = b
+		} else {
+			lo = a
+		}
+	}
+	return timeFromJulianDate(utFromTT((lo + hi) / 2))
+}
+
+// nextMoonApsis returns the first perigee or apogee after after.
+func nextMoonApsis(after time.Time, apogee bool) (time.Time, error) {
+	if y := after.Year(); y < MinYear || y > MaxYear {
+		return time.Time{}, ErrDateOutOfRange
+	}
+	k := math.Floor((decimalYear(julianDate(after.UTC())) - 1999.97) * 13.2555)
+	for i := -1.0; ; i++ {
+		if t := moonApsis(k+i, apogee); t.After(after) {
+			return t.In(after.Location()), nil
+		}
+	}
+}
+
+// NextMoonPerigee returns the instant after after when the moon is next
+// closest to the earth. The times are good to within about an hour.
+func NextMoonPerigee(after time.Time) (time.Time, error) {
+	return nextMoonApsis(after, false)
+}
+
+// NextMoonApogee returns the instant after after when the moon is next
+// farthest from the earth.
+func NextMoonApogee(after time.Time) (time.Time, error) {
+	return nextMoonApsis(after, true)
+}
+
+// IsSupermoon reports whether t is the instant of a supermoon: a full moon,
+// as returned by NextMoonPhase, that falls within 10% of the closest
+// approach of its orbit, measured between the nearest perigee and apogee.
+// It reports false for any other phase of the moon.
+func IsSupermoon(t time.Time) bool {
+	if MoonPhase(t).Name != FullMoon {
+		return false
+	}
+	if y := t.Year(); y < MinYear || y > MaxYear {
+		return false
+	}
+	k := math.Round((decimalYear(julianDate(t.UTC())) - 1999.97) * 13.2555)
+	perigee := nearestApsis(t, k, false)
+	apogee := nearestApsis(t, k, true)
+	near, far := MoonDistance(perigee), MoonDistance(apogee)
+	return MoonDistance(t) <= near+supermoonFraction*(far-near)
+}
+
+// nearestApsis returns the perigee or apogee closest to t, starting from
+// the orbit numbered about k.
+func nearestApsis(t time.Time, k float64, apogee bool) time.Time {
+	best := moonApsis(k, apogee)
+	for _, i := range []float64{-1, 1} {
+		if a := moonApsis(k+i, apogee); a.Sub(t).Abs() < best.Sub(t).Abs() {
+			best = a
+		}
+	}
+	return best
+}
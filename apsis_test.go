@@ -0,0 +1,70 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMoonApsides(t *testing.T) {
+	after := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		next func(time.Time) (time.Time, error)
+		want time.Time
+		dist float64
+	}{
+		{"apogee", NextMoonApogee, time.Date(2024, 10, 2, 19, 40, 0, 0, time.UTC), 406516},
+		{"perigee", NextMoonPerigee, time.Date(2024, 10, 17, 0, 51, 0, 0, time.UTC), 357173},
+	}
+	for _, tt := range tests {
+		got, err := tt.next(after)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := got.Sub(tt.want); d < -time.Hour || d > time.Hour {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+		if d := MoonDistance(got); math.Abs(d-tt.dist) > 10 {
+			t.Errorf("%s: got distance %.0f km, want %.0f", tt.name, d, tt.dist)
+		}
+	}
+}
+
+func TestMoonAngularDiameter(t *testing.T) {
+	// 1992 April 12, 0h TD (Meeus example 47.a), about 59s earlier in UT.
+	at := time.Date(1992, 4, 11, 23, 59, 1, 0, time.UTC)
+	if got := MoonAngularDiameter(at); math.Abs(got-0.5404) > 1e-3 {
+		t.Errorf("got %.4f, want 0.5404", got)
+	}
+}
+
+func TestIsSupermoon(t *testing.T) {
+	// The four supermoons of 2024 were the full moons of August to
+	// November.
+	var got []time.Month
+	full := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for full.Year() == 2024 {
+		if IsSupermoon(full) {
+			got = append(got, full.Month())
+		}
+		var err error
+		if full, err = NextMoonPhase(full, FullMoon); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := []time.Month{time.August, time.September, time.October, time.November}
+	if len(got) != len(want) {
+		t.Fatalf("got supermoons in %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got supermoons in %v, want %v", got, want)
+			break
+		}
+	}
+
+	if IsSupermoon(time.Date(2024, 10, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Error("first quarter moon reported as a supermoon")
+	}
+}
@@ -6,6 +6,10 @@
 // Ported to C++ by Pete Gray (petegray@ieee.org), July 2006
 // Released as Open Source and can be used in any way, as long as the
 // above description remains in place.
+//
+// Latitudes are in degrees positive north, and longitudes in degrees
+// positive east of Greenwich, as in ISO 6709 and GPS. NOAA's published
+// algorithms use west-positive longitudes; see LongitudeFromWest.
 package astrotime
 
 import (
@@ -20,6 +24,15 @@ const (
 	gradToDeg = math.Pi / 200
 
 	oneDay = time.Hour * 24
+
+	// solarSemidiameter is the apparent radius of the sun in degrees.
+	solarSemidiameter = 16.0 / 60
+	// standardRefraction is the atmospheric refraction at the horizon in
+	// degrees.
+	standardRefraction = 34.0 / 60
+	// zenithOfficial is the zenith angle of the sun's centre at the
+	// official sunrise and sunset (nominally 90.833°).
+	zenithOfficial = 90.833
 )
 
 // julianDate converts a Time to a Julian date.
@@ -54,9 +67,11 @@ func julianDateFromJulianCentury(t float64) float64 {
 	return t*36525.0 + 2451545.0
 }
 
-// solarGeoMeanLon calculates the Geometric Mean Longitude of the Sun.
+// solarGeoMeanLon calculates the Geometric Mean Longitude of the Sun. The
+// terms beyond NOAA's quadratic, from the VSOP87 series of Meeus (28.2),
+// matter only centuries from J2000.
 func solarGeoMeanLon(t float64) float64 {
-	lon := math.Mod(280.46646+t*(36000.76983+0.0003032*t), 360)
+	lon := math.Mod(280.46646+t*(36000.76983+t*(0.0003032+t*(1/49931000.0-t*(1/153000000.0+t/2e11)))), 360)
 	if lon > 0.0 {
 		return lon
 	}
@@ -64,9 +79,12 @@ func solarGeoMeanLon(t float64) float64 {
 	return lon + 360
 }
 
-// eclipticMeanObliquity calculates the mean obliquity of the ecliptic.
+// eclipticMeanObliquity calculates the mean obliquity of the ecliptic, by
+// Laskar's series (Meeus 22.3), which holds to 0.01″ over a thousand years
+// and a few seconds of arc at ±10,000 years.
 func eclipticMeanObliquity(t float64) float64 {
-	seconds := 21.448 - t*(46.8150+t*(0.00059-t*(0.001813)))
+	u := t / 100
+	seconds := 21.448 + u*(-4680.93+u*(-1.55+u*(1999.25+u*(-51.38+u*(-249.67+u*(-39.05+u*(7.12+u*(27.87+u*(5.79+u*2.45)))))))))
 	return 23.0 + (26.0+(seconds/60.0))/60.0
 }
 
@@ -82,9 +100,10 @@ func earthOrbitEccentricity(t float64) float64 {
 	return 0.016708634 - t*(0.000042037+0.0000001267*t)
 }
 
-// meanSolarAnomaly calculates the Geometric Mean Anomaly of the Sun.
+// meanSolarAnomaly calculates the Geometric Mean Anomaly of the Sun, with
+// Chapront's cubic term.
 func meanSolarAnomaly(t float64) float64 {
-	return 357.52911 + t*(35999.05029-0.0001537*t)
+	return 357.52911 + t*(35999.05029+t*(-0.0001537+t/24490000))
 }
 
 // equationOfTime calculates the difference between true solar time and mean solar time.
@@ -108,6 +127,15 @@ func equationOfTime(t float64) float64 {
 	return radToDeg * Etime * 4.0
 }
 
+// EquationOfTime calculates the equation of time at t: how far apparent
+// solar time, as shown by a sundial, runs ahead of mean solar time. It
+// ranges from about −14 minutes in mid-February to +16 minutes in early
+// November.
+func EquationOfTime(t time.Time) time.Duration {
+	m := equationOfTime(julianCentury(julianDate(t.UTC())))
+	return time.Duration(m * float64(time.Minute))
+}
+
 // solarEqOfCenter calculates the equation of center for the sun.
 func solarEqOfCenter(t float64) float64 {
 	m := meanSolarAnomaly(t)
@@ -140,11 +168,12 @@ func solarDeclination(t float64) float64 {
 	return radToDeg * math.Asin(sint)
 }
 
-// hourAngleSunrise calculates the hour angle of the sun at sunrise for the latitude.
-func hourAngleSunrise(lat, solarDec float64) float64 {
+// hourAngleSunrise calculates the hour angle of the sun at sunrise for the latitude,
+// when the sun's centre is at the zenith angle zenith.
+func hourAngleSunrise(lat, solarDec, zenith float64) float64 {
 	latRad := degToRad * lat
 	sdRad := degToRad * solarDec
-	return -math.Acos(math.Cos(degToRad*90.833)/(math.Cos(latRad)*math.Cos(sdRad)) - math.Tan(latRad)*math.Tan(sdRad))
+	return -math.Acos(math.Cos(degToRad*zenith)/(math.Cos(latRad)*math.Cos(sdRad)) - math.Tan(latRad)*math.Tan(sdRad))
 }
 
 // solNoonUTC calculates the Universal Coordinated Time (UTC) of solar noon for the
@@ -159,8 +188,16 @@ func solNoonUTC(t, longitude float64) float64 {
 	return 720 - (longitude * 4) - eqTime
 }
 
-// sunriseUTC calculates the UTC sunrise for the given day at the given location.
-func sunriseUTC(jd, latitude, longitude float64) float64 {
+// noonTerms holds the sun's position at solar noon on a day at a
+// longitude, from which the first pass of the sunrise and sunset
+// calculations starts. It does not depend on latitude.
+type noonTerms struct {
+	t, eqTime, solarDec float64
+}
+
+// solarNoonTerms calculates the noonTerms for the Julian date jd and
+// longitude.
+func solarNoonTerms(jd, longitude float64) noonTerms {
 	t := julianCentury(jd)
 
 	// *** Find the time of solar noon at the location, and use
@@ -169,106 +206,177 @@ func sunriseUTC(jd, latitude, longitude float64) float64 {
 
 	noonmin := solNoonUTC(t, longitude)
 	tnoon := julianCentury(jd + noonmin/1440.0)
+	return noonTerms{t: t, eqTime: equationOfTime(tnoon), solarDec: solarDeclination(tnoon)}
+}
 
+// refinement limits the passes of the sunrise and sunset calculations,
+// each taking the sun's position at the time the one before found.
+type refinement struct {
+	// passes is the most passes to make, the first from the sun's
+	// position at solar noon.
+	passes int
+	// tolerance, in minutes, ends the passes early once two agree within
+	// it.
+	tolerance float64
+}
+
+// defaultRefinement is the two passes of NOAA's calculator.
+var defaultRefinement = refinement{passes: 2}
+
+// sunriseUTC calculates the UTC sunrise for the given day at the given location,
+// refined by r.
+func sunriseUTC(jd, latitude, longitude, zenith float64, r refinement) float64 {
+	return sunriseFromNoon(solarNoonTerms(jd, longitude), latitude, longitude, zenith, r)
+}
+
+// sunriseFromNoon calculates the UTC sunrise, in minutes, starting from the
+// noon terms n for the longitude.
+func sunriseFromNoon(n noonTerms, latitude, longitude, zenith float64, r refinement) float64 {
 	// *** First pass to approximate sunrise (using solar noon)
 
-	eqTime := equationOfTime(tnoon)
-	solarDec := solarDeclination(tnoon)
-	hourAngle := hourAngleSunrise(latitude, solarDec)
+	hourAngle := hourAngleSunrise(latitude, n.solarDec, zenith)
 
 	delta := radToDeg*hourAngle - longitude
 	timeDiff := 4 * delta
-	timeUTC := 720 + timeDiff - eqTime
-
-	// *** Second pass includes fractional jday in gamma calc
-
-	newt := julianCentury(julianDateFromJulianCentury(t) + timeUTC/1440.0)
-	eqTime = equationOfTime(newt)
-	solarDec = solarDeclination(newt)
-	hourAngle = hourAngleSunrise(latitude, solarDec)
-	delta = radToDeg*hourAngle - longitude
-	timeDiff = 4 * delta
-	timeUTC = 720 + timeDiff - eqTime
+	timeUTC := 720 + timeDiff - n.eqTime
+
+	// *** Later passes include fractional jday in gamma calc
+
+	for i := 1; i < r.passes && !math.IsNaN(timeUTC); i++ {
+		newt := julianCentury(julianDateFromJulianCentury(n.t) + timeUTC/1440.0)
+		eqTime := equationOfTime(newt)
+		solarDec := solarDeclination(newt)
+		hourAngle = hourAngleSunrise(latitude, solarDec, zenith)
+		delta = radToDeg*hourAngle - longitude
+		timeDiff = 4 * delta
+		prev := timeUTC
+		timeUTC = 720 + timeDiff - eqTime
+		if math.Abs(timeUTC-prev) < r.tolerance {
+			break
+		}
+	}
 	return timeUTC
 }
 
-// Sunrise calculates the sunrise, in local time, on the day t at the
-// location specified in longitude and latitude.
-func Sunrise(t time.Time, latitude, longitude float64) time.Time {
-	jd := julianDate(t)
-	sr := time.Duration(math.Floor(sunriseUTC(jd, latitude, longitude)*60) * 1e9)
-	loc, _ := time.LoadLocation("UTC")
-	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc).Add(sr).In(t.Location())
+// sunrise calculates the time, in local time, on the day t at which the
+// rising sun reaches the zenith angle zenith.
+func sunrise(t time.Time, latitude, longitude, zenith float64) (time.Time, error) {
+	return sunriseAt(t, julianDate(t), defaultRefinement, latitude, longitude, zenith)
+}
+
+// sunriseAt is sunrise with the sun's position taken at the Julian date jd,
+// refined by r.
+func sunriseAt(t time.Time, jd float64, r refinement, latitude, longitude, zenith float64) (time.Time, error) {
+	m := sunriseUTC(jd, latitude, longitude, zenith, r)
+	if math.IsNaN(m) {
+		return time.Time{}, noEventError(jd, latitude, longitude, zenith)
+	}
+	sr := time.Duration(m * float64(time.Minute))
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Add(sr).In(t.Location()), nil
 }
 
-// hourAngleSunset calculates the hour angle of the sun at sunset for the latitude.
-func hourAngleSunset(lat, solarDec float64) float64 {
+// hourAngleSunset calculates the hour angle of the sun at sunset for the latitude,
+// when the sun's centre is at the zenith angle zenith.
+func hourAngleSunset(lat, solarDec, zenith float64) float64 {
 	latRad := degToRad * lat
 	sdRad := degToRad * solarDec
 
-	HA := (math.Acos(math.Cos(degToRad*90.833)/(math.Cos(latRad)*math.Cos(sdRad)) - math.Tan(latRad)*math.Tan(sdRad)))
+	HA := (math.Acos(math.Cos(degToRad*zenith)/(math.Cos(latRad)*math.Cos(sdRad)) - math.Tan(latRad)*math.Tan(sdRad)))
 
 	return -HA // in radians
 }
 
 // sunsetUTC calculates the Universal Coordinated Time (UTC) of sunset
-// for the given day at the given location on earth.
-func sunsetUTC(jd, latitude, longitude float64) float64 {
-	t := julianCentury(jd)
-
-	// *** Find the time of solar noon at the location, and use
-	//     that declination. This is better than start of the
-	//     Julian day
-
-	noonmin := solNoonUTC(t, longitude)
-	tnoon := julianCentury(jd + noonmin/1440.0)
+// for the given day at the given location on earth, refined by r.
+func sunsetUTC(jd, latitude, longitude, zenith float64, r refinement) float64 {
+	return sunsetFromNoon(solarNoonTerms(jd, longitude), latitude, longitude, zenith, r)
+}
 
+// sunsetFromNoon calculates the UTC sunset, in minutes, starting from the
+// noon terms n for the longitude.
+func sunsetFromNoon(n noonTerms, latitude, longitude, zenith float64, r refinement) float64 {
 	// First calculates sunrise and approx length of day
 
-	eqTime := equationOfTime(tnoon)
-	solarDec := solarDeclination(tnoon)
-	hourAngle := hourAngleSunset(latitude, solarDec)
+	hourAngle := hourAngleSunset(latitude, n.solarDec, zenith)
 
 	delta := -longitude - radToDeg*hourAngle
 	timeDiff := 4 * delta
-	timeUTC := 720 + timeDiff - eqTime
+	timeUTC := 720 + timeDiff - n.eqTime
+
+	// later passes include fractional day in gamma calc
+
+	for i := 1; i < r.passes && !math.IsNaN(timeUTC); i++ {
+		newt := julianCentury(julianDateFromJulianCentury(n.t) + timeUTC/1440.0)
+		eqTime := equationOfTime(newt)
+		solarDec := solarDeclination(newt)
+		hourAngle = hourAngleSunset(latitude, solarDec, zenith)
+
+		delta = -longitude - radToDeg*hourAngle
+		timeDiff = 4 * delta
+		prev := timeUTC
+		timeUTC = 720 + timeDiff - eqTime
+		if math.Abs(timeUTC-prev) < r.tolerance {
+			break
+		}
+	}
+	return timeUTC
+}
 
-	// first pass used to include fractional day in gamma calc
+// sunset calculates the time, in local time, on the day t at which the
+// setting sun reaches the zenith angle zenith.
+func sunset(t time.Time, latitude, longitude, zenith float64) (time.Time, error) {
+	return sunsetAt(t, julianDate(t), defaultRefinement, latitude, longitude, zenith)
+}
 
-	newt := julianCentury(julianDateFromJulianCentury(t) + timeUTC/1440.0)
-	eqTime = equationOfTime(newt)
-	solarDec = solarDeclination(newt)
-	hourAngle = hourAngleSunset(latitude, solarDec)
+// sunsetAt is sunset with the sun's position taken at the Julian date jd,
+// refined by r.
+func sunsetAt(t time.Time, jd float64, r refinement, latitude, longitude, zenith float64) (time.Time, error) {
+	m := sunsetUTC(jd, latitude, longitude, zenith, r)
+	if math.IsNaN(m) {
+		return time.Time{}, noEventError(jd, latitude, longitude, zenith)
+	}
+	ss := time.Duration(m * float64(time.Minute))
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Add(ss).In(t.Location()), nil
+}
+
+// noEventError reports whether the sun stays above or below the zenith angle
+// zenith all day, judged by its height at solar noon.
+func noEventError(jd, latitude, longitude, zenith float64) error {
+	return noEventFromNoon(solarNoonTerms(jd, longitude), latitude, zenith)
+}
+
+// noEventFromNoon is noEventError from the noon terms n.
+func noEventFromNoon(n noonTerms, latitude, zenith float64) error {
+	if math.Abs(latitude-n.solarDec) > zenith {
+		return ErrAlwaysBelow
+	}
+	return ErrAlwaysAbove
+}
 
-	delta = -longitude - radToDeg*hourAngle
-	timeDiff = 4 * delta
-	return 720 + timeDiff - eqTime
+// Sunrise calculates the sunrise, in local time, on the day t at the
+// location specified in longitude and latitude. It returns the zero Time if
+// the sun does not rise that day; use Observer.Sunrise to find out why.
+func Sunrise(t time.Time, latitude, longitude float64) time.Time {
+	s, _ := Observer{Lat: latitude, Lon: longitude}.Sunrise(t)
+	return s
 }
 
 // Sunset calculates the sunset, in local time, on the day t at the
-// location specified in longitude and latitude.
+// location specified in longitude and latitude. It returns the zero Time if
+// the sun does not set that day; use Observer.Sunset to find out why.
 func Sunset(t time.Time, latitude, longitude float64) time.Time {
-	jd := julianDate(t)
-	ss := time.Duration(math.Floor(sunsetUTC(jd, latitude, longitude)*60) * 1e9)
-	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Add(ss).In(t.Location())
+	s, _ := Observer{Lat: latitude, Lon: longitude}.Sunset(t)
+	return s
 }
 
 // NextSunrise returns date/time of the next sunrise after after
 func NextSunrise(after time.Time, latitude, longitude float64) time.Time {
-	s := Sunrise(after, latitude, longitude)
-	if after.Before(s) {
-		return s
-	}
-
-	return Sunrise(after.Add(oneDay), latitude, longitude)
+	s, _ := Observer{Lat: latitude, Lon: longitude}.NextSunrise(after)
+	return s
 }
 
 // NextSunset returns date/time of the next sunset after after
 func NextSunset(after time.Time, latitude, longitude float64) time.Time {
-	s := Sunset(after, latitude, longitude)
-	if after.Before(s) {
-		return s
-	}
-
-	return Sunset(after.Add(oneDay), latitude, longitude)
+	s, _ := Observer{Lat: latitude, Lon: longitude}.NextSunset(after)
+	return s
 }
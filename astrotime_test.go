@@ -99,3 +99,56 @@ func TestSunset(t *testing.T) {
 		}
 	}
 }
+
+func TestEquationOfTime(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		want time.Duration
+	}{
+		{time.Date(2024, 2, 11, 12, 0, 0, 0, time.UTC), -(14*time.Minute + 14*time.Second)},
+		{time.Date(2024, 4, 15, 12, 0, 0, 0, time.UTC), 0},
+		{time.Date(2024, 7, 26, 12, 0, 0, 0, time.UTC), -(6*time.Minute + 32*time.Second)},
+		{time.Date(2024, 11, 3, 12, 0, 0, 0, time.UTC), 16*time.Minute + 26*time.Second},
+	}
+	for _, tt := range tests {
+		got := EquationOfTime(tt.day)
+		if d := got - tt.want; d < -20*time.Second || d > 20*time.Second {
+			t.Errorf("EquationOfTime(%v) = %v, want %v", tt.day, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkSunrise(b *testing.B) {
+	day := time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Sunrise(day, 51.5, -0.1)
+	}
+}
+
+func BenchmarkSunset(b *testing.B) {
+	melbourne, _ := time.LoadLocation("Australia/Melbourne")
+	day := time.Date(2024, 6, 20, 0, 0, 0, 0, melbourne)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Sunset(day, -37.8, 145.0)
+	}
+}
+
+func TestSunriseAllocs(t *testing.T) {
+	melbourne, _ := time.LoadLocation("Australia/Melbourne")
+	day := time.Date(2024, 6, 20, 0, 0, 0, 0, melbourne)
+	o := NewObserver(-37.8, 145.0, WithElevation(100), WithDeltaT(true))
+	polar := Observer{Lat: 89, Lon: 0}
+	for name, f := range map[string]func(){
+		"Sunrise":       func() { Sunrise(day, -37.8, 145.0) },
+		"Sunset":        func() { Sunset(day, -37.8, 145.0) },
+		"EventTime":     func() { o.EventTime(day, EventCivilDusk) },
+		"polar":         func() { polar.Sunrise(day) },
+		"AlgorithmVSOP": func() { Observer{Lat: 51.5, algorithm: AlgorithmVSOP87}.Sunrise(day) },
+	} {
+		if n := testing.AllocsPerRun(100, f); n != 0 {
+			t.Errorf("%s allocates %v times, want 0", name, n)
+		}
+	}
+}
@@ -0,0 +1,1102 @@
+// Protocol buffer definitions of a service answering queries for the
+// events of the sun and moon, built on github.com/dntj/astrotime.
+//
+// Times are google.protobuf.Timestamp, instants in UTC; each response names
+// the time zone its dates were read in so that clients can show local
+// times. Dates are strings in the form 2006-01-02.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: astrotime.proto
+
+package astrotimepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventKind identifies a daily solar event, as astrotime.EventKind.
+type EventKind int32
+
+const (
+	EventKind_EVENT_KIND_UNSPECIFIED       EventKind = 0
+	EventKind_EVENT_KIND_ASTRONOMICAL_DAWN EventKind = 1
+	EventKind_EVENT_KIND_NAUTICAL_DAWN     EventKind = 2
+	EventKind_EVENT_KIND_CIVIL_DAWN        EventKind = 3
+	EventKind_EVENT_KIND_SUNRISE           EventKind = 4
+	EventKind_EVENT_KIND_SOLAR_NOON        EventKind = 5
+	EventKind_EVENT_KIND_SUNSET            EventKind = 6
+	EventKind_EVENT_KIND_CIVIL_DUSK        EventKind = 7
+	EventKind_EVENT_KIND_NAUTICAL_DUSK     EventKind = 8
+	EventKind_EVENT_KIND_ASTRONOMICAL_DUSK EventKind = 9
+)
+
+// Enum value maps for EventKind.
+var (
+	EventKind_name = map[int32]string{
+		0: "EVENT_KIND_UNSPECIFIED",
+		1: "EVENT_KIND_ASTRONOMICAL_DAWN",
+		2: "EVENT_KIND_NAUTICAL_DAWN",
+		3: "EVENT_KIND_CIVIL_DAWN",
+		4: "EVENT_KIND_SUNRISE",
+		5: "EVENT_KIND_SOLAR_NOON",
+		6: "EVENT_KIND_SUNSET",
+		7: "EVENT_KIND_CIVIL_DUSK",
+		8: "EVENT_KIND_NAUTICAL_DUSK",
+		9: "EVENT_KIND_ASTRONOMICAL_DUSK",
+	}
+	EventKind_value = map[string]int32{
+		"EVENT_KIND_UNSPECIFIED":       0,
+		"EVENT_KIND_ASTRONOMICAL_DAWN": 1,
+		"EVENT_KIND_NAUTICAL_DAWN":     2,
+		"EVENT_KIND_CIVIL_DAWN":        3,
+		"EVENT_KIND_SUNRISE":           4,
+		"EVENT_KIND_SOLAR_NOON":        5,
+		"EVENT_KIND_SUNSET":            6,
+		"EVENT_KIND_CIVIL_DUSK":        7,
+		"EVENT_KIND_NAUTICAL_DUSK":     8,
+		"EVENT_KIND_ASTRONOMICAL_DUSK": 9,
+	}
+)
+
+func (x EventKind) Enum() *EventKind {
+	p := new(EventKind)
+	*p = x
+	return p
+}
+
+func (x EventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_astrotime_proto_enumTypes[0].Descriptor()
+}
+
+func (EventKind) Type() protoreflect.EnumType {
+	return &file_astrotime_proto_enumTypes[0]
+}
+
+func (x EventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventKind.Descriptor instead.
+func (EventKind) EnumDescriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{0}
+}
+
+// NoEvent says why an event does not happen on a day.
+type NoEvent int32
+
+const (
+	// The event happens.
+	NoEvent_NO_EVENT_UNSPECIFIED NoEvent = 0
+	// The body stays above the altitude of the event all day, as
+	// astrotime.ErrAlwaysAbove.
+	NoEvent_NO_EVENT_ALWAYS_ABOVE NoEvent = 1
+	// The body stays below the altitude of the event all day, as
+	// astrotime.ErrAlwaysBelow.
+	NoEvent_NO_EVENT_ALWAYS_BELOW NoEvent = 2
+	// The body crosses the altitude, but not within the day, as
+	// astrotime.ErrNoEvent.
+	NoEvent_NO_EVENT_NOT_TODAY NoEvent = 3
+)
+
+// Enum value maps for NoEvent.
+var (
+	NoEvent_name = map[int32]string{
+		0: "NO_EVENT_UNSPECIFIED",
+		1: "NO_EVENT_ALWAYS_ABOVE",
+		2: "NO_EVENT_ALWAYS_BELOW",
+		3: "NO_EVENT_NOT_TODAY",
+	}
+	NoEvent_value = map[string]int32{
+		"NO_EVENT_UNSPECIFIED":  0,
+		"NO_EVENT_ALWAYS_ABOVE": 1,
+		"NO_EVENT_ALWAYS_BELOW": 2,
+		"NO_EVENT_NOT_TODAY":    3,
+	}
+)
+
+func (x NoEvent) Enum() *NoEvent {
+	p := new(NoEvent)
+	*p = x
+	return p
+}
+
+func (x NoEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NoEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_astrotime_proto_enumTypes[1].Descriptor()
+}
+
+func (NoEvent) Type() protoreflect.EnumType {
+	return &file_astrotime_proto_enumTypes[1]
+}
+
+func (x NoEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NoEvent.Descriptor instead.
+func (NoEvent) EnumDescriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{1}
+}
+
+// MoonPhaseName names the phase of the moon, as astrotime.MoonPhaseName.
+type MoonPhaseName int32
+
+const (
+	MoonPhaseName_MOON_PHASE_NAME_UNSPECIFIED     MoonPhaseName = 0
+	MoonPhaseName_MOON_PHASE_NAME_NEW_MOON        MoonPhaseName = 1
+	MoonPhaseName_MOON_PHASE_NAME_WAXING_CRESCENT MoonPhaseName = 2
+	MoonPhaseName_MOON_PHASE_NAME_FIRST_QUARTER   MoonPhaseName = 3
+	MoonPhaseName_MOON_PHASE_NAME_WAXING_GIBBOUS  MoonPhaseName = 4
+	MoonPhaseName_MOON_PHASE_NAME_FULL_MOON       MoonPhaseName = 5
+	MoonPhaseName_MOON_PHASE_NAME_WANING_GIBBOUS  MoonPhaseName = 6
+	MoonPhaseName_MOON_PHASE_NAME_LAST_QUARTER    MoonPhaseName = 7
+	MoonPhaseName_MOON_PHASE_NAME_WANING_CRESCENT MoonPhaseName = 8
+)
+
+// Enum value maps for MoonPhaseName.
+var (
+	MoonPhaseName_name = map[int32]string{
+		0: "MOON_PHASE_NAME_UNSPECIFIED",
+		1: "MOON_PHASE_NAME_NEW_MOON",
+		2: "MOON_PHASE_NAME_WAXING_CRESCENT",
+		3: "MOON_PHASE_NAME_FIRST_QUARTER",
+		4: "MOON_PHASE_NAME_WAXING_GIBBOUS",
+		5: "MOON_PHASE_NAME_FULL_MOON",
+		6: "MOON_PHASE_NAME_WANING_GIBBOUS",
+		7: "MOON_PHASE_NAME_LAST_QUARTER",
+		8: "MOON_PHASE_NAME_WANING_CRESCENT",
+	}
+	MoonPhaseName_value = map[string]int32{
+		"MOON_PHASE_NAME_UNSPECIFIED":     0,
+		"MOON_PHASE_NAME_NEW_MOON":        1,
+		"MOON_PHASE_NAME_WAXING_CRESCENT": 2,
+		"MOON_PHASE_NAME_FIRST_QUARTER":   3,
+		"MOON_PHASE_NAME_WAXING_GIBBOUS":  4,
+		"MOON_PHASE_NAME_FULL_MOON":       5,
+		"MOON_PHASE_NAME_WANING_GIBBOUS":  6,
+		"MOON_PHASE_NAME_LAST_QUARTER":    7,
+		"MOON_PHASE_NAME_WANING_CRESCENT": 8,
+	}
+)
+
+func (x MoonPhaseName) Enum() *MoonPhaseName {
+	p := new(MoonPhaseName)
+	*p = x
+	return p
+}
+
+func (x MoonPhaseName) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MoonPhaseName) Descriptor() protoreflect.EnumDescriptor {
+	return file_astrotime_proto_enumTypes[2].Descriptor()
+}
+
+func (MoonPhaseName) Type() protoreflect.EnumType {
+	return &file_astrotime_proto_enumTypes[2]
+}
+
+func (x MoonPhaseName) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MoonPhaseName.Descriptor instead.
+func (MoonPhaseName) EnumDescriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{2}
+}
+
+// Place is an observer on the Earth.
+type Place struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Latitude in degrees, north positive.
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude in degrees, east positive.
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Height above sea level in meters.
+	Elevation     float64 `protobuf:"fixed64,3,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Place) Reset() {
+	*x = Place{}
+	mi := &file_astrotime_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Place) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Place) ProtoMessage() {}
+
+func (x *Place) ProtoReflect() protoreflect.Message {
+	mi := &file_astrotime_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Place.ProtoReflect.Descriptor instead.
+func (*Place) Descriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Place) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Place) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Place) GetElevation() float64 {
+	if x != nil {
+		return x.Elevation
+	}
+	return 0
+}
+
+// Event is a solar event of a day.
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  EventKind              `protobuf:"varint,1,opt,name=kind,proto3,enum=astrotime.v1.EventKind" json:"kind,omitempty"`
+	// Time is unset if the event does not happen.
+	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	NoEvent       NoEvent                `protobuf:"varint,3,opt,name=no_event,json=noEvent,proto3,enum=astrotime.v1.NoEvent" json:"no_event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_astrotime_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_astrotime_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Event) GetKind() EventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return EventKind_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *Event) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Event) GetNoEvent() NoEvent {
+	if x != nil {
+		return x.NoEvent
+	}
+	return NoEvent_NO_EVENT_UNSPECIFIED
+}
+
+type SunEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Place *Place                 `protobuf:"bytes,1,opt,name=place,proto3" json:"place,omitempty"`
+	// Date is the day, in the time zone.
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// TimeZone is the IANA time zone the date is read in, UTC if empty.
+	TimeZone string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Kinds are the events wanted, every kind if empty.
+	Kinds         []EventKind `protobuf:"varint,4,rep,packed,name=kinds,proto3,enum=astrotime.v1.EventKind" json:"kinds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SunEventsRequest) Reset() {
+	*x = SunEventsRequest{}
+	mi := &file_astrotime_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SunEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SunEventsRequest) ProtoMessage() {}
+
+func (x *SunEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_astrotime_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SunEventsRequest.ProtoReflect.Descriptor instead.
+func (*SunEventsRequest) Descriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SunEventsRequest) GetPlace() *Place {
+	if x != nil {
+		return x.Place
+	}
+	return nil
+}
+
+func (x *SunEventsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SunEventsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *SunEventsRequest) GetKinds() []EventKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type SunEventsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Place    *Place                 `protobuf:"bytes,1,opt,name=place,proto3" json:"place,omitempty"`
+	Date     string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	TimeZone string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Events are in the order of the kinds requested, or in daily order.
+	Events []*Event `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	// DayLength is the time from sunrise to sunset: 24 hours if the sun
+	// stays up and zero if it stays down.
+	DayLength     *durationpb.Duration `protobuf:"bytes,5,opt,name=day_length,json=dayLength,proto3" json:"day_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SunEventsResponse) Reset() {
+	*x = SunEventsResponse{}
+	mi := &file_astrotime_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SunEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SunEventsResponse) ProtoMessage() {}
+
+func (x *SunEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_astrotime_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SunEventsResponse.ProtoReflect.Descriptor instead.
+func (*SunEventsResponse) Descriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SunEventsResponse) GetPlace() *Place {
+	if x != nil {
+		return x.Place
+	}
+	return nil
+}
+
+func (x *SunEventsResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SunEventsResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *SunEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *SunEventsResponse) GetDayLength() *durationpb.Duration {
+	if x != nil {
+		return x.DayLength
+	}
+	return nil
+}
+
+type BatchSunEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Places        []*Place               `protobuf:"bytes,1,rep,name=places,proto3" json:"places,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Kinds         []EventKind            `protobuf:"varint,4,rep,packed,name=kinds,proto3,enum=astrotime.v1.EventKind" json:"kinds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSunEventsRequest) Reset() {
+	*x = BatchSunEventsRequest{}
+	mi := &file_astrotime_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSunEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSunEventsRequest) ProtoMessage() {}
+
+func (x *BatchSunEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_astrotime_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSunEventsRequest.ProtoReflect.Descriptor instead.
+func (*BatchSunEventsRequest) Descriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchSunEventsRequest) GetPlaces() []*Place {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
+func (x *BatchSunEventsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *BatchSunEventsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *BatchSunEventsRequest) GetKinds() []EventKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type BatchSunEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SunEventsResponse   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchSunEventsResponse) Reset() {
+	*x = BatchSunEventsResponse{}
+	mi := &file_astrotime_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchSunEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSunEventsResponse) ProtoMessage() {}
+
+func (x *BatchSunEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_astrotime_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSunEventsResponse.ProtoReflect.Descriptor instead.
+func (*BatchSunEventsResponse) Descriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchSunEventsResponse) GetResults() []*SunEventsResponse {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type SunEventsRangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Place *Place                 `protobuf:"bytes,1,opt,name=place,proto3" json:"place,omitempty"`
+	// Start and End are the first and last days, inclusive.
+	Start         string      `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           string      `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	TimeZone      string      `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Kinds         []EventKind `protobuf:"varint,5,rep,packed,name=kinds,proto3,enum=astrotime.v1.EventKind" json:"kinds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SunEventsRangeRequest) Reset() {
+	*x = SunEventsRangeRequest{}
+	mi := &file_astrotime_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SunEventsRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SunEventsRangeRequest) ProtoMessage() {}
+
+func (x *SunEventsRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_astrotime_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SunEventsRangeRequest.ProtoReflect.Descriptor instead.
+func (*SunEventsRangeRequest) Descriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SunEventsRangeRequest) GetPlace() *Place {
+	if x != nil {
+		return x.Place
+	}
+	return nil
+}
+
+func (x *SunEventsRangeRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *SunEventsRangeRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *SunEventsRangeRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *SunEventsRangeRequest) GetKinds() []EventKind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type MoonEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Place         *Place                 `protobuf:"bytes,1,opt,name=place,proto3" json:"place,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoonEventsRequest) Reset() {
+	*x = MoonEventsRequest{}
+	mi := &file_astrotime_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoonEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoonEventsRequest) ProtoMessage() {}
+
+func (x *MoonEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_astrotime_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoonEventsRequest.ProtoReflect.Descriptor instead.
+func (*MoonEventsRequest) Descriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MoonEventsRequest) GetPlace() *Place {
+	if x != nil {
+		return x.Place
+	}
+	return nil
+}
+
+func (x *MoonEventsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MoonEventsRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// MoonTime is the time of a lunar event, unset with the reason if it does
+// not happen that day.
+type MoonTime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	NoEvent       NoEvent                `protobuf:"varint,2,opt,name=no_event,json=noEvent,proto3,enum=astrotime.v1.NoEvent" json:"no_event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoonTime) Reset() {
+	*x = MoonTime{}
+	mi := &file_astrotime_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoonTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoonTime) ProtoMessage() {}
+
+func (x *MoonTime) ProtoReflect() protoreflect.Message {
+	mi := &file_astrotime_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoonTime.ProtoReflect.Descriptor instead.
+func (*MoonTime) Descriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MoonTime) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *MoonTime) GetNoEvent() NoEvent {
+	if x != nil {
+		return x.NoEvent
+	}
+	return NoEvent_NO_EVENT_UNSPECIFIED
+}
+
+type MoonEventsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Place    *Place                 `protobuf:"bytes,1,opt,name=place,proto3" json:"place,omitempty"`
+	Date     string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	TimeZone string                 `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Moonrise *MoonTime              `protobuf:"bytes,4,opt,name=moonrise,proto3" json:"moonrise,omitempty"`
+	Moonset  *MoonTime              `protobuf:"bytes,5,opt,name=moonset,proto3" json:"moonset,omitempty"`
+	Transit  *MoonTime              `protobuf:"bytes,6,opt,name=transit,proto3" json:"transit,omitempty"`
+	// Phase is the phase at noon in the time zone: the elongation angle in
+	// degrees, the illuminated fraction and its name.
+	PhaseAngle    float64       `protobuf:"fixed64,7,opt,name=phase_angle,json=phaseAngle,proto3" json:"phase_angle,omitempty"`
+	Illumination  float64       `protobuf:"fixed64,8,opt,name=illumination,proto3" json:"illumination,omitempty"`
+	PhaseName     MoonPhaseName `protobuf:"varint,9,opt,name=phase_name,json=phaseName,proto3,enum=astrotime.v1.MoonPhaseName" json:"phase_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoonEventsResponse) Reset() {
+	*x = MoonEventsResponse{}
+	mi := &file_astrotime_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoonEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoonEventsResponse) ProtoMessage() {}
+
+func (x *MoonEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_astrotime_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoonEventsResponse.ProtoReflect.Descriptor instead.
+func (*MoonEventsResponse) Descriptor() ([]byte, []int) {
+	return file_astrotime_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MoonEventsResponse) GetPlace() *Place {
+	if x != nil {
+		return x.Place
+	}
+	return nil
+}
+
+func (x *MoonEventsResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MoonEventsResponse) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *MoonEventsResponse) GetMoonrise() *MoonTime {
+	if x != nil {
+		return x.Moonrise
+	}
+	return nil
+}
+
+func (x *MoonEventsResponse) GetMoonset() *MoonTime {
+	if x != nil {
+		return x.Moonset
+	}
+	return nil
+}
+
+func (x *MoonEventsResponse) GetTransit() *MoonTime {
+	if x != nil {
+		return x.Transit
+	}
+	return nil
+}
+
+func (x *MoonEventsResponse) GetPhaseAngle() float64 {
+	if x != nil {
+		return x.PhaseAngle
+	}
+	return 0
+}
+
+func (x *MoonEventsResponse) GetIllumination() float64 {
+	if x != nil {
+		return x.Illumination
+	}
+	return 0
+}
+
+func (x *MoonEventsResponse) GetPhaseName() MoonPhaseName {
+	if x != nil {
+		return x.PhaseName
+	}
+	return MoonPhaseName_MOON_PHASE_NAME_UNSPECIFIED
+}
+
+var File_astrotime_proto protoreflect.FileDescriptor
+
+const file_astrotime_proto_rawDesc = "" +
+	"\n" +
+	"\x0fastrotime.proto\x12\fastrotime.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"_\n" +
+	"\x05Place\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x1c\n" +
+	"\televation\x18\x03 \x01(\x01R\televation\"\x96\x01\n" +
+	"\x05Event\x12+\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x17.astrotime.v1.EventKindR\x04kind\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x120\n" +
+	"\bno_event\x18\x03 \x01(\x0e2\x15.astrotime.v1.NoEventR\anoEvent\"\x9d\x01\n" +
+	"\x10SunEventsRequest\x12)\n" +
+	"\x05place\x18\x01 \x01(\v2\x13.astrotime.v1.PlaceR\x05place\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x12-\n" +
+	"\x05kinds\x18\x04 \x03(\x0e2\x17.astrotime.v1.EventKindR\x05kinds\"\xd6\x01\n" +
+	"\x11SunEventsResponse\x12)\n" +
+	"\x05place\x18\x01 \x01(\v2\x13.astrotime.v1.PlaceR\x05place\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x12+\n" +
+	"\x06events\x18\x04 \x03(\v2\x13.astrotime.v1.EventR\x06events\x128\n" +
+	"\n" +
+	"day_length\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\tdayLength\"\xa4\x01\n" +
+	"\x15BatchSunEventsRequest\x12+\n" +
+	"\x06places\x18\x01 \x03(\v2\x13.astrotime.v1.PlaceR\x06places\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x12-\n" +
+	"\x05kinds\x18\x04 \x03(\x0e2\x17.astrotime.v1.EventKindR\x05kinds\"S\n" +
+	"\x16BatchSunEventsResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.astrotime.v1.SunEventsResponseR\aresults\"\xb6\x01\n" +
+	"\x15SunEventsRangeRequest\x12)\n" +
+	"\x05place\x18\x01 \x01(\v2\x13.astrotime.v1.PlaceR\x05place\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\x12-\n" +
+	"\x05kinds\x18\x05 \x03(\x0e2\x17.astrotime.v1.EventKindR\x05kinds\"o\n" +
+	"\x11MoonEventsRequest\x12)\n" +
+	"\x05place\x18\x01 \x01(\v2\x13.astrotime.v1.PlaceR\x05place\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\"l\n" +
+	"\bMoonTime\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x120\n" +
+	"\bno_event\x18\x02 \x01(\x0e2\x15.astrotime.v1.NoEventR\anoEvent\"\x89\x03\n" +
+	"\x12MoonEventsResponse\x12)\n" +
+	"\x05place\x18\x01 \x01(\v2\x13.astrotime.v1.PlaceR\x05place\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x122\n" +
+	"\bmoonrise\x18\x04 \x01(\v2\x16.astrotime.v1.MoonTimeR\bmoonrise\x120\n" +
+	"\amoonset\x18\x05 \x01(\v2\x16.astrotime.v1.MoonTimeR\amoonset\x120\n" +
+	"\atransit\x18\x06 \x01(\v2\x16.astrotime.v1.MoonTimeR\atransit\x12\x1f\n" +
+	"\vphase_angle\x18\a \x01(\x01R\n" +
+	"phaseAngle\x12\"\n" +
+	"\fillumination\x18\b \x01(\x01R\fillumination\x12:\n" +
+	"\n" +
+	"phase_name\x18\t \x01(\x0e2\x1b.astrotime.v1.MoonPhaseNameR\tphaseName*\xa7\x02\n" +
+	"\tEventKind\x12\x1a\n" +
+	"\x16EVENT_KIND_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cEVENT_KIND_ASTRONOMICAL_DAWN\x10\x01\x12\x1c\n" +
+	"\x18EVENT_KIND_NAUTICAL_DAWN\x10\x02\x12\x19\n" +
+	"\x15EVENT_KIND_CIVIL_DAWN\x10\x03\x12\x16\n" +
+	"\x12EVENT_KIND_SUNRISE\x10\x04\x12\x19\n" +
+	"\x15EVENT_KIND_SOLAR_NOON\x10\x05\x12\x15\n" +
+	"\x11EVENT_KIND_SUNSET\x10\x06\x12\x19\n" +
+	"\x15EVENT_KIND_CIVIL_DUSK\x10\a\x12\x1c\n" +
+	"\x18EVENT_KIND_NAUTICAL_DUSK\x10\b\x12 \n" +
+	"\x1cEVENT_KIND_ASTRONOMICAL_DUSK\x10\t*q\n" +
+	"\aNoEvent\x12\x18\n" +
+	"\x14NO_EVENT_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15NO_EVENT_ALWAYS_ABOVE\x10\x01\x12\x19\n" +
+	"\x15NO_EVENT_ALWAYS_BELOW\x10\x02\x12\x16\n" +
+	"\x12NO_EVENT_NOT_TODAY\x10\x03*\xc4\x02\n" +
+	"\rMoonPhaseName\x12\x1f\n" +
+	"\x1bMOON_PHASE_NAME_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18MOON_PHASE_NAME_NEW_MOON\x10\x01\x12#\n" +
+	"\x1fMOON_PHASE_NAME_WAXING_CRESCENT\x10\x02\x12!\n" +
+	"\x1dMOON_PHASE_NAME_FIRST_QUARTER\x10\x03\x12\"\n" +
+	"\x1eMOON_PHASE_NAME_WAXING_GIBBOUS\x10\x04\x12\x1d\n" +
+	"\x19MOON_PHASE_NAME_FULL_MOON\x10\x05\x12\"\n" +
+	"\x1eMOON_PHASE_NAME_WANING_GIBBOUS\x10\x06\x12 \n" +
+	"\x1cMOON_PHASE_NAME_LAST_QUARTER\x10\a\x12#\n" +
+	"\x1fMOON_PHASE_NAME_WANING_CRESCENT\x10\b2\xe1\x02\n" +
+	"\tAstrotime\x12L\n" +
+	"\tSunEvents\x12\x1e.astrotime.v1.SunEventsRequest\x1a\x1f.astrotime.v1.SunEventsResponse\x12[\n" +
+	"\x0eBatchSunEvents\x12#.astrotime.v1.BatchSunEventsRequest\x1a$.astrotime.v1.BatchSunEventsResponse\x12X\n" +
+	"\x0eSunEventsRange\x12#.astrotime.v1.SunEventsRangeRequest\x1a\x1f.astrotime.v1.SunEventsResponse0\x01\x12O\n" +
+	"\n" +
+	"MoonEvents\x12\x1f.astrotime.v1.MoonEventsRequest\x1a .astrotime.v1.MoonEventsResponseB'Z%github.com/dntj/astrotime/astrotimepbb\x06proto3"
+
+var (
+	file_astrotime_proto_rawDescOnce sync.Once
+	file_astrotime_proto_rawDescData []byte
+)
+
+func file_astrotime_proto_rawDescGZIP() []byte {
+	file_astrotime_proto_rawDescOnce.Do(func() {
+		file_astrotime_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_astrotime_proto_rawDesc), len(file_astrotime_proto_rawDesc)))
+	})
+	return file_astrotime_proto_rawDescData
+}
+
+var file_astrotime_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_astrotime_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_astrotime_proto_goTypes = []any{
+	(EventKind)(0),                 // 0: astrotime.v1.EventKind
+	(NoEvent)(0),                   // 1: astrotime.v1.NoEvent
+	(MoonPhaseName)(0),             // 2: astrotime.v1.MoonPhaseName
+	(*Place)(nil),                  // 3: astrotime.v1.Place
+	(*Event)(nil),                  // 4: astrotime.v1.Event
+	(*SunEventsRequest)(nil),       // 5: astrotime.v1.SunEventsRequest
+	(*SunEventsResponse)(nil),      // 6: astrotime.v1.SunEventsResponse
+	(*BatchSunEventsRequest)(nil),  // 7: astrotime.v1.BatchSunEventsRequest
+	(*BatchSunEventsResponse)(nil), // 8: astrotime.v1.BatchSunEventsResponse
+	(*SunEventsRangeRequest)(nil),  // 9: astrotime.v1.SunEventsRangeRequest
+	(*MoonEventsRequest)(nil),      // 10: astrotime.v1.MoonEventsRequest
+	(*MoonTime)(nil),               // 11: astrotime.v1.MoonTime
+	(*MoonEventsResponse)(nil),     // 12: astrotime.v1.MoonEventsResponse
+	(*timestamppb.Timestamp)(nil),  // 13: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 14: google.protobuf.Duration
+}
+var file_astrotime_proto_depIdxs = []int32{
+	0,  // 0: astrotime.v1.Event.kind:type_name -> astrotime.v1.EventKind
+	13, // 1: astrotime.v1.Event.time:type_name -> google.protobuf.Timestamp
+	1,  // 2: astrotime.v1.Event.no_event:type_name -> astrotime.v1.NoEvent
+	3,  // 3: astrotime.v1.SunEventsRequest.place:type_name -> astrotime.v1.Place
+	0,  // 4: astrotime.v1.SunEventsRequest.kinds:type_name -> astrotime.v1.EventKind
+	3,  // 5: astrotime.v1.SunEventsResponse.place:type_name -> astrotime.v1.Place
+	4,  // 6: astrotime.v1.SunEventsResponse.events:type_name -> astrotime.v1.Event
+	14, // 7: astrotime.v1.SunEventsResponse.day_length:type_name -> google.protobuf.Duration
+	3,  // 8: astrotime.v1.BatchSunEventsRequest.places:type_name -> astrotime.v1.Place
+	0,  // 9: astrotime.v1.BatchSunEventsRequest.kinds:type_name -> astrotime.v1.EventKind
+	6,  // 10: astrotime.v1.BatchSunEventsResponse.results:type_name -> astrotime.v1.SunEventsResponse
+	3,  // 11: astrotime.v1.SunEventsRangeRequest.place:type_name -> astrotime.v1.Place
+	0,  // 12: astrotime.v1.SunEventsRangeRequest.kinds:type_name -> astrotime.v1.EventKind
+	3,  // 13: astrotime.v1.MoonEventsRequest.place:type_name -> astrotime.v1.Place
+	13, // 14: astrotime.v1.MoonTime.time:type_name -> google.protobuf.Timestamp
+	1,  // 15: astrotime.v1.MoonTime.no_event:type_name -> astrotime.v1.NoEvent
+	3,  // 16: astrotime.v1.MoonEventsResponse.place:type_name -> astrotime.v1.Place
+	11, // 17: astrotime.v1.MoonEventsResponse.moonrise:type_name -> astrotime.v1.MoonTime
+	11, // 18: astrotime.v1.MoonEventsResponse.moonset:type_name -> astrotime.v1.MoonTime
+	11, // 19: astrotime.v1.MoonEventsResponse.transit:type_name -> astrotime.v1.MoonTime
+	2,  // 20: astrotime.v1.MoonEventsResponse.phase_name:type_name -> astrotime.v1.MoonPhaseName
+	5,  // 21: astrotime.v1.Astrotime.SunEvents:input_type -> astrotime.v1.SunEventsRequest
+	7,  // 22: astrotime.v1.Astrotime.BatchSunEvents:input_type -> astrotime.v1.BatchSunEventsRequest
+	9,  // 23: astrotime.v1.Astrotime.SunEventsRange:input_type -> astrotime.v1.SunEventsRangeRequest
+	10, // 24: astrotime.v1.Astrotime.MoonEvents:input_type -> astrotime.v1.MoonEventsRequest
+	6,  // 25: astrotime.v1.Astrotime.SunEvents:output_type -> astrotime.v1.SunEventsResponse
+	8,  // 26: astrotime.v1.Astrotime.BatchSunEvents:output_type -> astrotime.v1.BatchSunEventsResponse
+	6,  // 27: astrotime.v1.Astrotime.SunEventsRange:output_type -> astrotime.v1.SunEventsResponse
+	12, // 28: astrotime.v1.Astrotime.MoonEvents:output_type -> astrotime.v1.MoonEventsResponse
+	25, // [25:29] is the sub-list for method output_type
+	21, // [21:25] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_astrotime_proto_init() }
+func file_astrotime_proto_init() {
+	if File_astrotime_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_astrotime_proto_rawDesc), len(file_astrotime_proto_rawDesc)),
+			NumEnums:      3,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_astrotime_proto_goTypes,
+		DependencyIndexes: file_astrotime_proto_depIdxs,
+		EnumInfos:         file_astrotime_proto_enumTypes,
+		MessageInfos:      file_astrotime_proto_msgTypes,
+	}.Build()
+	File_astrotime_proto = out.File
+	file_astrotime_proto_goTypes = nil
+	file_astrotime_proto_depIdxs = nil
+}
@@ -0,0 +1,264 @@
+// Protocol buffer definitions of a service answering queries for the
+// events of the sun and moon, built on github.com/dntj/astrotime.
+//
+// Times are google.protobuf.Timestamp, instants in UTC; each response names
+// the time zone its dates were read in so that clients can show local
+// times. Dates are strings in the form 2006-01-02.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: astrotime.proto
+
+package astrotimepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Astrotime_SunEvents_FullMethodName      = "/astrotime.v1.Astrotime/SunEvents"
+	Astrotime_BatchSunEvents_FullMethodName = "/astrotime.v1.Astrotime/BatchSunEvents"
+	Astrotime_SunEventsRange_FullMethodName = "/astrotime.v1.Astrotime/SunEventsRange"
+	Astrotime_MoonEvents_FullMethodName     = "/astrotime.v1.Astrotime/MoonEvents"
+)
+
+// AstrotimeClient is the client API for Astrotime service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Astrotime calculates the events of the sun and moon at places on days.
+type AstrotimeClient interface {
+	// SunEvents returns the solar events of a day at a place.
+	SunEvents(ctx context.Context, in *SunEventsRequest, opts ...grpc.CallOption) (*SunEventsResponse, error)
+	// BatchSunEvents returns the solar events of a day at many places, in
+	// the order of the places.
+	BatchSunEvents(ctx context.Context, in *BatchSunEventsRequest, opts ...grpc.CallOption) (*BatchSunEventsResponse, error)
+	// SunEventsRange streams the solar events at a place for each day of a
+	// range, in order.
+	SunEventsRange(ctx context.Context, in *SunEventsRangeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SunEventsResponse], error)
+	// MoonEvents returns the moonrise, moonset, transit and phase of a day at
+	// a place.
+	MoonEvents(ctx context.Context, in *MoonEventsRequest, opts ...grpc.CallOption) (*MoonEventsResponse, error)
+}
+
+type astrotimeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAstrotimeClient(cc grpc.ClientConnInterface) AstrotimeClient {
+	return &astrotimeClient{cc}
+}
+
+func (c *astrotimeClient) SunEvents(ctx context.Context, in *SunEventsRequest, opts ...grpc.CallOption) (*SunEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SunEventsResponse)
+	err := c.cc.Invoke(ctx, Astrotime_SunEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *astrotimeClient) BatchSunEvents(ctx context.Context, in *BatchSunEventsRequest, opts ...grpc.CallOption) (*BatchSunEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchSunEventsResponse)
+	err := c.cc.Invoke(ctx, Astrotime_BatchSunEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *astrotimeClient) SunEventsRange(ctx context.Context, in *SunEventsRangeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SunEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Astrotime_ServiceDesc.Streams[0], Astrotime_SunEventsRange_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SunEventsRangeRequest, SunEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Astrotime_SunEventsRangeClient = grpc.ServerStreamingClient[SunEventsResponse]
+
+func (c *astrotimeClient) MoonEvents(ctx context.Context, in *MoonEventsRequest, opts ...grpc.CallOption) (*MoonEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoonEventsResponse)
+	err := c.cc.Invoke(ctx, Astrotime_MoonEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AstrotimeServer is the server API for Astrotime service.
+// All implementations must embed UnimplementedAstrotimeServer
+// for forward compatibility.
+//
+// Astrotime calculates the events of the sun and moon at places on days.
+type AstrotimeServer interface {
+	// SunEvents returns the solar events of a day at a place.
+	SunEvents(context.Context, *SunEventsRequest) (*SunEventsResponse, error)
+	// BatchSunEvents returns the solar events of a day at many places, in
+	// the order of the places.
+	BatchSunEvents(context.Context, *BatchSunEventsRequest) (*BatchSunEventsResponse, error)
+	// SunEventsRange streams the solar events at a place for each day of a
+	// range, in order.
+	SunEventsRange(*SunEventsRangeRequest, grpc.ServerStreamingServer[SunEventsResponse]) error
+	// MoonEvents returns the moonrise, moonset, transit and phase of a day at
+	// a place.
+	MoonEvents(context.Context, *MoonEventsRequest) (*MoonEventsResponse, error)
+	mustEmbedUnimplementedAstrotimeServer()
+}
+
+// UnimplementedAstrotimeServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAstrotimeServer struct{}
+
+func (UnimplementedAstrotimeServer) SunEvents(context.Context, *SunEventsRequest) (*SunEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SunEvents not implemented")
+}
+func (UnimplementedAstrotimeServer) BatchSunEvents(context.Context, *BatchSunEventsRequest) (*BatchSunEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchSunEvents not implemented")
+}
+func (UnimplementedAstrotimeServer) SunEventsRange(*SunEventsRangeRequest, grpc.ServerStreamingServer[SunEventsResponse]) error {
+	return status.Error(codes.Unimplemented, "method SunEventsRange not implemented")
+}
+func (UnimplementedAstrotimeServer) MoonEvents(context.Context, *MoonEventsRequest) (*MoonEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoonEvents not implemented")
+}
+func (UnimplementedAstrotimeServer) mustEmbedUnimplementedAstrotimeServer() {}
+func (UnimplementedAstrotimeServer) testEmbeddedByValue()                   {}
+
+// UnsafeAstrotimeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AstrotimeServer will
+// result in compilation errors.
+type UnsafeAstrotimeServer interface {
+	mustEmbedUnimplementedAstrotimeServer()
+}
+
+func RegisterAstrotimeServer(s grpc.ServiceRegistrar, srv AstrotimeServer) {
+	// If the following call panics, it indicates UnimplementedAstrotimeServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Astrotime_ServiceDesc, srv)
+}
+
+func _Astrotime_SunEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SunEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AstrotimeServer).SunEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Astrotime_SunEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AstrotimeServer).SunEvents(ctx, req.(*SunEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Astrotime_BatchSunEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSunEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AstrotimeServer).BatchSunEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Astrotime_BatchSunEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AstrotimeServer).BatchSunEvents(ctx, req.(*BatchSunEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Astrotime_SunEventsRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SunEventsRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AstrotimeServer).SunEventsRange(m, &grpc.GenericServerStream[SunEventsRangeRequest, SunEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Astrotime_SunEventsRangeServer = grpc.ServerStreamingServer[SunEventsResponse]
+
+func _Astrotime_MoonEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoonEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AstrotimeServer).MoonEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Astrotime_MoonEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AstrotimeServer).MoonEvents(ctx, req.(*MoonEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Astrotime_ServiceDesc is the grpc.ServiceDesc for Astrotime service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Astrotime_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "astrotime.v1.Astrotime",
+	HandlerType: (*AstrotimeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SunEvents",
+			Handler:    _Astrotime_SunEvents_Handler,
+		},
+		{
+			MethodName: "BatchSunEvents",
+			Handler:    _Astrotime_BatchSunEvents_Handler,
+		},
+		{
+			MethodName: "MoonEvents",
+			Handler:    _Astrotime_MoonEvents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SunEventsRange",
+			Handler:       _Astrotime_SunEventsRange_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "astrotime.proto",
+}
@@ -0,0 +1,18 @@
+// Package astrotimepb is a gRPC service for the events of the sun and moon,
+// so that services in other languages can query the library through a
+// typed contract. The service is defined in astrotime.proto; Server
+// implements it:
+//
+//	s := grpc.NewServer()
+//	astrotimepb.RegisterAstrotimeServer(s, astrotimepb.NewServer())
+//
+// The package depends on google.golang.org/protobuf and
+// google.golang.org/grpc, which the rest of the library does not. The
+// generated files, astrotime.pb.go and astrotime_grpc.pb.go, are checked
+// in; regenerate them after changing the definitions with protoc and the
+// protoc-gen-go and protoc-gen-go-grpc plugins:
+//
+//	go generate github.com/dntj/astrotime/astrotimepb
+package astrotimepb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative astrotime.proto
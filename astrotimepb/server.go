@@ -0,0 +1,281 @@
+package astrotimepb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/dntj/astrotime"
+)
+
+// Server implements the Astrotime service with the library:
+//
+//	s := grpc.NewServer()
+//	astrotimepb.RegisterAstrotimeServer(s, astrotimepb.NewServer())
+//
+// Requests with invalid places, dates, time zones or kinds of event fail
+// with codes.InvalidArgument.
+type Server struct {
+	UnimplementedAstrotimeServer
+
+	opts []astrotime.Option
+}
+
+// NewServer returns a Server calculating with observers configured by opts,
+// such as astrotime.WithAlgorithm.
+func NewServer(opts ...astrotime.Option) *Server {
+	return &Server{opts: opts}
+}
+
+// dateLayout is the layout of dates in requests and responses.
+const dateLayout = "2006-01-02"
+
+// SunEvents returns the solar events of a day at a place.
+func (s *Server) SunEvents(ctx context.Context, req *SunEventsRequest) (*SunEventsResponse, error) {
+	loc, err := location(req.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+	day, err := date(req.GetDate(), loc)
+	if err != nil {
+		return nil, err
+	}
+	kinds, err := eventKinds(req.GetKinds())
+	if err != nil {
+		return nil, err
+	}
+	return s.sunEvents(req.GetPlace(), day, kinds)
+}
+
+// BatchSunEvents returns the solar events of a day at many places, in the
+// order of the places.
+func (s *Server) BatchSunEvents(ctx context.Context, req *BatchSunEventsRequest) (*BatchSunEventsResponse, error) {
+	loc, err := location(req.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+	day, err := date(req.GetDate(), loc)
+	if err != nil {
+		return nil, err
+	}
+	kinds, err := eventKinds(req.GetKinds())
+	if err != nil {
+		return nil, err
+	}
+	resp := &BatchSunEventsResponse{Results: make([]*SunEventsResponse, 0, len(req.GetPlaces()))}
+	for _, p := range req.GetPlaces() {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		r, err := s.sunEvents(p, day, kinds)
+		if err != nil {
+			return nil, err
+		}
+		resp.Results = append(resp.Results, r)
+	}
+	return resp, nil
+}
+
+// SunEventsRange streams the solar events at a place for each day of a
+// range, in order.
+func (s *Server) SunEventsRange(req *SunEventsRangeRequest, stream grpc.ServerStreamingServer[SunEventsResponse]) error {
+	loc, err := location(req.GetTimeZone())
+	if err != nil {
+		return err
+	}
+	start, err := date(req.GetStart(), loc)
+	if err != nil {
+		return err
+	}
+	end, err := date(req.GetEnd(), loc)
+	if err != nil {
+		return err
+	}
+	if end.Before(start) {
+		return status.Error(codes.InvalidArgument, "astrotimepb: end is before start")
+	}
+	kinds, err := eventKinds(req.GetKinds())
+	if err != nil {
+		return err
+	}
+	for i := 0; ; i++ {
+		day := start.AddDate(0, 0, i)
+		if day.After(end) {
+			return nil
+		}
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+		r, err := s.sunEvents(req.GetPlace(), day, kinds)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(r); err != nil {
+			return err
+		}
+	}
+}
+
+// MoonEvents returns the moonrise, moonset, transit and phase of a day at a
+// place.
+func (s *Server) MoonEvents(ctx context.Context, req *MoonEventsRequest) (*MoonEventsResponse, error) {
+	loc, err := location(req.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+	day, err := date(req.GetDate(), loc)
+	if err != nil {
+		return nil, err
+	}
+	o, err := s.observer(req.GetPlace(), loc)
+	if err != nil {
+		return nil, err
+	}
+	moonTime := func(t time.Time, err error) (*MoonTime, error) {
+		if err != nil {
+			n, ok := noEvent(err)
+			if !ok {
+				return nil, statusOf(err)
+			}
+			return &MoonTime{NoEvent: n}, nil
+		}
+		return &MoonTime{Time: timestamppb.New(t)}, nil
+	}
+	resp := &MoonEventsResponse{Place: req.GetPlace(), Date: day.Format(dateLayout), TimeZone: loc.String()}
+	if resp.Moonrise, err = moonTime(o.Moonrise(day)); err != nil {
+		return nil, err
+	}
+	if resp.Moonset, err = moonTime(o.Moonset(day)); err != nil {
+		return nil, err
+	}
+	if resp.Transit, err = moonTime(o.MoonTransit(day)); err != nil {
+		return nil, err
+	}
+	phase := astrotime.MoonPhase(day.Add(12 * time.Hour))
+	resp.PhaseAngle = phase.Angle
+	resp.Illumination = phase.Illumination
+	resp.PhaseName = MoonPhaseName(phase.Name + 1)
+	return resp, nil
+}
+
+// sunEvents calculates the events of the kinds, and the day length, at the
+// place on the day.
+func (s *Server) sunEvents(p *Place, day time.Time, kinds []astrotime.EventKind) (*SunEventsResponse, error) {
+	o, err := s.observer(p, day.Location())
+	if err != nil {
+		return nil, err
+	}
+	resp := &SunEventsResponse{
+		Place:    p,
+		Date:     day.Format(dateLayout),
+		TimeZone: day.Location().String(),
+		Events:   make([]*Event, 0, len(kinds)),
+	}
+	for _, k := range kinds {
+		ev := &Event{Kind: EventKind(k + 1)}
+		t, err := o.EventTime(day, k)
+		if err != nil {
+			n, ok := noEvent(err)
+			if !ok {
+				return nil, statusOf(err)
+			}
+			ev.NoEvent = n
+		} else {
+			ev.Time = timestamppb.New(t)
+		}
+		resp.Events = append(resp.Events, ev)
+	}
+	length, err := o.Photoperiod(day, false)
+	if err != nil {
+		return nil, statusOf(err)
+	}
+	resp.DayLength = durationpb.New(length)
+	return resp, nil
+}
+
+// observer returns the observer at the place, in the time zone.
+func (s *Server) observer(p *Place, loc *time.Location) (astrotime.Observer, error) {
+	if p == nil {
+		return astrotime.Observer{}, status.Error(codes.InvalidArgument, "astrotimepb: no place")
+	}
+	if err := astrotime.ValidateCoordinates(p.GetLatitude(), p.GetLongitude()); err != nil {
+		return astrotime.Observer{}, statusOf(err)
+	}
+	o := astrotime.NewObserver(p.GetLatitude(), p.GetLongitude(), s.opts...)
+	o.Elevation = p.GetElevation()
+	o.Location = loc
+	return o, nil
+}
+
+// location loads the IANA time zone name, or UTC if it is empty.
+func location(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "astrotimepb: time zone %q: %v", name, err)
+	}
+	return loc, nil
+}
+
+// date parses a date, midnight at its start in loc.
+func date(s string, loc *time.Location) (time.Time, error) {
+	day, err := time.ParseInLocation(dateLayout, s, loc)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "astrotimepb: date %q: %v", s, err)
+	}
+	if err := astrotime.ValidateDate(day); err != nil {
+		return time.Time{}, statusOf(err)
+	}
+	return day, nil
+}
+
+// eventKinds converts the kinds of a request, returning every kind in
+// daily order if there are none.
+func eventKinds(kinds []EventKind) ([]astrotime.EventKind, error) {
+	if len(kinds) == 0 {
+		kinds = make([]EventKind, 0, len(EventKind_name)-1)
+		for k := EventKind_EVENT_KIND_ASTRONOMICAL_DAWN; k <= EventKind_EVENT_KIND_ASTRONOMICAL_DUSK; k++ {
+			kinds = append(kinds, k)
+		}
+	}
+	out := make([]astrotime.EventKind, len(kinds))
+	for i, k := range kinds {
+		if k < EventKind_EVENT_KIND_ASTRONOMICAL_DAWN || k > EventKind_EVENT_KIND_ASTRONOMICAL_DUSK {
+			return nil, status.Errorf(codes.InvalidArgument, "astrotimepb: invalid event kind %v", k)
+		}
+		out[i] = astrotime.EventKind(k - 1)
+	}
+	return out, nil
+}
+
+// noEvent returns the reason err gives for an event not happening, and
+// whether it is one.
+func noEvent(err error) (NoEvent, bool) {
+	switch {
+	case errors.Is(err, astrotime.ErrAlwaysAbove):
+		return NoEvent_NO_EVENT_ALWAYS_ABOVE, true
+	case errors.Is(err, astrotime.ErrAlwaysBelow):
+		return NoEvent_NO_EVENT_ALWAYS_BELOW, true
+	case errors.Is(err, astrotime.ErrNoEvent):
+		return NoEvent_NO_EVENT_NOT_TODAY, true
+	}
+	return NoEvent_NO_EVENT_UNSPECIFIED, false
+}
+
+// statusOf returns the gRPC status error for an error of the library:
+// InvalidArgument for invalid coordinates and dates out of range, and
+// Internal for anything else.
+func statusOf(err error) error {
+	if errors.Is(err, astrotime.ErrInvalidCoordinates) || errors.Is(err, astrotime.ErrDateOutOfRange) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
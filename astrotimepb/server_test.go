@@ -0,0 +1,179 @@
+package astrotimepb
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/dntj/astrotime"
+)
+
+var greenwich = &Place{Latitude: 51.4769, Longitude: -0.0005}
+
+// dial serves a Server over an in-memory connection and returns a client.
+func dial(t *testing.T) AstrotimeClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	RegisterAstrotimeServer(s, NewServer())
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewAstrotimeClient(conn)
+}
+
+func TestSunEvents(t *testing.T) {
+	c := dial(t)
+	resp, err := c.SunEvents(context.Background(), &SunEventsRequest{
+		Place:    greenwich,
+		Date:     "2024-06-20",
+		TimeZone: "UTC",
+		Kinds:    []EventKind{EventKind_EVENT_KIND_SUNRISE, EventKind_EVENT_KIND_SUNSET},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := astrotime.Observer{Lat: greenwich.Latitude, Lon: greenwich.Longitude, Location: time.UTC}
+	day := time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC)
+	rise, _ := o.Sunrise(day)
+	set, _ := o.Sunset(day)
+	if len(resp.Events) != 2 {
+		t.Fatalf("got %d events, want 2", len(resp.Events))
+	}
+	for i, want := range []time.Time{rise, set} {
+		if got := resp.Events[i].Time.AsTime(); !got.Equal(want) {
+			t.Errorf("event %d: got %v, want %v", i, got, want)
+		}
+	}
+	if got, want := resp.DayLength.AsDuration(), set.Sub(rise); got != want {
+		t.Errorf("got day length %v, want %v", got, want)
+	}
+	if resp.Date != "2024-06-20" || resp.TimeZone != "UTC" {
+		t.Errorf("got date %q in %q, want 2024-06-20 in UTC", resp.Date, resp.TimeZone)
+	}
+}
+
+func TestSunEventsPolar(t *testing.T) {
+	resp, err := NewServer().SunEvents(context.Background(), &SunEventsRequest{
+		Place: &Place{Latitude: 78.2, Longitude: 15.6},
+		Date:  "2024-06-20",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Events) != 9 {
+		t.Fatalf("got %d events, want 9", len(resp.Events))
+	}
+	for _, ev := range resp.Events {
+		want := NoEvent_NO_EVENT_ALWAYS_ABOVE
+		if ev.Kind == EventKind_EVENT_KIND_SOLAR_NOON {
+			want = NoEvent_NO_EVENT_UNSPECIFIED
+		}
+		if ev.NoEvent != want || (ev.Time == nil) != (want != NoEvent_NO_EVENT_UNSPECIFIED) {
+			t.Errorf("%v: got %v at %v, want %v", ev.Kind, ev.NoEvent, ev.Time, want)
+		}
+	}
+	if got := resp.DayLength.AsDuration(); got != 24*time.Hour {
+		t.Errorf("got day length %v, want 24h", got)
+	}
+}
+
+func TestInvalidArgument(t *testing.T) {
+	s := NewServer()
+	for _, req := range []*SunEventsRequest{
+		{Date: "2024-06-20"},
+		{Place: &Place{Latitude: 91}, Date: "2024-06-20"},
+		{Place: greenwich, Date: "20 June"},
+		{Place: greenwich, Date: "5000-01-01"},
+		{Place: greenwich, Date: "2024-06-20", TimeZone: "Mars/Olympus"},
+		{Place: greenwich, Date: "2024-06-20", Kinds: []EventKind{EventKind_EVENT_KIND_UNSPECIFIED}},
+		{Place: greenwich, Date: "2024-06-20", Kinds: []EventKind{42}},
+	} {
+		_, err := s.SunEvents(context.Background(), req)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%v: got %v, want InvalidArgument", req, err)
+		}
+	}
+}
+
+func TestBatchSunEvents(t *testing.T) {
+	places := []*Place{greenwich, {Latitude: -33.87, Longitude: 151.21}}
+	resp, err := dial(t).BatchSunEvents(context.Background(), &BatchSunEventsRequest{
+		Places: places,
+		Date:   "2024-06-20",
+		Kinds:  []EventKind{EventKind_EVENT_KIND_SOLAR_NOON},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Results) != 2 {
+		t.Fatalf("got %d results, want 2", len(resp.Results))
+	}
+	for i, r := range resp.Results {
+		if r.Place.Latitude != places[i].Latitude {
+			t.Errorf("result %d: got place %v, want %v", i, r.Place, places[i])
+		}
+	}
+	if a, b := resp.Results[0].Events[0].Time.AsTime(), resp.Results[1].Events[0].Time.AsTime(); a.Sub(b) < 9*time.Hour {
+		t.Errorf("got noon at Sydney %v, want about 10h before Greenwich's %v", b, a)
+	}
+}
+
+func TestSunEventsRange(t *testing.T) {
+	stream, err := dial(t).SunEventsRange(context.Background(), &SunEventsRangeRequest{
+		Place:    greenwich,
+		Start:    "2024-03-30",
+		End:      "2024-04-01",
+		TimeZone: "Europe/London",
+		Kinds:    []EventKind{EventKind_EVENT_KIND_SUNRISE},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dates []string
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		dates = append(dates, r.Date)
+	}
+	if len(dates) != 3 || dates[0] != "2024-03-30" || dates[2] != "2024-04-01" {
+		t.Errorf("got dates %v, want 2024-03-30 to 2024-04-01", dates)
+	}
+}
+
+func TestMoonEvents(t *testing.T) {
+	resp, err := NewServer().MoonEvents(context.Background(), &MoonEventsRequest{
+		Place: greenwich,
+		Date:  "2024-06-22",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.PhaseName != MoonPhaseName_MOON_PHASE_NAME_FULL_MOON {
+		t.Errorf("got phase %v, want full moon", resp.PhaseName)
+	}
+	o := astrotime.Observer{Lat: greenwich.Latitude, Lon: greenwich.Longitude, Location: time.UTC}
+	rise, err := o.Moonrise(time.Date(2024, 6, 22, 0, 0, 0, 0, time.UTC))
+	if got := resp.Moonrise.GetTime(); err == nil && !got.AsTime().Equal(rise) {
+		t.Errorf("got moonrise %v, want %v", got.AsTime(), rise)
+	}
+}
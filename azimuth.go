@@ -0,0 +1,55 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// solarAzimuth calculates the azimuth of the sun, in degrees clockwise from
+// north, for the hour angle ha (radians, negative before noon).
+func solarAzimuth(lat, solarDec, ha float64) float64 {
+	latRad := degToRad * lat
+	sdRad := degToRad * solarDec
+	az := radToDeg*math.Atan2(math.Sin(ha), math.Cos(ha)*math.Sin(latRad)-math.Tan(sdRad)*math.Cos(latRad)) + 180
+	return math.Mod(az, 360)
+}
+
+// SunriseAzimuth calculates the direction of sunrise, in degrees clockwise
+// from north, on the day t at the location specified in longitude and
+// latitude. It returns NaN if the sun does not rise that day.
+func SunriseAzimuth(t time.Time, latitude, longitude float64) float64 {
+	az, err := Observer{Lat: latitude, Lon: longitude}.SunriseAzimuth(t)
+	if err != nil {
+		return math.NaN()
+	}
+	return az
+}
+
+func sunriseAzimuth(t time.Time, latitude, longitude, zenith float64) (float64, error) {
+	sr, err := sunrise(t, latitude, longitude, zenith)
+	if err != nil {
+		return 0, err
+	}
+	solarDec := solarDeclination(julianCentury(julianDate(sr.UTC())))
+	return solarAzimuth(latitude, solarDec, hourAngleSunrise(latitude, solarDec, zenith)), nil
+}
+
+// SunsetAzimuth calculates the direction of sunset, in degrees clockwise
+// from north, on the day t at the location specified in longitude and
+// latitude. It returns NaN if the sun does not set that day.
+func SunsetAzimuth(t time.Time, latitude, longitude float64) float64 {
+	az, err := Observer{Lat: latitude, Lon: longitude}.SunsetAzimuth(t)
+	if err != nil {
+		return math.NaN()
+	}
+	return az
+}
+
+func sunsetAzimuth(t time.Time, latitude, longitude, zenith float64) (float64, error) {
+	ss, err := sunset(t, latitude, longitude, zenith)
+	if err != nil {
+		return 0, err
+	}
+	solarDec := solarDeclination(julianCentury(julianDate(ss.UTC())))
+	return solarAzimuth(latitude, solarDec, -hourAngleSunset(latitude, solarDec, zenith)), nil
+}
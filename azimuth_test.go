@@ -0,0 +1,30 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRiseSetAzimuth(t *testing.T) {
+	tests := []struct {
+		name            string
+		day             time.Time
+		lat, lon        float64
+		sunrise, sunset float64
+	}{
+		{"washington solstice", p("2017-06-21T12:00:00Z"), 38.8895, -77.0352, 58.48, 301.52},
+		{"washington equinox", p("2017-09-22T12:00:00Z"), 38.8895, -77.0352, 89.14, 270.61},
+		{"melbourne solstice", p("2017-06-21T00:00:00Z"), -37.8136, 144.9631, 60.51, 299.49},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SunriseAzimuth(tt.day, tt.lat, tt.lon); math.Abs(got-tt.sunrise) > 0.1 {
+				t.Errorf("got sunrise azimuth %.2f, want %.2f", got, tt.sunrise)
+			}
+			if got := SunsetAzimuth(tt.day, tt.lat, tt.lon); math.Abs(got-tt.sunset) > 0.1 {
+				t.Errorf("got sunset azimuth %.2f, want %.2f", got, tt.sunset)
+			}
+		})
+	}
+}
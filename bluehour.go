@@ -0,0 +1,35 @@
+package astrotime
+
+import "time"
+
+const (
+	// blueHourLow and blueHourHigh are the default altitudes of the sun, in
+	// degrees, bounding the blue hour.
+	blueHourLow  = -6
+	blueHourHigh = -4
+)
+
+// WithBlueHour sets the altitudes of the sun, in degrees, between which
+// BlueHour reports the blue hour, instead of −6° and −4°.
+func WithBlueHour(low, high float64) Option {
+	return func(o *Observer) {
+		o.blueHour = &altitudeBand{low, high}
+	}
+}
+
+// BlueHour calculates the morning and evening blue hours on the day t, while
+// the sun is between −6° and −4° altitude, or the range set by WithBlueHour.
+// Errors are as for GoldenHour.
+func (o Observer) BlueHour(t time.Time) (morning, evening Interval, err error) {
+	b := altitudeBand{blueHourLow, blueHourHigh}
+	if o.blueHour != nil {
+		b = *o.blueHour
+	}
+	return o.band(t, b)
+}
+
+// BlueHour calculates the morning and evening blue hours on the day t at the
+// location specified in latitude and longitude.
+func BlueHour(t time.Time, latitude, longitude float64) (morning, evening Interval, err error) {
+	return Observer{Lat: latitude, Lon: longitude}.BlueHour(t)
+}
@@ -0,0 +1,46 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBlueHour(t *testing.T) {
+	day := p("2017-10-15T12:00:00Z")
+	o := Observer{Lat: 38.8895, Lon: -77.0352}
+	blueMorning, blueEvening, err := o.BlueHour(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	goldMorning, goldEvening, err := o.GoldenHour(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := goldMorning.Start.Sub(blueMorning.End); d < 0 || d > time.Second {
+		t.Errorf("got morning blue hour ending %s, want it to meet golden hour at %s", blueMorning.End, goldMorning.Start)
+	}
+	if d := blueEvening.Start.Sub(goldEvening.End); d < 0 || d > time.Second {
+		t.Errorf("got evening blue hour starting %s, want it to meet golden hour at %s", blueEvening.Start, goldEvening.End)
+	}
+	for _, i := range []Interval{blueMorning, blueEvening} {
+		if d := i.Duration(); d < 5*time.Minute || d > 20*time.Minute {
+			t.Errorf("got blue hour lasting %s, want about 10m", d)
+		}
+	}
+}
+
+func TestWithBlueHour(t *testing.T) {
+	day := p("2017-10-15T12:00:00Z")
+	o := NewObserver(38.8895, -77.0352, WithBlueHour(-8, -4))
+	wide, _, err := o.BlueHour(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	narrow, _, err := BlueHour(day, 38.8895, -77.0352)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !wide.Start.Before(narrow.Start) {
+		t.Errorf("got blue hour from %s with −8°, want before %s", wide.Start, narrow.Start)
+	}
+}
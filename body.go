@@ -0,0 +1,95 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// siderealRate is how far the stars turn, in degrees, per day of UT.
+const siderealRate = 360.98564736629
+
+// Body is a fixed celestial object, such as a star, cluster or galaxy,
+// whose position among the stars does not change appreciably over a night.
+type Body struct {
+	// RA and Dec are the right ascension and declination of the body in
+	// degrees, for the equinox of the dates it is observed.
+	RA, Dec float64
+}
+
+// BodyPosition calculates the azimuth of the body, in degrees clockwise
+// from north, and its geometric altitude in degrees at t.
+func (o Observer) BodyPosition(b Body, t time.Time) (azimuth, altitude float64, err error) {
+	if err := o.validate(t); err != nil {
+		return math.NaN(), math.NaN(), err
+	}
+	azimuth, altitude = o.fixedHorizontal(julianDate(t.UTC()), b.RA, b.Dec)
+	return azimuth, altitude, nil
+}
+
+// BodyAt calculates the right ascension and declination of the point on the
+// sky at the azimuth, in degrees clockwise from north, and geometric
+// altitude at t: the inverse of BodyPosition, for reading off where a
+// telescope on an alt-azimuth mount is pointing.
+func (o Observer) BodyAt(azimuth, altitude float64, t time.Time) (Body, error) {
+	if err := o.validate(t); err != nil {
+		return Body{}, err
+	}
+	ha, dec := horizontalToEquatorial(o.Lat, azimuth, altitude)
+	ra := math.Mod(math.Mod(gast(julianDate(t.UTC()))+o.Lon-ha, 360)+360, 360)
+	return Body{RA: ra, Dec: dec}, nil
+}
+
+// BodyTransit calculates when the body crosses the meridian on the calendar
+// day of t, standing highest in the sky. Transits come about four minutes
+// earlier each day, so a day occasionally has two; the first is returned.
+func (o Observer) BodyTransit(b Body, t time.Time) (time.Time, error) {
+	return o.bodyEvent(b, t, 0)
+}
+
+// BodyRise calculates when the body rises on the calendar day of t, allowing
+// for refraction and the dip of the horizon. It returns ErrAlwaysAbove for
+// a circumpolar body and ErrAlwaysBelow for one that never rises at the
+// observer's latitude.
+func (o Observer) BodyRise(b Body, t time.Time) (time.Time, error) {
+	return o.bodyEvent(b, t, -1)
+}
+
+// BodySet calculates when the body sets on the calendar day of t. Errors are
+// as for BodyRise.
+func (o Observer) BodySet(b Body, t time.Time) (time.Time, error) {
+	return o.bodyEvent(b, t, 1)
+}
+
+// bodyEvent finds the first rise (side −1), transit (0) or set (+1) of the
+// body on the calendar day of t.
+func (o Observer) bodyEvent(b Body, t time.Time, side float64) (time.Time, error) {
+	if err := o.validate(t); err != nil {
+		return time.Time{}, err
+	}
+	var h0 float64
+	if side != 0 {
+		alt := -(o.refraction() + horizonDip(o.Elevation))
+		phi, dec := degToRad*o.Lat, degToRad*b.Dec
+		cosH := (math.Sin(degToRad*alt) - math.Sin(phi)*math.Sin(dec)) / (math.Cos(phi) * math.Cos(dec))
+		switch {
+		case cosH < -1:
+			return time.Time{}, ErrAlwaysAbove
+		case cosH > 1:
+			return time.Time{}, ErrAlwaysBelow
+		}
+		h0 = radToDeg * math.Acos(cosH)
+	}
+
+	start, end := o.dayBounds(t)
+	ha := gast(julianDate(start.UTC())) + o.Lon - b.RA
+	// Days from start to the first transit at or after it.
+	first := math.Mod(math.Mod(-ha, 360)+360, 360) / siderealRate
+	for k := -1.0; k <= 2; k++ {
+		days := first + (k*360+side*h0)/siderealRate
+		at := start.Add(time.Duration(days * float64(24*time.Hour)))
+		if !at.Before(start) && at.Before(end) {
+			return o.round(at), nil
+		}
+	}
+	return time.Time{}, ErrNoEvent
+}
@@ -0,0 +1,87 @@
+package astrotime
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBodyRiseTransitSet(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 15.a: Venus at Boston on
+	// 1988 March 20, here held fixed at its position at 0h TD. Venus moves
+	// about 1° a day, so the book's times differ by up to five minutes.
+	o := Observer{Lat: 42.3333, Lon: -71.0833}
+	venus := Body{RA: 41.73129, Dec: 18.44092}
+	day := time.Date(1988, 3, 20, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		event func(Body, time.Time) (time.Time, error)
+		want  time.Time
+	}{
+		{"rise", o.BodyRise, time.Date(1988, 3, 20, 12, 25, 0, 0, time.UTC)},
+		{"transit", o.BodyTransit, time.Date(1988, 3, 20, 19, 41, 0, 0, time.UTC)},
+		{"set", o.BodySet, time.Date(1988, 3, 20, 2, 55, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := tt.event(venus, day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := got.Sub(tt.want); d < -5*time.Minute || d > 5*time.Minute {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// The altitude at rise is that of the refracted horizon.
+	rise, _ := o.BodyRise(venus, day)
+	if _, alt, _ := o.BodyPosition(venus, rise); math.Abs(alt+standardRefraction) > 0.01 {
+		t.Errorf("got altitude %.3f at rise, want %.3f", alt, -standardRefraction)
+	}
+	transit, _ := o.BodyTransit(venus, day)
+	if az, _, _ := o.BodyPosition(venus, transit); math.Abs(az-180) > 0.01 {
+		t.Errorf("got azimuth %.3f at transit, want 180", az)
+	}
+}
+
+func TestBodyCircumpolar(t *testing.T) {
+	o := Observer{Lat: 51.4779, Lon: 0}
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	polaris := Body{RA: 37.95, Dec: 89.26}
+	if _, err := o.BodyRise(polaris, day); !errors.Is(err, ErrAlwaysAbove) {
+		t.Errorf("Polaris: got error %v, want ErrAlwaysAbove", err)
+	}
+	// The Southern Cross never rises over London.
+	acrux := Body{RA: 186.65, Dec: -63.10}
+	if _, err := o.BodySet(acrux, day); !errors.Is(err, ErrAlwaysBelow) {
+		t.Errorf("Acrux: got error %v, want ErrAlwaysBelow", err)
+	}
+	if _, err := o.BodyTransit(polaris, day); err != nil {
+		t.Errorf("Polaris transit: %v", err)
+	}
+}
+
+func TestBodyAt(t *testing.T) {
+	o := NewObserver(51.4769, -0.0005)
+	at := p("2024-03-01T21:00:00Z")
+	sirius := Body{RA: 101.2872, Dec: -16.7161}
+	az, alt, err := o.BodyPosition(sirius, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := o.BodyAt(az, alt, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(got.RA-sirius.RA) > 1e-6 || math.Abs(got.Dec-sirius.Dec) > 1e-6 {
+		t.Errorf("got %+v back from %.4f, %.4f, want %+v", got, az, alt, sirius)
+	}
+	// The zenith lies at the observer's latitude and local sidereal time.
+	zenith, err := o.BodyAt(0, 90, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(zenith.Dec-o.Lat) > 1e-6 || math.Abs(zenith.RA-LAST(at, o.Lon)) > 1e-6 {
+		t.Errorf("got zenith %+v, want RA %.4f, Dec %.4f", zenith, LAST(at, o.Lon), o.Lat)
+	}
+}
@@ -0,0 +1,101 @@
+package astrotime
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// WithCache gives the observer a cache of the last size event times it has
+// calculated, keyed by the event, the calendar day and the observer's
+// location, so that repeated queries for the same day, as a web server
+// makes, are answered without recalculating. Copies of the observer share
+// the cache, which is safe for concurrent use. A size of zero or less
+// removes the cache, which is the default.
+//
+// A cached time is the one calculated for the first query of the day. With
+// AlgorithmNOAA the time of day of the query moves results by up to a few
+// seconds, so a cached result can differ that much from a fresh one.
+func WithCache(size int) Option {
+	return func(o *Observer) {
+		if size <= 0 {
+			o.cache = nil
+			return
+		}
+		o.cache = &eventCache{size: size, items: make(map[cacheKey]*list.Element)}
+	}
+}
+
+// cacheKey identifies an event time in an eventCache. Other settings of
+// the observer are fixed when the cache is made.
+type cacheKey struct {
+	kind           EventKind
+	year           int
+	month          time.Month
+	day            int
+	loc            *time.Location
+	lat, lon, elev float64
+}
+
+// cacheEntry is a cached event time, or the error that the event does not
+// happen.
+type cacheEntry struct {
+	key  cacheKey
+	time time.Time
+	err  error
+}
+
+// eventCache is a least recently used cache of event times.
+type eventCache struct {
+	mu    sync.Mutex
+	size  int
+	order list.List // of *cacheEntry, most recently used first
+	items map[cacheKey]*list.Element
+}
+
+// get returns the entry for key, if cached.
+func (c *eventCache) get(key cacheKey) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	c.order.MoveToFront(e)
+	return *e.Value.(*cacheEntry), true
+}
+
+// add caches the entry, evicting the least recently used if the cache is
+// full.
+func (c *eventCache) add(entry cacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[entry.key]; ok {
+		*e.Value.(*cacheEntry) = entry
+		c.order.MoveToFront(e)
+		return
+	}
+	if c.order.Len() >= c.size {
+		last := c.order.Back()
+		delete(c.items, last.Value.(*cacheEntry).key)
+		c.order.Remove(last)
+	}
+	c.items[entry.key] = c.order.PushFront(&entry)
+}
+
+// cachedEventTime is EventTime through the observer's cache, which must
+// not be nil. Invalid queries and failures other than the event not
+// happening are not cached.
+func (o Observer) cachedEventTime(t time.Time, kind EventKind) (time.Time, error) {
+	local := o.local(t)
+	key := cacheKey{kind: kind, loc: local.Location(), lat: o.Lat, lon: o.Lon, elev: o.Elevation}
+	key.year, key.month, key.day = local.Date()
+	if e, ok := o.cache.get(key); ok {
+		return e.time, e.err
+	}
+	s, err := o.eventTime(t, kind)
+	if err == nil || isNoEvent(err) {
+		o.cache.add(cacheEntry{key: key, time: s, err: err})
+	}
+	return s, err
+}
@@ -0,0 +1,80 @@
+package astrotime
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithCache(t *testing.T) {
+	day := p("2024-06-20T09:00:00+02:00")
+	plain := NewObserver(48.85, 2.35)
+	o := NewObserver(48.85, 2.35, WithCache(4))
+	for _, kind := range allEventKinds {
+		want, werr := plain.EventTime(day, kind)
+		for range 2 {
+			got, err := o.EventTime(day, kind)
+			if !got.Equal(want) || err != werr {
+				t.Errorf("%v: got %s, %v, want %s, %v", kind, got, err, want, werr)
+			}
+		}
+	}
+	if n := o.cache.order.Len(); n != 4 {
+		t.Errorf("cache holds %d entries, want 4", n)
+	}
+
+	// A cached time is returned whatever the time of day of the query.
+	rise, _ := o.Sunrise(day)
+	if again, _ := o.Sunrise(day.Add(12 * time.Hour)); !again.Equal(rise) {
+		t.Errorf("cached sunrise %s, want %s", again, rise)
+	}
+	// A change of place, day or time zone is a different entry.
+	moved := o
+	moved.Lat = 60
+	if s, _ := moved.Sunrise(day); s.Equal(rise) {
+		t.Error("sunrise at another latitude came from the cache")
+	}
+	if s, _ := o.Sunrise(day.AddDate(0, 0, 1)); s.Equal(rise) {
+		t.Error("sunrise on another day came from the cache")
+	}
+	if s, _ := o.Sunrise(day.UTC().Add(-9 * time.Hour)); s.Location() != time.UTC {
+		t.Errorf("sunrise queried in UTC is in %v", s.Location())
+	}
+
+	// The polar night is cached too, but not invalid queries.
+	polar := NewObserver(80, 0, WithCache(2))
+	for range 2 {
+		if _, err := polar.Sunrise(p("2024-12-21T00:00:00Z")); err != ErrAlwaysBelow {
+			t.Errorf("got %v, want ErrAlwaysBelow", err)
+		}
+	}
+	polar.Lat = 91
+	if _, err := polar.Sunrise(p("2024-12-21T00:00:00Z")); err == nil {
+		t.Error("latitude 91 accepted")
+	}
+	if n := polar.cache.order.Len(); n != 1 {
+		t.Errorf("cache holds %d entries, want 1", n)
+	}
+
+	if NewObserver(0, 0, WithCache(4), WithCache(0)).cache != nil {
+		t.Error("WithCache(0) did not remove the cache")
+	}
+}
+
+func TestWithCacheConcurrent(t *testing.T) {
+	o := NewObserver(51.5, -0.1, WithCache(16))
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for d := range 64 {
+				o.Sunset(p("2024-01-01T12:00:00Z").AddDate(0, 0, (d+i)%32))
+			}
+		}()
+	}
+	wg.Wait()
+	if n := o.cache.order.Len(); n != 16 {
+		t.Errorf("cache holds %d entries, want 16", n)
+	}
+}
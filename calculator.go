@@ -0,0 +1,85 @@
+package astrotime
+
+import (
+	"fmt"
+	"time"
+)
+
+// A Calculator answers queries about the sun at many places on one day,
+// such as for map tiles and grids, working out what does not depend on the
+// place once: the day, the observer's settings and, for each query, the
+// sun's position at the place's solar noon, shared by all its events. Its
+// results are the same as an Observer's with the same options at each
+// place. Like an Observer it is never changed once made, and is safe for
+// concurrent use.
+type Calculator struct {
+	d sunDay
+}
+
+// NewCalculator returns a Calculator for the day t, in t's location,
+// configured by opts as for NewObserver.
+func NewCalculator(t time.Time, opts ...Option) (*Calculator, error) {
+	if err := ValidateDate(t); err != nil {
+		return nil, err
+	}
+	return &Calculator{d: NewObserver(0, 0, opts...).onDay(t)}, nil
+}
+
+// Sunrise calculates the sunrise at the latitude and longitude.
+func (c *Calculator) Sunrise(latitude, longitude float64) (time.Time, error) {
+	return c.EventTime(latitude, longitude, EventSunrise)
+}
+
+// Sunset calculates the sunset at the latitude and longitude.
+func (c *Calculator) Sunset(latitude, longitude float64) (time.Time, error) {
+	return c.EventTime(latitude, longitude, EventSunset)
+}
+
+// EventTime calculates the time of the event kind at the latitude and
+// longitude.
+func (c *Calculator) EventTime(latitude, longitude float64, kind EventKind) (time.Time, error) {
+	if err := ValidateCoordinates(latitude, longitude); err != nil {
+		return time.Time{}, err
+	}
+	return c.event(c.d.noon(longitude), latitude, longitude, kind)
+}
+
+// Events returns the events of kinds, or of every kind if none are given,
+// at the latitude and longitude in chronological order, skipping those
+// that do not happen that day.
+func (c *Calculator) Events(latitude, longitude float64, kinds ...EventKind) ([]Event, error) {
+	if err := ValidateCoordinates(latitude, longitude); err != nil {
+		return nil, err
+	}
+	if len(kinds) == 0 {
+		kinds = allEventKinds
+	}
+	noon := c.d.noon(longitude)
+	events := make([]Event, 0, len(kinds))
+	for _, kind := range kinds {
+		s, err := c.event(noon, latitude, longitude, kind)
+		if isNoEvent(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, Event{Kind: kind, Time: s})
+	}
+	sortEvents(events)
+	return events, nil
+}
+
+// event calculates the event kind at the latitude and longitude, whose
+// noon terms are n.
+func (c *Calculator) event(n noonTerms, latitude, longitude float64, kind EventKind) (time.Time, error) {
+	if kind < EventAstronomicalDawn || kind > EventAstronomicalDusk {
+		return time.Time{}, fmt.Errorf("astrotime: unknown event kind %v", kind)
+	}
+	if kind == EventSolarNoon {
+		o := c.d.o
+		o.Lat, o.Lon = latitude, longitude
+		return o.round(o.solarNoon(c.d.t)), nil
+	}
+	return c.d.crossing(n, latitude, longitude, c.d.o.eventZenith(kind), kind < EventSolarNoon)
+}
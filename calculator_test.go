@@ -0,0 +1,59 @@
+package astrotime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCalculator(t *testing.T) {
+	places := []LatLon{{51.5, -0.1}, {-33.9, 151.2}, {64.1, -21.9}, {78.2, 15.6}, {-77.8, 166.7}, {0, 180}}
+	for _, opts := range [][]Option{
+		nil,
+		{WithElevation(300), WithDeltaT(true), WithIterations(6, 0)},
+		{WithAlgorithm(AlgorithmVSOP87)},
+	} {
+		for _, day := range []string{"2024-06-20T06:00:00+02:00", "2024-09-22T00:00:00Z", "2024-12-21T18:00:00-05:00"} {
+			c, err := NewCalculator(p(day), opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, pl := range places {
+				o := NewObserver(pl.Lat, pl.Lon, opts...)
+				var want []Event
+				for _, kind := range allEventKinds {
+					s, err := c.EventTime(pl.Lat, pl.Lon, kind)
+					ws, werr := o.EventTime(p(day), kind)
+					if !s.Equal(ws) || err != werr {
+						t.Errorf("%s at %+v %v: got %s, %v, want %s, %v", day, pl, kind, s, err, ws, werr)
+					}
+					if werr == nil {
+						want = append(want, Event{Kind: kind, Time: ws})
+					}
+				}
+				sortEvents(want)
+				events, err := c.Events(pl.Lat, pl.Lon)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(events) != len(want) {
+					t.Fatalf("%s at %+v: got %d events, want %d", day, pl, len(events), len(want))
+				}
+				for i := range events {
+					if events[i] != want[i] {
+						t.Errorf("%s at %+v: event %d is %v, want %v", day, pl, i, events[i], want[i])
+					}
+				}
+			}
+		}
+	}
+	c, _ := NewCalculator(p("2024-06-20T00:00:00Z"))
+	if _, err := c.Sunrise(91, 0); !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("got %v, want ErrInvalidCoordinates", err)
+	}
+	if _, err := c.EventTime(0, 0, EventKind(99)); err == nil {
+		t.Error("unknown event kind accepted")
+	}
+	if _, err := NewCalculator(p("3500-01-01T00:00:00Z")); !errors.Is(err, ErrDateOutOfRange) {
+		t.Errorf("got %v, want ErrDateOutOfRange", err)
+	}
+}
@@ -0,0 +1,96 @@
+package astrotime
+
+import (
+	"strconv"
+	"time"
+)
+
+// Calendar is a calendar in which historical dates are written. The time
+// package, and so every calculation here, uses the proleptic Gregorian
+// calendar; a Calendar converts dates from older sources to and from it.
+type Calendar int
+
+const (
+	// Gregorian is the proleptic Gregorian calendar of the time package.
+	Gregorian Calendar = iota
+	// Julian is the proleptic Julian calendar.
+	Julian
+	// Historical is the Julian calendar until 4 October 1582 and the
+	// Gregorian calendar from the next day, 15 October 1582, as adopted in
+	// Rome. Dates in the gap between them are read as Julian.
+	Historical
+)
+
+var calendarNames = [...]string{
+	Gregorian:  "Gregorian",
+	Julian:     "Julian",
+	Historical: "Historical",
+}
+
+func (c Calendar) String() string {
+	if c < 0 || int(c) >= len(calendarNames) {
+		return "Calendar(" + strconv.Itoa(int(c)) + ")"
+	}
+	return calendarNames[c]
+}
+
+// gregorianReform is the day number of 15 October 1582, the first day of
+// the Gregorian calendar.
+const gregorianReform = 2299161
+
+// Time returns the Time of the date and time of day, given in the
+// calendar, in loc. As with time.Date, values outside their usual ranges
+// are normalized.
+func (c Calendar) Time(year int, month time.Month, day, hour, min, sec, nsec int, loc *time.Location) time.Time {
+	m := int(month) - 1
+	year += floorDiv(m, 12)
+	month = time.Month(m - 12*floorDiv(m, 12) + 1)
+	gregorian := julianDayNumber(year, month, day)
+	if c == Julian || c == Historical && gregorian < gregorianReform {
+		day += julianCalendarDayNumber(year, month, day) - gregorian
+	}
+	return time.Date(year, month, day, hour, min, sec, nsec, loc)
+}
+
+// Date returns the date of t, in its location, in the calendar.
+func (c Calendar) Date(t time.Time) (year int, month time.Month, day int) {
+	year, month, day = t.Date()
+	jdn := julianDayNumber(year, month, day)
+	if c == Gregorian || c == Historical && jdn >= gregorianReform {
+		return year, month, day
+	}
+	// Richards' algorithm (Explanatory Supplement, 2013, §15.11).
+	e := 4*(jdn+1401) + 3
+	h := 5*(e%1461/4) + 2
+	day = h%153/5 + 1
+	m := (h/153+2)%12 + 1
+	year = e/1461 - 4716 + (14-m)/12
+	return year, time.Month(m), day
+}
+
+// julianDayNumber returns the number of the Julian day beginning at noon
+// on the proleptic Gregorian date.
+func julianDayNumber(year int, month time.Month, day int) int {
+	a := (14 - int(month)) / 12
+	y := year + 4800 - a
+	m := int(month) + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// julianCalendarDayNumber returns the number of the Julian day beginning
+// at noon on the proleptic Julian date.
+func julianCalendarDayNumber(year int, month time.Month, day int) int {
+	a := (14 - int(month)) / 12
+	y := year + 4800 - a
+	m := int(month) + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - 32083
+}
+
+// floorDiv divides a by b, rounding towards negative infinity.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,52 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalendarTime(t *testing.T) {
+	tests := []struct {
+		c                Calendar
+		year, month, day int
+		want             string
+	}{
+		{Gregorian, 2017, 7, 10, "2017-07-10"},
+		{Julian, 1582, 10, 4, "1582-10-14"},
+		{Historical, 1582, 10, 4, "1582-10-14"},
+		{Historical, 1582, 10, 15, "1582-10-15"},
+		{Julian, 1700, 2, 29, "1700-03-11"},
+		{Julian, 1700, 3, 1, "1700-03-12"},
+		{Julian, 2000, 1, 1, "2000-01-14"},
+		{Julian, 200, 3, 1, "0200-03-01"},
+		{Julian, 100, 1, 1, "0099-12-30"},
+		{Julian, 1582, 22, 4, "1583-10-14"}, // normalized month
+	}
+	for _, tt := range tests {
+		got := tt.c.Time(tt.year, time.Month(tt.month), tt.day, 0, 0, 0, 0, time.UTC)
+		if s := got.Format("2006-01-02"); s != tt.want {
+			t.Errorf("%v %d-%02d-%02d: got %s, want %s", tt.c, tt.year, tt.month, tt.day, s, tt.want)
+		}
+		year, month, day := tt.c.Date(got)
+		if tt.month <= 12 && (year != tt.year || int(month) != tt.month || day != tt.day) {
+			t.Errorf("%v date of %s: got %d-%02d-%02d, want %d-%02d-%02d", tt.c, got.Format("2006-01-02"), year, month, day, tt.year, tt.month, tt.day)
+		}
+	}
+}
+
+func TestCalendarJulianDate(t *testing.T) {
+	// Meeus example 7.b: 333 January 27.5 in the Julian calendar.
+	got := julianDate(Julian.Time(333, time.January, 27, 12, 0, 0, 0, time.UTC))
+	if got != 1842713 {
+		t.Errorf("got JD %v, want 1842713", got)
+	}
+}
+
+func TestCalendarString(t *testing.T) {
+	if got := Historical.String(); got != "Historical" {
+		t.Errorf("got %q, want Historical", got)
+	}
+	if got := Calendar(7).String(); got != "Calendar(7)" {
+		t.Errorf("got %q, want Calendar(7)", got)
+	}
+}
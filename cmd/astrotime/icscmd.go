@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dntj/astrotime"
+	"github.com/dntj/astrotime/ics"
+	"github.com/dntj/astrotime/table"
+)
+
+// runICS writes an iCalendar of the sun's events over a range of days.
+func runICS(args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("ics", stderr)
+	var place placeFlags
+	place.register(fs)
+	from := fs.String("from", "", "first day as 2006-01-02 (default today)")
+	to := fs.String("to", "", "last day as 2006-01-02 (default a year after --from, less a day)")
+	events := fs.String("events", "sunrise,sunset", "comma-separated events, of "+strings.Join(eventNames(), ", "))
+	name := fs.String("name", "", "name of the calendar in calendar applications")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments %q", fs.Args())
+	}
+	o, err := place.observer(fs)
+	if err != nil {
+		return err
+	}
+	start, err := parseDate(*from, o.Location)
+	if err != nil {
+		return err
+	}
+	end := start.AddDate(1, 0, -1)
+	if *to != "" {
+		if end, err = parseDate(*to, o.Location); err != nil {
+			return err
+		}
+	}
+	if end.Before(start) {
+		return fmt.Errorf("--to %s is before --from", *to)
+	}
+	kinds, err := parseEvents(*events)
+	if err != nil {
+		return err
+	}
+	c := ics.Calendar{Observer: o, Start: start, End: end, Kinds: kinds, Name: *name, Stamp: now()}
+	return c.Encode(stdout)
+}
+
+// parseEvents parses a comma-separated list of events named as the columns
+// of a table.
+func parseEvents(s string) ([]astrotime.EventKind, error) {
+	cols, err := table.ParseColumns(s)
+	if err != nil {
+		return nil, err
+	}
+	kinds := make([]astrotime.EventKind, len(cols))
+	for i, c := range cols {
+		kind, ok := c.Event()
+		if !ok {
+			return nil, fmt.Errorf("%s is not an event", c)
+		}
+		kinds[i] = kind
+	}
+	return kinds, nil
+}
+
+// eventNames returns the names of the columns of a table which are events.
+func eventNames() []string {
+	var names []string
+	for _, c := range table.AllColumns {
+		if _, ok := c.Event(); ok {
+			names = append(names, c.String())
+		}
+	}
+	return names
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestICS(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time { return time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC) }
+
+	var out bytes.Buffer
+	err := run([]string{"ics", "--lat", "51.4769", "--lon", "-0.0005", "--tz", "Europe/London", "--from", "2024-06-20", "--to", "2024-06-21", "--events", "sunset", "--name", "Sunset at home"}, &out, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"X-WR-CALNAME:Sunset at home\r\n",
+		"TZID:Europe/London\r\n",
+		"DTSTAMP:20240601T120000Z\r\n",
+		"DTSTART;TZID=Europe/London:20240620T212048\r\n",
+		"SUMMARY:Sunset\r\n",
+	} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("output lacks %q", s)
+		}
+	}
+	if n := strings.Count(out.String(), "BEGIN:VEVENT"); n != 2 {
+		t.Errorf("got %d events, want 2", n)
+	}
+}
+
+func TestICSDefaultRange(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time { return time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC) }
+
+	var out bytes.Buffer
+	if err := run([]string{"ics", "--lat", "0", "--lon", "0", "--tz", "UTC"}, &out, &out); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(out.String(), "BEGIN:VEVENT"); n != 2*366 {
+		t.Errorf("got %d events, want %d", n, 2*366)
+	}
+	for _, args := range [][]string{
+		{"ics", "--lat", "0", "--lon", "0", "--events", "sunrise,day_length"},
+		{"ics", "--lat", "0", "--lon", "0", "--events", "moonrise"},
+		{"ics", "--lat", "0", "--lon", "0", "--from", "2024-02-01", "--to", "2024-01-01"},
+		{"ics", "--lat", "0", "--lon", "0", "--to", "tomorrow"},
+	} {
+		if err := run(args, &out, &out); err == nil {
+			t.Errorf("run(%q) succeeded", args)
+		}
+	}
+}
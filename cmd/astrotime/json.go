@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dntj/astrotime"
+	"github.com/dntj/astrotime/table"
+)
+
+// formatFlag defines the --format flag on fs, taking one of the formats,
+// the first by default.
+func formatFlag(fs *flag.FlagSet, formats ...string) *string {
+	return fs.String("format", formats[0], "output format, of "+strings.Join(formats, ", "))
+}
+
+// checkFormat checks that the value of --format is one of the formats.
+func checkFormat(format string, formats ...string) error {
+	if !slices.Contains(formats, format) {
+		return fmt.Errorf("unknown format %q; want one of %s", format, strings.Join(formats, ", "))
+	}
+	return nil
+}
+
+// writeJSON writes v to w as indented JSON.
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// placeJSON describes the observer in JSON output.
+type placeJSON struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Elevation float64 `json:"elevation,omitempty"`
+	TimeZone  string  `json:"time_zone"`
+}
+
+// placeJSONOf returns the placeJSON for o.
+func placeJSONOf(o astrotime.Observer) placeJSON {
+	return placeJSON{Latitude: o.Lat, Longitude: o.Lon, Elevation: o.Elevation, TimeZone: zoneName(o.Location)}
+}
+
+// zoneName names the time zone for JSON output: by its IANA name or, for a
+// local time zone without one, by its abbreviation and offset now, such as
+// "CET +01:00".
+func zoneName(loc *time.Location) string {
+	if loc.String() != "Local" {
+		return loc.String()
+	}
+	return now().In(loc).Format("MST -07:00")
+}
+
+// eventJSON is an event in JSON output: its kind, named as the table
+// column, and either its time in RFC 3339 form, with the offset of the
+// time zone, or whether the sun stays "above" or "below" all day.
+type eventJSON struct {
+	Kind  string     `json:"kind"`
+	Time  *time.Time `json:"time,omitempty"`
+	Stays string     `json:"stays,omitempty"`
+}
+
+// eventsJSON returns the events of the row in the columns, skipping those
+// which are not events.
+func eventsJSON(r table.Row, cols []table.Column) []eventJSON {
+	events := []eventJSON{}
+	for _, c := range cols {
+		kind, ok := c.Event()
+		if !ok {
+			continue
+		}
+		ev := eventJSON{Kind: c.String()}
+		switch t, err := r.Time(kind); {
+		case err == nil:
+			ev.Time = &t
+		case errors.Is(err, astrotime.ErrAlwaysAbove):
+			ev.Stays = "above"
+		default:
+			ev.Stays = "below"
+		}
+		events = append(events, ev)
+	}
+	return events
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+	"github.com/dntj/astrotime/table"
+)
+
+func TestEventsJSON(t *testing.T) {
+	o := astrotime.Observer{Lat: 78.22, Lon: 15.65, Location: time.UTC}
+	day := time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC)
+	var row table.Row
+	if err := table.Generate(o, day, day, func(r table.Row) error { row = r; return nil }); err != nil {
+		t.Fatal(err)
+	}
+	got := eventsJSON(row, []table.Column{table.Date, table.Sunrise, table.SolarNoon, table.DayLength})
+	if len(got) != 2 {
+		t.Fatalf("got %d events, want 2", len(got))
+	}
+	if got[0].Kind != "sunrise" || got[0].Time != nil || got[0].Stays != "below" {
+		t.Errorf("got %+v, want sunrise staying below", got[0])
+	}
+	if got[1].Kind != "solar_noon" || got[1].Time == nil || got[1].Stays != "" {
+		t.Errorf("got %+v, want solar noon with a time", got[1])
+	}
+}
+
+func TestCheckFormat(t *testing.T) {
+	for _, tt := range []struct {
+		format string
+		ok     bool
+	}{
+		{"text", true},
+		{"json", true},
+		{"csv", false},
+		{"JSON", false},
+		{"", false},
+	} {
+		if err := checkFormat(tt.format, "text", "json"); (err == nil) != tt.ok {
+			t.Errorf("checkFormat(%q) = %v", tt.format, err)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Command astrotime prints the times of solar events for a place.
+//
+// Usage:
+//
+//	astrotime sun --lat 51.48 --lon -0.01 [--date 2024-06-20] [--tz Europe/London]
+//	astrotime table --lat 51.48 --lon -0.01 [--month 2024-06 | --year 2024] [--columns date,sunrise,sunset] [--format csv]
+//	astrotime ics --lat 51.48 --lon -0.01 [--from 2024-06-01] [--to 2024-12-31] [--events sunset] [--name "Sunset at home"]
+//
+// The sun subcommand prints dawn and dusk at each depth of twilight,
+// sunrise, solar noon, sunset and the length of the day. The table
+// subcommand prints a row of chosen columns for each day of a month, this
+// month by default, or of a year. The ics subcommand writes an iCalendar
+// file of chosen events over a range of days, a year from today by
+// default, which calendar applications can import or subscribe to. Dates
+// are read, and times printed, in the time zone given by --tz, the local
+// time zone by default; the date defaults to today.
+//
+// With --format json, the sun and table subcommands write their results as
+// JSON instead: times in RFC 3339 form with the offset of the time zone,
+// which is named alongside by its IANA name, taken for the local time zone
+// from $TZ or /etc/localtime, and events identified by the names of the
+// table columns, such as "civil_dawn". The table subcommand also takes
+// --format csv, for spreadsheets.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// now returns the current time; tests replace it.
+var now = time.Now
+
+// commands maps each subcommand to the function running it with its
+// arguments.
+var commands = map[string]func(args []string, stdout, stderr io.Writer) error{
+	"ics":   runICS,
+	"sun":   runSun,
+	"table": runTable,
+}
+
+func main() {
+	err := run(os.Args[1:], os.Stdout, os.Stderr)
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+	default:
+		fmt.Fprintln(os.Stderr, "astrotime:", err)
+		os.Exit(1)
+	}
+}
+
+// run runs the subcommand named by the first of args.
+func run(args []string, stdout, stderr io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no subcommand; want one of %s", strings.Join(commandNames(), ", "))
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown subcommand %q; want one of %s", args[0], strings.Join(commandNames(), ", "))
+	}
+	return cmd(args[1:], stdout, stderr)
+}
+
+// commandNames returns the names of the subcommands in order.
+func commandNames() []string {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// placeFlags are the flags choosing the observer and time zone, shared by
+// the subcommands.
+type placeFlags struct {
+	lat, lon, elevation float64
+	tz                  string
+}
+
+// register defines the flags on fs.
+func (p *placeFlags) register(fs *flag.FlagSet) {
+	fs.Float64Var(&p.lat, "lat", 0, "latitude in degrees, north positive")
+	fs.Float64Var(&p.lon, "lon", 0, "longitude in degrees, east positive")
+	fs.Float64Var(&p.elevation, "elevation", 0, "height above sea level in meters")
+	fs.StringVar(&p.tz, "tz", "", "IANA time zone for dates and times (default local)")
+}
+
+// observer returns the observer the flags describe, after checking that
+// the latitude and longitude were given.
+func (p *placeFlags) observer(fs *flag.FlagSet) (astrotime.Observer, error) {
+	set := map[string]bool{}
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	if !set["lat"] || !set["lon"] {
+		return astrotime.Observer{}, errors.New("--lat and --lon are required")
+	}
+	if err := astrotime.ValidateCoordinates(p.lat, p.lon); err != nil {
+		return astrotime.Observer{}, err
+	}
+	loc, err := p.location()
+	if err != nil {
+		return astrotime.Observer{}, err
+	}
+	o := astrotime.NewObserver(p.lat, p.lon, astrotime.WithElevation(p.elevation))
+	o.Location = loc
+	return o, nil
+}
+
+// location returns the time zone named by --tz, or the local time zone.
+func (p *placeFlags) location() (*time.Location, error) {
+	if p.tz == "" {
+		return localZone(), nil
+	}
+	return time.LoadLocation(p.tz)
+}
+
+// localtime is the system's link to its time zone file.
+var localtime = "/etc/localtime"
+
+// localZone returns the local time zone under its IANA name, which
+// time.Local does not carry, as the time package finds it: from $TZ or
+// the zone file /etc/localtime links to. It returns time.Local if neither
+// names a zone.
+func localZone() *time.Location {
+	name, ok := os.LookupEnv("TZ")
+	name = strings.TrimPrefix(name, ":")
+	if !ok {
+		target, err := os.Readlink(localtime)
+		if _, zone, found := strings.Cut(target, "zoneinfo/"); err == nil && found {
+			name = zone
+		}
+	}
+	if name == "" || strings.HasPrefix(name, "/") {
+		return time.Local
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// parseDate parses a date in the form 2006-01-02 in loc, or returns today
+// there if s is empty.
+func parseDate(s string, loc *time.Location) (time.Time, error) {
+	if s == "" {
+		y, m, d := now().In(loc).Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, loc), nil
+	}
+	return time.ParseInLocation("2006-01-02", s, loc)
+}
+
+// newFlagSet returns a flag set for the subcommand that reports errors
+// rather than exiting, writing usage to stderr.
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet("astrotime "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	return fs
+}
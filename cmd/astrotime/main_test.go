@@ -0,0 +1,75 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseDate(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time { return time.Date(2024, 6, 20, 20, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		s    string
+		want time.Time
+	}{
+		{"2024-01-31", time.Date(2024, 1, 31, 0, 0, 0, 0, tokyo)},
+		// Today is already the 21st in Tokyo.
+		{"", time.Date(2024, 6, 21, 0, 0, 0, 0, tokyo)},
+	}
+	for _, tt := range tests {
+		got, err := parseDate(tt.s, tokyo)
+		if err != nil || !got.Equal(tt.want) || got.Location() != tokyo {
+			t.Errorf("parseDate(%q) = %s, %v, want %s", tt.s, got, err, tt.want)
+		}
+	}
+	if _, err := parseDate("2024-02-30", tokyo); err == nil {
+		t.Error("parseDate accepted February 30")
+	}
+}
+
+func TestLocalZone(t *testing.T) {
+	if _, err := time.LoadLocation("Europe/Oslo"); err != nil {
+		t.Skip(err)
+	}
+	defer func(s string) { localtime = s }(localtime)
+	link := filepath.Join(t.TempDir(), "localtime")
+	if err := os.Symlink("/usr/share/zoneinfo/Asia/Tokyo", link); err != nil {
+		t.Skip(err)
+	}
+	localtime = link
+
+	t.Setenv("TZ", "Europe/Oslo")
+	if got := localZone().String(); got != "Europe/Oslo" {
+		t.Errorf("TZ=Europe/Oslo: got %q", got)
+	}
+	t.Setenv("TZ", ":Europe/Oslo")
+	if got := localZone().String(); got != "Europe/Oslo" {
+		t.Errorf("TZ=:Europe/Oslo: got %q", got)
+	}
+	t.Setenv("TZ", "")
+	if got := localZone(); got != time.Local {
+		t.Errorf("TZ=: got %q, want Local", got)
+	}
+	os.Unsetenv("TZ")
+	if got := localZone().String(); got != "Asia/Tokyo" {
+		t.Errorf("/etc/localtime to Asia/Tokyo: got %q", got)
+	}
+}
+
+func TestZoneName(t *testing.T) {
+	defer func(f func() time.Time) { now = f }(now)
+	now = func() time.Time { return time.Date(2024, 6, 20, 20, 0, 0, 0, time.UTC) }
+	if got := zoneName(time.UTC); got != "UTC" {
+		t.Errorf("got %q, want UTC", got)
+	}
+	if got, want := zoneName(time.Local), now().In(time.Local).Format("MST -07:00"); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
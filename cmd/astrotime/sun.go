@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dntj/astrotime"
+	"github.com/dntj/astrotime/table"
+)
+
+// runSun prints the solar events of a day.
+func runSun(args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("sun", stderr)
+	var place placeFlags
+	place.register(fs)
+	date := fs.String("date", "", "date as 2006-01-02 (default today)")
+	format := formatFlag(fs, "text", "json")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments %q", fs.Args())
+	}
+	if err := checkFormat(*format, "text", "json"); err != nil {
+		return err
+	}
+	o, err := place.observer(fs)
+	if err != nil {
+		return err
+	}
+	day, err := parseDate(*date, o.Location)
+	if err != nil {
+		return err
+	}
+	var row table.Row
+	if err := table.Generate(o, day, day, func(r table.Row) error { row = r; return nil }); err != nil {
+		return err
+	}
+
+	if *format == "json" {
+		return writeJSON(stdout, sunJSON{
+			placeJSON: placeJSONOf(o),
+			Date:      row.Date.Format("2006-01-02"),
+			Events:    eventsJSON(row, table.AllColumns),
+			DayLength: row.DayLength().Seconds(),
+		})
+	}
+	w := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Date\t%s %s\n", row.Date.Format("2006-01-02"), row.Date.Location())
+	for _, c := range table.AllColumns {
+		kind, ok := c.Event()
+		if !ok {
+			continue
+		}
+		s, err := row.Time(kind)
+		switch {
+		case err == nil:
+			fmt.Fprintf(w, "%s\t%s\n", kind.Label(), s.Format("15:04:05 MST"))
+		case errors.Is(err, astrotime.ErrAlwaysAbove):
+			fmt.Fprintf(w, "%s\tnone, sun stays above\n", kind.Label())
+		default:
+			fmt.Fprintf(w, "%s\tnone, sun stays below\n", kind.Label())
+		}
+	}
+	fmt.Fprintf(w, "Day length\t%s\n", formatDayLength(row.DayLength()))
+	return w.Flush()
+}
+
+// sunJSON is the output of the sun subcommand in JSON.
+type sunJSON struct {
+	placeJSON
+	Date      string      `json:"date"`
+	Events    []eventJSON `json:"events"`
+	DayLength float64     `json:"day_length_seconds"`
+}
+
+// formatDayLength formats d as hours and minutes, such as 16h38m.
+func formatDayLength(d time.Duration) string {
+	d = d.Round(time.Minute)
+	return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSun(t *testing.T) {
+	var out bytes.Buffer
+	err := run([]string{"sun", "--lat", "51.4769", "--lon", "-0.0005", "--date", "2024-06-20", "--tz", "Europe/London"}, &out, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `Date               2024-06-20 Europe/London
+Astronomical dawn  none, sun stays above
+Nautical dawn      02:40:30 BST
+Civil dawn         03:54:56 BST
+Sunrise            04:42:38 BST
+Solar noon         13:01:36 BST
+Sunset             21:20:48 BST
+Civil dusk         22:08:30 BST
+Nautical dusk      23:22:57 BST
+Astronomical dusk  none, sun stays above
+Day length         16h38m
+`
+	if out.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func TestSunPolar(t *testing.T) {
+	var out bytes.Buffer
+	if err := run([]string{"sun", "--lat", "78.22", "--lon", "15.65", "--date", "2024-12-21", "--tz", "UTC"}, &out, &out); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{"Sunrise            none, sun stays below", "Day length         0h00m"} {
+		if !strings.Contains(out.String(), line) {
+			t.Errorf("output lacks %q:\n%s", line, out.String())
+		}
+	}
+}
+
+func TestSunJSON(t *testing.T) {
+	var out bytes.Buffer
+	err := run([]string{"sun", "--lat", "51.4769", "--lon", "-0.0005", "--date", "2024-06-20", "--tz", "Europe/London", "--format", "json"}, &out, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		TimeZone string `json:"time_zone"`
+		Date     string `json:"date"`
+		Events   []struct {
+			Kind  string `json:"kind"`
+			Time  string `json:"time"`
+			Stays string `json:"stays"`
+		} `json:"events"`
+		DayLength float64 `json:"day_length_seconds"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("%v in %s", err, out.String())
+	}
+	if got.TimeZone != "Europe/London" || got.Date != "2024-06-20" {
+		t.Errorf("got time zone %q, date %q, want Europe/London, 2024-06-20", got.TimeZone, got.Date)
+	}
+	if len(got.Events) != 9 {
+		t.Fatalf("got %d events, want 9", len(got.Events))
+	}
+	for _, tt := range []struct {
+		i                 int
+		kind, time, stays string
+	}{
+		{0, "astronomical_dawn", "", "above"},
+		{3, "sunrise", "2024-06-20T04:42:38+01:00", ""},
+		{5, "sunset", "2024-06-20T21:20:48+01:00", ""},
+	} {
+		ev := got.Events[tt.i]
+		if ev.Kind != tt.kind || ev.Time != tt.time || ev.Stays != tt.stays {
+			t.Errorf("event %d is %+v, want %s %q %q", tt.i, ev, tt.kind, tt.time, tt.stays)
+		}
+	}
+	if got.DayLength != 59890 {
+		t.Errorf("got day length %v, want 59890", got.DayLength)
+	}
+}
+
+func TestSunErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"sun", "--lat", "51.5"},
+		{"sun", "--lat", "91", "--lon", "0"},
+		{"sun", "--lat", "51.5", "--lon", "0", "--date", "20 June"},
+		{"sun", "--lat", "51.5", "--lon", "0", "--tz", "Mars/Olympus"},
+		{"sun", "--lat", "51.5", "--lon", "0", "extra"},
+		{"sun", "--lat", "51.5", "--lon", "0", "--format", "yaml"},
+		{"moon"},
+		{},
+	} {
+		var out bytes.Buffer
+		if err := run(args, &out, &out); err == nil {
+			t.Errorf("run(%q) succeeded", args)
+		}
+	}
+}
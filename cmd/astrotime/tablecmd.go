@@ -0,0 +1,170 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dntj/astrotime"
+	"github.com/dntj/astrotime/table"
+)
+
+// runTable prints a table of the sun's events for a month or a year.
+func runTable(args []string, stdout, stderr io.Writer) error {
+	fs := newFlagSet("table", stderr)
+	var place placeFlags
+	place.register(fs)
+	year := fs.Int("year", 0, "print a table for the whole year")
+	month := fs.String("month", "", "print a table for the month, as 2006-01 (default this month)")
+	columns := fs.String("columns", "", "comma-separated columns, of "+strings.Join(columnNames(table.AllColumns), ", "))
+	format := formatFlag(fs, "text", "json", "csv")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments %q", fs.Args())
+	}
+	if err := checkFormat(*format, "text", "json", "csv"); err != nil {
+		return err
+	}
+	o, err := place.observer(fs)
+	if err != nil {
+		return err
+	}
+	cols := table.DefaultColumns
+	if *columns != "" {
+		if cols, err = table.ParseColumns(*columns); err != nil {
+			return err
+		}
+	}
+
+	var start, end time.Time
+	switch {
+	case *year != 0 && *month != "":
+		return errors.New("--year and --month are exclusive")
+	case *year != 0:
+		start = time.Date(*year, time.January, 1, 0, 0, 0, 0, o.Location)
+		end = start.AddDate(1, 0, -1)
+	default:
+		m := now().In(o.Location)
+		if *month != "" {
+			if m, err = time.ParseInLocation("2006-01", *month, o.Location); err != nil {
+				return err
+			}
+		}
+		start = time.Date(m.Year(), m.Month(), 1, 0, 0, 0, 0, o.Location)
+		end = start.AddDate(0, 1, -1)
+	}
+	if *format == "csv" {
+		w := table.NewCSVWriter(stdout, cols)
+		if err := table.Generate(o, start, end, w.Write); err != nil {
+			return err
+		}
+		return w.Flush()
+	}
+
+	var rows []table.Row
+	err = table.Generate(o, start, end, func(r table.Row) error {
+		rows = append(rows, r)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if *format == "json" {
+		return writeJSON(stdout, tableJSONOf(o, cols, rows))
+	}
+	return printTable(stdout, cols, rows)
+}
+
+// tableJSON is the output of the table subcommand in JSON.
+type tableJSON struct {
+	placeJSON
+	Columns []string  `json:"columns"`
+	Rows    []rowJSON `json:"rows"`
+}
+
+// rowJSON is a day of the table in JSON, with the events chosen; the day
+// length is left out unless chosen.
+type rowJSON struct {
+	Date      string      `json:"date"`
+	Events    []eventJSON `json:"events"`
+	DayLength *float64    `json:"day_length_seconds,omitempty"`
+}
+
+// tableJSONOf returns the tableJSON for the columns of rows.
+func tableJSONOf(o astrotime.Observer, cols []table.Column, rows []table.Row) tableJSON {
+	t := tableJSON{placeJSON: placeJSONOf(o), Columns: columnNames(cols), Rows: make([]rowJSON, len(rows))}
+	for i, r := range rows {
+		row := rowJSON{Date: r.Date.Format("2006-01-02"), Events: eventsJSON(r, cols)}
+		if slices.Contains(cols, table.DayLength) {
+			d := r.DayLength().Seconds()
+			row.DayLength = &d
+		}
+		t.Rows[i] = row
+	}
+	return t
+}
+
+// printTable prints the columns of rows aligned, with a header, marking
+// events that do not happen with **** if the sun stays above the horizon
+// or twilight altitude and ---- if it stays below.
+func printTable(w io.Writer, cols []table.Column, rows []table.Row) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(columnNames(cols), "\t"))
+	var above, below bool
+	for _, r := range rows {
+		cells := make([]string, len(cols))
+		for i, c := range cols {
+			cell, err := formatCell(r, c)
+			switch {
+			case errors.Is(err, astrotime.ErrAlwaysAbove):
+				cell, above = "****", true
+			case errors.Is(err, astrotime.ErrAlwaysBelow):
+				cell, below = "----", true
+			}
+			cells[i] = cell
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if above {
+		fmt.Fprintln(w, "**** sun continuously above the horizon or twilight altitude")
+	}
+	if below {
+		fmt.Fprintln(w, "---- sun continuously below the horizon or twilight altitude")
+	}
+	return nil
+}
+
+// formatCell formats the column of the row for people: times to the
+// nearest minute, in the row's time zone.
+func formatCell(r table.Row, c table.Column) (string, error) {
+	switch c {
+	case table.Date:
+		return r.Date.Format("2006-01-02 Mon"), nil
+	case table.DayLength:
+		return formatDayLength(r.DayLength()), nil
+	}
+	kind, _ := c.Event()
+	t, err := r.Time(kind)
+	if err != nil {
+		return "", err
+	}
+	return t.Round(time.Minute).Format("15:04"), nil
+}
+
+// columnNames returns the names of the columns.
+func columnNames(cols []table.Column) []string {
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = c.String()
+	}
+	return names
+}
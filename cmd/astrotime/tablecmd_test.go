@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestTable(t *testing.T) {
+	var out bytes.Buffer
+	err := run([]string{"table", "--lat", "69.65", "--lon", "18.96", "--tz", "Europe/Oslo", "--month", "2024-05", "--columns", "date,sunrise,sunset,day_length"}, &out, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(out.String(), "\n")
+	want := map[int]string{
+		0:  "date            sunrise  sunset  day_length",
+		1:  "2024-05-01 Wed  03:18    22:09   18h52m",
+		17: "2024-05-17 Fri  01:06    ****    24h00m",
+		18: "2024-05-18 Sat  ****     ****    24h00m",
+		32: "**** sun continuously above the horizon or twilight altitude",
+	}
+	for i, line := range want {
+		if i >= len(lines) || lines[i] != line {
+			t.Errorf("line %d is %q, want %q", i, lines[i], line)
+		}
+	}
+	if len(lines) != 34 {
+		t.Errorf("got %d lines, want 34", len(lines))
+	}
+}
+
+func TestTableJSON(t *testing.T) {
+	var out bytes.Buffer
+	err := run([]string{"table", "--lat", "69.65", "--lon", "18.96", "--tz", "Europe/Oslo", "--month", "2024-05", "--columns", "sunset,day_length", "--format", "json"}, &out, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		TimeZone string   `json:"time_zone"`
+		Columns  []string `json:"columns"`
+		Rows     []struct {
+			Date   string `json:"date"`
+			Events []struct {
+				Kind  string `json:"kind"`
+				Time  string `json:"time"`
+				Stays string `json:"stays"`
+			} `json:"events"`
+			DayLength float64 `json:"day_length_seconds"`
+		} `json:"rows"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+		t.Fatalf("%v in %s", err, out.String())
+	}
+	if got.TimeZone != "Europe/Oslo" || strings.Join(got.Columns, ",") != "sunset,day_length" {
+		t.Errorf("got time zone %q, columns %q", got.TimeZone, got.Columns)
+	}
+	if len(got.Rows) != 31 {
+		t.Fatalf("got %d rows, want 31", len(got.Rows))
+	}
+	first, last := got.Rows[0], got.Rows[30]
+	if first.Date != "2024-05-01" || len(first.Events) != 1 || first.Events[0].Kind != "sunset" || !strings.HasPrefix(first.Events[0].Time, "2024-05-01T22:09") || !strings.HasSuffix(first.Events[0].Time, "+02:00") {
+		t.Errorf("first row is %+v", first)
+	}
+	if last.Events[0].Stays != "above" || last.DayLength != 86400 {
+		t.Errorf("last row is %+v", last)
+	}
+}
+
+func TestTableCSV(t *testing.T) {
+	var out bytes.Buffer
+	err := run([]string{"table", "--lat", "69.65", "--lon", "18.96", "--tz", "Europe/Oslo", "--month", "2024-05", "--columns", "date,sunrise,sunset,day_length", "--format", "csv"}, &out, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(out.String(), "\n")
+	want := map[int]string{
+		0:  "date,sunrise,sunset,day_length",
+		1:  "2024-05-01,2024-05-01T03:17:38+02:00,2024-05-01T22:09:09+02:00,18:51:31",
+		18: "2024-05-18,,,24:00:00",
+	}
+	for i, line := range want {
+		if i >= len(lines) || lines[i] != line {
+			t.Errorf("line %d is %q, want %q", i, lines[i], line)
+		}
+	}
+	if len(lines) != 1+31+1 {
+		t.Errorf("got %d lines, want a header, 31 days and a newline", len(lines))
+	}
+}
+
+func TestTableYear(t *testing.T) {
+	var out bytes.Buffer
+	if err := run([]string{"table", "--lat", "78.22", "--lon", "15.65", "--tz", "UTC", "--year", "2024"}, &out, &out); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(out.String(), "\n"); n != 1+366+2 {
+		t.Errorf("got %d lines, want a header, 366 days and 2 legends", n)
+	}
+	for _, args := range [][]string{
+		{"table", "--lat", "0", "--lon", "0", "--year", "2024", "--month", "2024-01"},
+		{"table", "--lat", "0", "--lon", "0", "--month", "January"},
+		{"table", "--lat", "0", "--lon", "0", "--columns", "date,moonrise"},
+	} {
+		if err := run(args, &out, &out); err == nil {
+			t.Errorf("run(%q) succeeded", args)
+		}
+	}
+}
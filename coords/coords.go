@@ -0,0 +1,135 @@
+// Package coords converts between the celestial coordinate systems used by
+// star catalogs, ephemerides and the rise and set calculations of
+// astrotime. Angles are in degrees throughout.
+//
+//	ecl := coords.Ecliptic{Lon: 113.215630, Lat: 6.684170}
+//	eq := ecl.Equatorial(coords.ObliquityJ2000)
+package coords
+
+import (
+	"math"
+	"time"
+
+	"github.com/dntj/astrotime/epoch"
+)
+
+const (
+	degToRad = math.Pi / 180
+	radToDeg = 180 / math.Pi
+)
+
+// ObliquityJ2000 is the mean obliquity of the ecliptic at J2000.0, in
+// degrees, for coordinates referred to the J2000 ecliptic and equator.
+const ObliquityJ2000 = 23.4392911
+
+// Ecliptic holds ecliptic coordinates: longitude, from 0° to 360° along the
+// ecliptic eastward from the equinox, and latitude north of the ecliptic.
+type Ecliptic struct {
+	Lon, Lat float64
+}
+
+// Equatorial holds equatorial coordinates: right ascension, from 0° to
+// 360° along the equator eastward from the equinox, and declination north
+// of the equator.
+type Equatorial struct {
+	RA, Dec float64
+}
+
+// Horizontal holds horizontal coordinates: azimuth, from 0° to 360°
+// clockwise from north, and altitude above the horizon.
+type Horizontal struct {
+	Azimuth, Altitude float64
+}
+
+// Equatorial converts c to equatorial coordinates, for an ecliptic inclined
+// to the equator by the obliquity: ObliquityJ2000 for coordinates of the
+// J2000 epoch, MeanObliquity for the mean equinox of a date, or the sum of
+// MeanObliquity and the nutation in obliquity for the true equinox.
+func (c Ecliptic) Equatorial(obliquity float64) Equatorial {
+	l, b, e := degToRad*c.Lon, degToRad*c.Lat, degToRad*obliquity
+	ra := math.Atan2(math.Sin(l)*math.Cos(e)-math.Tan(b)*math.Sin(e), math.Cos(l))
+	dec := math.Asin(math.Sin(b)*math.Cos(e) + math.Cos(b)*math.Sin(e)*math.Sin(l))
+	return Equatorial{RA: normalize(radToDeg * ra), Dec: radToDeg * dec}
+}
+
+// Ecliptic converts c to ecliptic coordinates for the obliquity, as for
+// Ecliptic.Equatorial.
+func (c Equatorial) Ecliptic(obliquity float64) Ecliptic {
+	a, d, e := degToRad*c.RA, degToRad*c.Dec, degToRad*obliquity
+	lon := math.Atan2(math.Sin(a)*math.Cos(e)+math.Tan(d)*math.Sin(e), math.Cos(a))
+	lat := math.Asin(math.Sin(d)*math.Cos(e) - math.Cos(d)*math.Sin(e)*math.Sin(a))
+	return Ecliptic{Lon: normalize(radToDeg * lon), Lat: radToDeg * lat}
+}
+
+// Horizontal converts c to horizontal coordinates for an observer at the
+// latitude when the local sidereal time, the right ascension on the
+// meridian, is siderealTime in degrees (see astrotime.LAST). The altitude
+// is geometric, without refraction.
+func (c Equatorial) Horizontal(latitude, siderealTime float64) Horizontal {
+	h, d, phi := degToRad*(siderealTime-c.RA), degToRad*c.Dec, degToRad*latitude
+	az := math.Atan2(math.Sin(h), math.Cos(h)*math.Sin(phi)-math.Tan(d)*math.Cos(phi))
+	alt := math.Asin(math.Sin(phi)*math.Sin(d) + math.Cos(phi)*math.Cos(d)*math.Cos(h))
+	return Horizontal{Azimuth: normalize(radToDeg*az + 180), Altitude: radToDeg * alt}
+}
+
+// Equatorial converts c to equatorial coordinates for an observer at the
+// latitude at the local sidereal time siderealTime, the inverse of
+// Equatorial.Horizontal.
+func (c Horizontal) Equatorial(latitude, siderealTime float64) Equatorial {
+	a, h, phi := degToRad*(c.Azimuth-180), degToRad*c.Altitude, degToRad*latitude
+	ha := math.Atan2(math.Sin(a), math.Cos(a)*math.Sin(phi)+math.Tan(h)*math.Cos(phi))
+	dec := math.Asin(math.Sin(phi)*math.Sin(h) - math.Cos(phi)*math.Cos(h)*math.Cos(a))
+	return Equatorial{RA: normalize(siderealTime - radToDeg*ha), Dec: radToDeg * dec}
+}
+
+// Precess carries c, referred to the mean equator and equinox of the Julian
+// date from, to those of the Julian date to (both TT), by the rigorous
+// method of Meeus chapter 21 with the IAU 1976 angles ζ, z and θ. Use
+// epoch.J2000 or epoch.B1950 for catalog epochs and epoch.JulianDate for a
+// date. Positions from FK4 catalogs such as B1950 are precessed, not
+// converted to the FK5 frame, which would move them by up to about a
+// second of arc more.
+func (c Equatorial) Precess(from, to float64) Equatorial {
+	T := (from - epoch.J2000) / 36525
+	t := (to - from) / 36525
+	zeta := (2306.2181+T*(1.39656-0.000139*T))*t + (0.30188-0.000344*T)*t*t + 0.017998*t*t*t
+	z := (2306.2181+T*(1.39656-0.000139*T))*t + (1.09468+0.000066*T)*t*t + 0.018203*t*t*t
+	theta := (2004.3109-T*(0.85330+0.000217*T))*t - (0.42665+0.000217*T)*t*t - 0.041833*t*t*t
+	zeta, z, theta = degToRad*zeta/3600, degToRad*z/3600, degToRad*theta/3600
+
+	a, d := degToRad*c.RA+zeta, degToRad*c.Dec
+	x := math.Cos(theta)*math.Cos(d)*math.Cos(a) - math.Sin(theta)*math.Sin(d)
+	y := math.Cos(d) * math.Sin(a)
+	sinDec := math.Sin(theta)*math.Cos(d)*math.Cos(a) + math.Cos(theta)*math.Sin(d)
+	dec := math.Asin(sinDec)
+	if math.Abs(c.Dec) > 89 {
+		// Near the poles, the declination from its cosine is more precise.
+		dec = math.Copysign(math.Acos(math.Hypot(x, y)), sinDec)
+	}
+	return Equatorial{RA: normalize(radToDeg * (math.Atan2(y, x) + z)), Dec: radToDeg * dec}
+}
+
+// MeanObliquity calculates the mean obliquity of the ecliptic in degrees at
+// t, taken as Terrestrial Time, by Laskar's series (Meeus 22.3): good to
+// 0.01″ within a thousand years of 2000 and a few seconds of arc within
+// ten thousand.
+func MeanObliquity(t time.Time) float64 {
+	return meanObliquity((epoch.JulianDate(t) - epoch.J2000) / 36525)
+}
+
+// meanObliquity calculates the mean obliquity of the ecliptic for t in
+// Julian centuries since J2000.0.
+func meanObliquity(t float64) float64 {
+	u := t / 100
+	seconds := 21.448 + u*(-4680.93+u*(-1.55+u*(1999.25+u*(-51.38+u*(-249.67+u*(-39.05+u*(7.12+u*(27.87+u*(5.79+u*2.45)))))))))
+	return 23 + (26+seconds/60)/60
+}
+
+// normalize reduces the angle a to [0°, 360°).
+func normalize(a float64) float64 {
+	a = math.Mod(a, 360)
+	if a < 0 {
+		a += 360
+	}
+	return a
+}
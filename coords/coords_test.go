@@ -0,0 +1,78 @@
+package coords
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime/epoch"
+)
+
+func TestEclipticEquatorial(t *testing.T) {
+	// Meeus example 13.a: Pollux, J2000.0.
+	eq := Equatorial{RA: 116.328942, Dec: 28.026183}
+	ecl := eq.Ecliptic(ObliquityJ2000)
+	if math.Abs(ecl.Lon-113.215630) > 1e-6 || math.Abs(ecl.Lat-6.684170) > 1e-6 {
+		t.Errorf("got %.6f, %.6f, want 113.215630, 6.684170", ecl.Lon, ecl.Lat)
+	}
+	back := ecl.Equatorial(ObliquityJ2000)
+	if math.Abs(back.RA-eq.RA) > 1e-9 || math.Abs(back.Dec-eq.Dec) > 1e-9 {
+		t.Errorf("got %.6f, %.6f back, want %.6f, %.6f", back.RA, back.Dec, eq.RA, eq.Dec)
+	}
+}
+
+func TestEquatorialRange(t *testing.T) {
+	// Just west of the equinox the right ascension wraps to near 360°.
+	eq := Ecliptic{Lon: 359, Lat: 0}.Equatorial(ObliquityJ2000)
+	if eq.RA < 358 || eq.RA >= 360 || eq.Dec > 0 {
+		t.Errorf("got %.6f, %.6f, want RA just under 360° and declination south", eq.RA, eq.Dec)
+	}
+}
+
+func TestMeanObliquity(t *testing.T) {
+	// Meeus example 22.a: 1987 April 10, 0h TD, ε0 = 23°26′27.407″.
+	got := MeanObliquity(time.Date(1987, 4, 10, 0, 0, 0, 0, time.UTC))
+	if want := 23 + 26.0/60 + 27.407/3600; math.Abs(got-want)*3600 > 0.001 {
+		t.Errorf("got %.7f, want %.7f", got, want)
+	}
+	if got := MeanObliquity(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)); math.Abs(got-ObliquityJ2000) > 1e-7 {
+		t.Errorf("got %.7f at J2000.0, want %.7f", got, ObliquityJ2000)
+	}
+}
+
+func TestEquatorialHorizontal(t *testing.T) {
+	// Meeus example 13.b: Venus from the US Naval Observatory, 1987 April
+	// 10 at 19:21 UT, where the apparent sidereal time at Greenwich was 128.7369°
+	// less the longitude of 77.0656°W.
+	eq := Equatorial{RA: 347.3193375, Dec: -6.719892}
+	lst := 128.7368875 - 77.0655556
+	h := eq.Horizontal(38.921389, lst)
+	if math.Abs(h.Azimuth-248.0337) > 1e-3 || math.Abs(h.Altitude-15.1249) > 1e-3 {
+		t.Errorf("got %.4f, %.4f, want 248.0337, 15.1249", h.Azimuth, h.Altitude)
+	}
+	back := h.Equatorial(38.921389, lst)
+	if math.Abs(back.RA-eq.RA) > 1e-9 || math.Abs(back.Dec-eq.Dec) > 1e-9 {
+		t.Errorf("got %.7f, %.7f back, want %.7f, %.7f", back.RA, back.Dec, eq.RA, eq.Dec)
+	}
+}
+
+func TestPrecess(t *testing.T) {
+	// Meeus example 21.b: θ Persei, with its proper motion to 2028
+	// November 13.19 TD already applied to the J2000 position.
+	j2000 := Equatorial{RA: 41.054063, Dec: 49.227750}
+	date := 2462088.69
+	got := j2000.Precess(epoch.J2000, date)
+	if math.Abs(got.RA-41.547214)*3600 > 0.05 || math.Abs(got.Dec-49.348483)*3600 > 0.05 {
+		t.Errorf("got %.6f, %.6f, want 41.547214, 49.348483", got.RA, got.Dec)
+	}
+	back := got.Precess(date, epoch.J2000)
+	if math.Abs(back.RA-j2000.RA)*3600 > 1e-4 || math.Abs(back.Dec-j2000.Dec)*3600 > 1e-4 {
+		t.Errorf("got %.7f, %.7f back, want %.7f, %.7f", back.RA, back.Dec, j2000.RA, j2000.Dec)
+	}
+	// Near the pole, precession swings Polaris by ten degrees of right
+	// ascension between B1950 and J2000.
+	polaris := Equatorial{RA: 27.2033, Dec: 89.0286}.Precess(epoch.B1950, epoch.J2000)
+	if math.Abs(polaris.RA-37.9529) > 0.05 || math.Abs(polaris.Dec-89.2641) > 0.001 {
+		t.Errorf("got Polaris at %.4f, %.4f, want 37.9529, 89.2641", polaris.RA, polaris.Dec)
+	}
+}
@@ -0,0 +1,61 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextSolarLongitude(t *testing.T) {
+	// The solar longitudes of the seasons agree with the Meeus series.
+	for year := 1950; year <= 2050; year += 25 {
+		march, september, err := Equinoxes(year)
+		if err != nil {
+			t.Fatal(err)
+		}
+		june, december, _ := Solstices(year)
+		start := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+		for _, tt := range []struct {
+			lon  float64
+			want time.Time
+		}{{0, march}, {90, june}, {180, september}, {270, december}} {
+			got, err := NextSolarLongitude(start, tt.lon)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d := got.Sub(tt.want); d.Abs() > 15*time.Minute {
+				t.Errorf("%d, %v°: got %v, want %v", year, tt.lon, got, tt.want)
+			}
+		}
+	}
+
+	// The search always moves forward.
+	march, _, _ := Equinoxes(2024)
+	next, err := NextSolarLongitude(march.Add(time.Minute), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.Year() != 2025 || next.Month() != time.March {
+		t.Errorf("got %v, want March 2025", next)
+	}
+}
+
+func TestCrossQuarterDays(t *testing.T) {
+	days, err := CrossQuarterDays(2024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [4]time.Time{
+		Imbolc:     time.Date(2024, 2, 4, 10, 0, 0, 0, time.UTC),
+		Beltane:    time.Date(2024, 5, 5, 2, 0, 0, 0, time.UTC),
+		Lughnasadh: time.Date(2024, 8, 6, 20, 0, 0, 0, time.UTC),
+		Samhain:    time.Date(2024, 11, 6, 22, 0, 0, 0, time.UTC),
+	}
+	for d := range want {
+		if diff := days[d].Sub(want[d]); diff.Abs() > 6*time.Hour {
+			t.Errorf("%v: got %v, want about %v", CrossQuarterDay(d), days[d], want[d])
+		}
+	}
+	if got := Lughnasadh.String(); got != "Lughnasadh" {
+		t.Errorf("got %q, want Lughnasadh", got)
+	}
+}
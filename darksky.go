@@ -0,0 +1,68 @@
+package astrotime
+
+import "time"
+
+// darkSkyLimits sets when the moon is considered not to brighten the sky.
+type darkSkyLimits struct {
+	moonAltitude    float64
+	maxIllumination float64
+}
+
+// WithDarkSky sets when DarkSky ignores the moon: while its altitude is
+// below moonAltitude degrees, or while its illuminated fraction is at most
+// maxIllumination. By default the moon only counts as down once it has
+// set, whatever its phase.
+func WithDarkSky(moonAltitude, maxIllumination float64) Option {
+	return func(o *Observer) {
+		o.darkSky = &darkSkyLimits{moonAltitude, maxIllumination}
+	}
+}
+
+// isNight reports whether the sun is more than 18° below the horizon at t.
+func (o Observer) isNight(t time.Time) bool {
+	_, alt := o.sunPosition(t)
+	return alt < 90-zenithAstronomical
+}
+
+// night returns the span from solar noon on the calendar day of t to solar
+// noon on the next day.
+func (o Observer) night(t time.Time) (start, end time.Time) {
+	t = o.local(t)
+	return o.solarNoon(t), o.solarNoon(t.AddDate(0, 0, 1))
+}
+
+// isDark reports whether the sky is truly dark at t: the sun is more than
+// 18° below the horizon, and the moon is down or faint enough to ignore.
+func (o Observer) isDark(t time.Time) bool {
+	if !o.isNight(t) {
+		return false
+	}
+	if o.darkSky == nil {
+		return o.moonHorizonAltitude(t) < 0
+	}
+	if MoonPhase(t).Illumination <= o.darkSky.maxIllumination {
+		return true
+	}
+	_, alt, _ := o.moonHorizontal(julianDate(t.UTC()))
+	return alt < o.darkSky.moonAltitude
+}
+
+// DarkSky returns the intervals of the night following the calendar day of
+// t, from solar noon to the next, during which the sky is truly dark:
+// astronomical night with the moon below the horizon, or within the limits
+// set by WithDarkSky. The result is empty if there is no such time, as on
+// nights near full moon or through a polar summer.
+func (o Observer) DarkSky(t time.Time) ([]Interval, error) {
+	if err := o.validate(t); err != nil {
+		return nil, err
+	}
+
+	start, end := o.night(t)
+	return o.windows(start, end, o.isDark), nil
+}
+
+// DarkSky returns the truly dark intervals of the night following the day
+// t for an observer at the latitude and longitude.
+func DarkSky(t time.Time, latitude, longitude float64) ([]Interval, error) {
+	return Observer{Lat: latitude, Lon: longitude}.DarkSky(t)
+}
@@ -0,0 +1,61 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDarkSky(t *testing.T) {
+	o := Observer{Lat: 51.4779, Lon: 0}
+	tests := []struct {
+		day   int
+		hours float64 // total darkness, approximately
+	}{
+		{2, 8.8},  // new moon: the whole astronomical night
+		{10, 7.0}, // first quarter: after moonset
+		{17, 0},   // full moon: up all night
+		{24, 3.9}, // last quarter: until moonrise
+	}
+	for _, tt := range tests {
+		day := time.Date(2024, 10, tt.day, 0, 0, 0, 0, time.UTC)
+		windows, err := o.DarkSky(day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var total time.Duration
+		for _, w := range windows {
+			total += w.Duration()
+			for _, at := range []time.Time{w.Start.Add(time.Minute), w.End.Add(-time.Minute)} {
+				if !o.isDark(at) {
+					t.Errorf("October %d: %v inside window is not dark", tt.day, at)
+				}
+			}
+		}
+		if d := total.Hours() - tt.hours; d < -0.1 || d > 0.1 {
+			t.Errorf("October %d: got %.2fh of darkness, want %.1fh", tt.day, total.Hours(), tt.hours)
+		}
+	}
+}
+
+func TestDarkSkyLimits(t *testing.T) {
+	day := time.Date(2024, 10, 17, 0, 0, 0, 0, time.UTC)
+	// Ignoring a full moon, the night is as dark as at new moon.
+	o := NewObserver(51.4779, 0, WithDarkSky(0, 1))
+	windows, err := o.DarkSky(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(windows) != 1 || windows[0].Duration() < 8*time.Hour {
+		t.Errorf("got %v, want the whole astronomical night", windows)
+	}
+}
+
+func TestDarkSkyWhiteNights(t *testing.T) {
+	windows, err := DarkSky(time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), 60, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(windows) != 0 {
+		t.Errorf("got %v, want no dark sky", windows)
+	}
+}
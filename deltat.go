@@ -0,0 +1,211 @@
+package astrotime
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// unixEpochJD is the Julian date of 1970-01-01T00:00:00Z.
+const unixEpochJD = 2440587.5
+
+// timeFromJulianDate converts a Julian date (UT) to a UTC Time.
+func timeFromJulianDate(jd float64) time.Time {
+	ns := (jd - unixEpochJD) * float64(oneDay)
+	return time.Unix(0, 0).UTC().Add(time.Duration(ns))
+}
+
+// DeltaTEntry is a value of ΔT, measured or predicted, at a decimal year.
+type DeltaTEntry struct {
+	Year   float64
+	DeltaT time.Duration
+}
+
+// A DeltaTTable is a table of measured or predicted values of ΔT that an
+// observer takes ΔT from, through WithDeltaTTable, in place of the
+// polynomial model. It cannot be changed once made, so it may be shared
+// freely.
+type DeltaTTable struct {
+	entries []DeltaTEntry
+}
+
+// NewDeltaTTable returns a DeltaTTable interpolating linearly between the
+// entries, for dates within the span of years they cover; the polynomial
+// model is still used outside it. The entries must be in order of
+// increasing year.
+func NewDeltaTTable(entries []DeltaTEntry) (*DeltaTTable, error) {
+	if len(entries) < 2 {
+		return nil, fmt.Errorf("astrotime: ΔT table needs at least two entries, got %d", len(entries))
+	}
+	for i := 1; i < len(entries); i++ {
+		if entries[i].Year <= entries[i-1].Year {
+			return nil, fmt.Errorf("astrotime: ΔT table years out of order at %v", entries[i].Year)
+		}
+	}
+	return &DeltaTTable{entries: append([]DeltaTEntry(nil), entries...)}, nil
+}
+
+// DeltaT returns ΔT at t from the table.
+func (tab *DeltaTTable) DeltaT(t time.Time) time.Duration {
+	s := tab.seconds(decimalYear(julianDate(t.UTC())))
+	return time.Duration(s * float64(time.Second))
+}
+
+// seconds returns ΔT = TT − UT in seconds for the decimal year y, from the
+// table if it covers y and from deltaT if not, or if tab is nil.
+func (tab *DeltaTTable) seconds(y float64) float64 {
+	if tab == nil {
+		return deltaT(y)
+	}
+	table := tab.entries
+	if y < table[0].Year || y > table[len(table)-1].Year {
+		return deltaT(y)
+	}
+	i := sort.Search(len(table)-1, func(i int) bool { return table[i+1].Year >= y })
+	a, b := table[i].DeltaT.Seconds(), table[i+1].DeltaT.Seconds()
+	f := (y - table[i].Year) / (table[i+1].Year - table[i].Year)
+	return a + f*(b-a)
+}
+
+// WithDeltaTTable makes the observer take ΔT from table wherever it uses
+// ΔT: under WithDeltaT, for AlgorithmVSOP87 and for the moon. A nil table
+// restores the polynomial model.
+func WithDeltaTTable(table *DeltaTTable) Option {
+	return func(o *Observer) {
+		o.deltaTTable = table
+	}
+}
+
+// ttFromUT converts a Julian date (UT) to a Julian ephemeris date (TT) with
+// the observer's ΔT.
+func (o Observer) ttFromUT(jd float64) float64 {
+	return jd + o.deltaTTable.seconds(decimalYear(jd))/86400
+}
+
+// WithDeltaT makes the observer's sunrise, sunset and twilight calculations
+// take the sun's position in Terrestrial Time, ΔT after Universal Time. The
+// NOAA algorithms leave ΔT out, which matters little today but moves events
+// by tens of seconds in antiquity, where ΔT reaches hours. It is disabled by
+// default, so that results match NOAA's.
+func WithDeltaT(enabled bool) Option {
+	return func(o *Observer) {
+		o.terrestrial = enabled
+	}
+}
+
+// sunrise is the package sunrise, calculated with the observer's algorithm
+// and corrected for ΔT if the observer enables it.
+func (o Observer) sunrise(t time.Time, latitude, longitude, zenith float64) (time.Time, error) {
+	if o.algorithm == AlgorithmVSOP87 {
+		return o.sunEvent(t, latitude, longitude, zenith, -1)
+	}
+	return sunriseAt(t, o.julianDate(t), o.refinement(), latitude, longitude, zenith)
+}
+
+// sunset is the package sunset, calculated with the observer's algorithm
+// and corrected for ΔT if the observer enables it.
+func (o Observer) sunset(t time.Time, latitude, longitude, zenith float64) (time.Time, error) {
+	if o.algorithm == AlgorithmVSOP87 {
+		return o.sunEvent(t, latitude, longitude, zenith, 1)
+	}
+	return sunsetAt(t, o.julianDate(t), o.refinement(), latitude, longitude, zenith)
+}
+
+// julianDate returns the Julian date of t for the sun's position: UT by
+// default, TT with WithDeltaT.
+func (o Observer) julianDate(t time.Time) float64 {
+	jd := julianDate(t)
+	if o.terrestrial {
+		return o.ttFromUT(jd)
+	}
+	return jd
+}
+
+// deltaT estimates ΔT in seconds for the decimal year y, using the
+// polynomials of Espenak and Meeus (NASA Five Millennium Canon of Solar
+// Eclipses, 2006).
+func deltaT(y float64) float64 {
+	switch {
+	case y < -500:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	case y < 500:
+		u := y / 100
+		return 10583.6 + u*(-1014.41+u*(33.78311+u*(-5.952053+u*(-0.1798452+u*(0.022174192+u*0.0090316521)))))
+	case y < 1600:
+		u := (y - 1000) / 100
+		return 1574.2 + u*(-556.01+u*(71.23472+u*(0.319781+u*(-0.8503463+u*(-0.005050998+u*0.0083572073)))))
+	case y < 1700:
+		t := y - 1600
+		return 120 + t*(-0.9808+t*(-0.01532+t/7129))
+	case y < 1800:
+		t := y - 1700
+		return 8.83 + t*(0.1603+t*(-0.0059285+t*(0.00013336-t/1174000)))
+	case y < 1860:
+		t := y - 1800
+		return 13.72 + t*(-0.332447+t*(0.0068612+t*(0.0041116+t*(-0.00037436+t*(0.0000121272+t*(-0.0000001699+t*0.000000000875))))))
+	case y < 1900:
+		t := y - 1860
+		return 7.62 + t*(0.5737+t*(-0.251754+t*(0.01680668+t*(-0.0004473624+t/233174))))
+	case y < 1920:
+		t := y - 1900
+		return -2.79 + t*(1.494119+t*(-0.0598939+t*(0.0061966-t*0.000197)))
+	case y < 1941:
+		t := y - 1920
+		return 21.20 + t*(0.84493+t*(-0.076100+t*0.0020936))
+	case y < 1961:
+		t := y - 1950
+		return 29.07 + t*(0.407+t*(-1.0/233+t/2547))
+	case y < 1986:
+		t := y - 1975
+		return 45.45 + t*(1.067+t*(-1.0/260-t/718))
+	case y < 2005:
+		t := y - 2000
+		return 63.86 + t*(0.3345+t*(-0.060374+t*(0.0017275+t*(0.000651814+t*0.00002373599))))
+	case y < 2050:
+		t := y - 2000
+		return 62.92 + t*(0.32217+t*0.005589)
+	case y < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	}
+	u := (y - 1820) / 100
+	return -20 + 32*u*u
+}
+
+// decimalYear returns the year of the Julian date jd as a decimal.
+func decimalYear(jd float64) float64 {
+	return 2000 + (jd-2451545)/365.25
+}
+
+// utFromTT converts a Julian ephemeris date (TT) to a Julian date (UT). The
+// series of Meeus, such as the lunation series behind NextMoonPhase, give
+// their instants in TT, ahead of UT by ΔT: over a minute now and hours in
+// antiquity, so the phases need it whether or not an observer uses TT.
+func utFromTT(jde float64) float64 {
+	return jde - deltaT(decimalYear(jde))/86400
+}
+
+// ttFromUT converts a Julian date (UT) to a Julian ephemeris date (TT).
+func ttFromUT(jd float64) float64 {
+	return jd + deltaT(decimalYear(jd))/86400
+}
+
+// DeltaT estimates ΔT, the difference TT − UT between Terrestrial Time, the
+// uniform time scale of ephemerides, and Universal Time at t.
+func DeltaT(t time.Time) time.Duration {
+	s := deltaT(decimalYear(julianDate(t.UTC())))
+	return time.Duration(s * float64(time.Second))
+}
+
+// TerrestrialTime converts the instant t to Terrestrial Time: the result
+// reads in UTC the TT clock reading at t, ΔT ahead of Universal Time.
+func TerrestrialTime(t time.Time) time.Time {
+	return t.UTC().Add(DeltaT(t))
+}
+
+// UniversalTime converts a Terrestrial Time clock reading, given as a Time
+// in UTC such as TerrestrialTime returns, back to Universal Time.
+func UniversalTime(tt time.Time) time.Time {
+	return tt.UTC().Add(-DeltaT(tt))
+}
@@ -0,0 +1,121 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDeltaT(t *testing.T) {
+	tests := []struct {
+		year, want, tolerance float64
+	}{
+		{1000, 1574.2, 1},
+		{1800, 13.72, 0.01},
+		{1900, -2.79, 0.01},
+		{1950, 29.07, 0.01},
+		{2000, 63.86, 0.01},
+		{2017, 68.6, 1.5},
+	}
+	for _, tt := range tests {
+		if got := deltaT(tt.year); math.Abs(got-tt.want) > tt.tolerance {
+			t.Errorf("deltaT(%v) = %.2f, want %.2f", tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestTimeFromJulianDate(t *testing.T) {
+	for _, s := range []string{"2000-01-01T12:00:00Z", "1977-02-18T03:37:42Z", "2017-07-10T15:04:05Z"} {
+		want := p(s)
+		if got := timeFromJulianDate(julianDate(want)); got.Sub(want).Abs() > time.Millisecond {
+			t.Errorf("got %s back from julian date, want %s", got, want)
+		}
+	}
+}
+
+func TestDeltaTExported(t *testing.T) {
+	got := DeltaT(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC))
+	if d := got - 63860*time.Millisecond; d < -100*time.Millisecond || d > 100*time.Millisecond {
+		t.Errorf("got %v, want about 63.86s", got)
+	}
+}
+
+func TestDeltaTTable(t *testing.T) {
+	table, err := NewDeltaTTable([]DeltaTEntry{
+		{Year: 2020, DeltaT: 69 * time.Second},
+		{Year: 2030, DeltaT: 71 * time.Second},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		year, want, tolerance float64
+	}{
+		{2020, 69, 1e-9},
+		{2025, 70, 1e-9},
+		{2030, 71, 1e-9},
+		{2000, 63.86, 0.01}, // outside the table
+	}
+	for _, tt := range tests {
+		if got := table.seconds(tt.year); math.Abs(got-tt.want) > tt.tolerance {
+			t.Errorf("seconds(%v) = %.2f, want %.2f", tt.year, got, tt.want)
+		}
+	}
+	if got := table.DeltaT(p("2025-01-01T00:00:00Z")); got.Round(time.Second) != 70*time.Second {
+		t.Errorf("DeltaT in 2025 = %v, want 70s", got)
+	}
+	var none *DeltaTTable
+	if got := none.seconds(2025); got != deltaT(2025) {
+		t.Errorf("nil table gives %.2f, want the model's %.2f", got, deltaT(2025))
+	}
+
+	for _, bad := range [][]DeltaTEntry{table.entries[:1], {table.entries[1], table.entries[0]}} {
+		if _, err := NewDeltaTTable(bad); err == nil {
+			t.Errorf("NewDeltaTTable(%v) succeeded, want an error", bad)
+		}
+	}
+
+	// An observer with the table uses it; others keep the model.
+	far, _ := NewDeltaTTable([]DeltaTEntry{{Year: 2020, DeltaT: time.Hour}, {Year: 2030, DeltaT: time.Hour}})
+	jd := julianDate(p("2025-01-01T00:00:00Z"))
+	if got := NewObserver(51.5, 0, WithDeltaTTable(far)).ttFromUT(jd) - jd; math.Abs(got-1.0/24) > 1e-9 {
+		t.Errorf("observer ΔT %v days, want an hour", got)
+	}
+	if got := NewObserver(51.5, 0).ttFromUT(jd); got != ttFromUT(jd) {
+		t.Errorf("observer without a table converts to %v, want %v", got, ttFromUT(jd))
+	}
+}
+
+func TestTerrestrialTime(t *testing.T) {
+	ut := p("2000-01-01T12:00:00Z")
+	tt := TerrestrialTime(ut)
+	if d := tt.Sub(ut); math.Abs(d.Seconds()-63.86) > 0.01 {
+		t.Errorf("TT − UT = %v, want about 63.86s", d)
+	}
+	if got := UniversalTime(tt); got.Sub(ut).Abs() > time.Millisecond {
+		t.Errorf("got %s back from TT, want %s", got, ut)
+	}
+}
+
+func TestWithDeltaT(t *testing.T) {
+	// ΔT was nearly three hours in 1 AD, when the sun moved 0.12° in longitude
+	// while UT caught up.
+	day := time.Date(1, 3, 1, 12, 0, 0, 0, time.UTC)
+	plain, err := NewObserver(51.48, 0).Sunrise(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	corrected, err := NewObserver(51.48, 0, WithDeltaT(true)).Sunrise(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The sun's declination climbs about 24′ a day in March, or 3′ over
+	// ΔT, bringing sunrise at London some 16 seconds earlier.
+	if d := plain.Sub(corrected); d < 10*time.Second || d > 25*time.Second {
+		t.Errorf("WithDeltaT moved sunrise by %v, want about 16s earlier", d)
+	}
+	o := NewObserver(51.48, 0, WithDeltaT(true))
+	if got, want := o.julianDate(day), julianDate(day)+deltaT(1.16)/86400; math.Abs(got-want) > 1e-4 {
+		t.Errorf("julianDate = %v, want %v", got, want)
+	}
+}
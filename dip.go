@@ -0,0 +1,21 @@
+package astrotime
+
+import "math"
+
+// refractedRadius is the radius, in meters, of the earth curved less by
+// terrestrial refraction, bending the line of sight to the horizon, that
+// makes the dip from small heights come to 1.76′·√h.
+var refractedRadius = 2 / math.Pow(1.76/60*degToRad, 2)
+
+// horizonDip calculates the dip of the visible horizon, in degrees, for an
+// observer at height meters above the surrounding terrain, including the
+// effect of terrestrial refraction. It is the familiar 1.76′·√h written as
+// the exact geometry of a sight line grazing the refracted earth; the two
+// differ by less than a second of time in sunrise even from airliner
+// heights, where the dip is about 3°.
+func horizonDip(meters float64) float64 {
+	if meters <= 0 {
+		return 0
+	}
+	return radToDeg * math.Acos(refractedRadius/(refractedRadius+meters))
+}
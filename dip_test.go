@@ -0,0 +1,53 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestHorizonDip(t *testing.T) {
+	tests := []struct {
+		meters, want float64
+	}{
+		{0, 0},
+		{-10, 0},
+		{100, 0.2933},
+		{300, 0.5081},
+	}
+	for _, tt := range tests {
+		if got := horizonDip(tt.meters); math.Abs(got-tt.want) > 1e-4 {
+			t.Errorf("horizonDip(%v) = %.4f, want %.4f", tt.meters, got, tt.want)
+		}
+	}
+}
+
+func TestElevatedSunrise(t *testing.T) {
+	day := p("2017-10-15T12:00:00Z")
+	sea := Observer{Lat: 38.8895, Lon: -77.0352}
+	hill := Observer{Lat: 38.8895, Lon: -77.0352, Elevation: 300}
+	if d := sunriseOn(t, sea, day).Sub(sunriseOn(t, hill, day)); d < 2*time.Minute || d > 4*time.Minute {
+		t.Errorf("got sunrise %s earlier from 300m, want about 3m", d)
+	}
+	if d := sunsetOn(t, hill, day).Sub(sunsetOn(t, sea, day)); d < 2*time.Minute || d > 4*time.Minute {
+		t.Errorf("got sunset %s later from 300m, want about 3m", d)
+	}
+}
+
+func TestFlightLevelSunrise(t *testing.T) {
+	day := p("2024-06-21T12:00:00Z")
+	ground := Observer{Lat: 51.47, Lon: -0.45}
+	aloft := NewObserver(51.47, -0.45, WithFlightLevel(350))
+	if got, want := aloft.Elevation, 10668.0; math.Abs(got-want) > 1e-9 {
+		t.Errorf("got elevation %v, want %v", got, want)
+	}
+	if got := horizonDip(aloft.Elevation); math.Abs(got-3.028) > 1e-3 {
+		t.Errorf("got dip %.3f° at FL350, want 3.028°", got)
+	}
+	if d := sunriseOn(t, ground, day).Sub(sunriseOn(t, aloft, day)); d < 25*time.Minute || d > 30*time.Minute {
+		t.Errorf("got sunrise %s earlier at FL350, want about 27m", d)
+	}
+	if d := sunsetOn(t, aloft, day).Sub(sunsetOn(t, ground, day)); d < 25*time.Minute || d > 30*time.Minute {
+		t.Errorf("got sunset %s later at FL350, want about 27m", d)
+	}
+}
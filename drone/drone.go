@@ -0,0 +1,135 @@
+// Package drone calculates the daily windows in which small unmanned
+// aircraft may fly by the daylight rules of aviation authorities. The
+// FAA's Part 107 (14 CFR §107.29) allows operations from the start of
+// morning civil twilight to the end of evening civil twilight, with
+// anti-collision lighting in the twilight at either end; other
+// jurisdictions use sunrise and sunset, or other twilights, so a Rule may
+// be one of the presets or have any events.
+//
+//	ok := drone.CanFlyNow(time.Now(), 40.71, -74.01)
+//
+// Authorities also allow flight at night with waivers, lighting or
+// training; the windows here are those of the daylight rule only.
+package drone
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// Rule is a regulation allowing flight between two events of the day.
+type Rule struct {
+	Name string
+
+	// Dawn and Dusk are the events at which the window opens and closes,
+	// such as EventCivilDawn and EventCivilDusk.
+	Dawn, Dusk astrotime.EventKind
+}
+
+// Preset rules.
+var (
+	Part107  = Rule{Name: "FAA Part 107", Dawn: astrotime.EventCivilDawn, Dusk: astrotime.EventCivilDusk}
+	CASA     = Rule{Name: "CASA standard operating conditions", Dawn: astrotime.EventCivilDawn, Dusk: astrotime.EventCivilDusk}
+	Daylight = Rule{Name: "Sunrise to sunset", Dawn: astrotime.EventSunrise, Dusk: astrotime.EventSunset}
+)
+
+// Rules are the preset rules.
+var Rules = []Rule{Part107, CASA, Daylight}
+
+// Window is the window for flight of a day.
+type Window struct {
+	// Date is midnight at the start of the day, in the observer's time
+	// zone.
+	Date time.Time
+
+	// Start and End bound the window, by the rule. Both are zero on a day
+	// without one, when the sun stays too low all day; on days when it
+	// stays high enough, they are the midnights at the start and end of the
+	// day.
+	Start, End time.Time
+
+	// Sunrise and Sunset are zero on days when the sun does not rise or
+	// set. Under Part 107 the parts of the window before sunrise and after
+	// sunset need anti-collision lighting; see NeedsLighting.
+	Sunrise, Sunset time.Time
+}
+
+// Duration returns the length of the window.
+func (w Window) Duration() time.Duration {
+	return w.End.Sub(w.Start)
+}
+
+// Contains reports whether t lies within the window.
+func (w Window) Contains(t time.Time) bool {
+	return !w.Start.IsZero() && !t.Before(w.Start) && t.Before(w.End)
+}
+
+// Calculate calculates the window for flight by the rule on the day t, as
+// seen by o.
+func Calculate(o astrotime.Observer, t time.Time, r Rule) (Window, error) {
+	if o.Location != nil {
+		t = t.In(o.Location)
+	}
+	w := Window{Date: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())}
+	next := w.Date.AddDate(0, 0, 1)
+	start, err := edge(o, w.Date, r.Dawn, w.Date)
+	if err != nil || start.IsZero() {
+		return Window{Date: w.Date}, err
+	}
+	end, err := edge(o, w.Date, r.Dusk, next)
+	if err != nil || end.IsZero() {
+		return Window{Date: w.Date}, err
+	}
+	w.Start, w.End = start, end
+	w.Sunrise, _ = o.Sunrise(w.Date)
+	w.Sunset, _ = o.Sunset(w.Date)
+	return w, nil
+}
+
+// edge returns the time of the event kind on day, or bound if the sun stays
+// above the event's altitude all day, or zero if it stays below.
+func edge(o astrotime.Observer, day time.Time, kind astrotime.EventKind, bound time.Time) (time.Time, error) {
+	s, err := o.EventTime(day, kind)
+	switch {
+	case errors.Is(err, astrotime.ErrAlwaysAbove):
+		return bound, nil
+	case errors.Is(err, astrotime.ErrAlwaysBelow):
+		return time.Time{}, nil
+	}
+	return s, err
+}
+
+// CanFly reports whether the rule allows flight at t, as seen by o.
+func CanFly(o astrotime.Observer, t time.Time, r Rule) (bool, error) {
+	w, err := Calculate(o, t, r)
+	if err != nil {
+		return false, err
+	}
+	return w.Contains(t), nil
+}
+
+// NeedsLighting reports whether the rule allows flight at t, as seen by o,
+// only in twilight, with the sun's upper limb below the horizon: the time
+// for which Part 107 requires anti-collision lighting visible for three
+// statute miles.
+func NeedsLighting(o astrotime.Observer, t time.Time, r Rule) (bool, error) {
+	ok, err := CanFly(o, t, r)
+	if !ok || err != nil {
+		return false, err
+	}
+	p, err := o.Phase(t)
+	if err != nil {
+		return false, err
+	}
+	return p != astrotime.Day, nil
+}
+
+// CanFlyNow reports whether Part 107 allows flight at t at the latitude and
+// longitude, at sea level. It reports false if the calculation fails; use
+// CanFly to find out why.
+func CanFlyNow(t time.Time, latitude, longitude float64) bool {
+	ok, _ := CanFly(astrotime.Observer{Lat: latitude, Lon: longitude}, t, Part107)
+	return ok
+}
@@ -0,0 +1,108 @@
+package drone
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestCalculate(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	o := astrotime.Observer{Lat: 40.71, Lon: -74.01, Location: ny}
+	day := time.Date(2024, 6, 21, 12, 0, 0, 0, ny)
+	for _, tt := range []struct {
+		rule       Rule
+		start, end string
+	}{
+		{Part107, "04:51:40", "21:04:15"},
+		{CASA, "04:51:40", "21:04:15"},
+		{Daylight, "05:25:06", "20:30:49"},
+	} {
+		w, err := Calculate(o, day, tt.rule)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.rule.Name, err)
+		}
+		if got := w.Start.Format("15:04:05"); got != tt.start {
+			t.Errorf("%s: got start %s, want %s", tt.rule.Name, got, tt.start)
+		}
+		if got := w.End.Format("15:04:05"); got != tt.end {
+			t.Errorf("%s: got end %s, want %s", tt.rule.Name, got, tt.end)
+		}
+		if got := w.Sunrise.Format("15:04:05"); got != "05:25:06" {
+			t.Errorf("%s: got sunrise %s, want 05:25:06", tt.rule.Name, got)
+		}
+	}
+}
+
+func TestCalculatePolar(t *testing.T) {
+	day := time.Date(2024, 6, 21, 12, 0, 0, 0, time.UTC)
+	midnight := time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		name       string
+		lat        float64
+		rule       Rule
+		start, end time.Time
+	}{
+		{"midnight sun", 70, Part107, midnight, midnight.AddDate(0, 0, 1)},
+		{"white night", 62, Part107, midnight, midnight.AddDate(0, 0, 1)},
+		{"polar night", -70, Part107, time.Date(2024, 6, 21, 8, 38, 15, 0, time.UTC), time.Date(2024, 6, 21, 12, 45, 34, 0, time.UTC)},
+		{"polar night by day", -70, Daylight, time.Time{}, time.Time{}},
+	} {
+		w, err := Calculate(astrotime.Observer{Lat: tt.lat, Lon: 20}, day, tt.rule)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if !w.Start.Equal(tt.start) || !w.End.Equal(tt.end) {
+			t.Errorf("%s: got %v to %v, want %v to %v", tt.name, w.Start, w.End, tt.start, tt.end)
+		}
+	}
+}
+
+func TestCanFly(t *testing.T) {
+	// The window is that of the day in the observer's time zone.
+	o := astrotime.Observer{Lat: 40.71, Lon: -74.01, Location: time.FixedZone("EDT", -4*3600)}
+	for _, tt := range []struct {
+		at            string
+		fly, lighting bool
+	}{
+		{"2024-06-21T08:45:00Z", false, false},
+		{"2024-06-21T09:00:00Z", true, true},
+		{"2024-06-21T16:00:00Z", true, false},
+		{"2024-06-22T00:50:00Z", true, true},
+		{"2024-06-22T01:10:00Z", false, false},
+	} {
+		at, _ := time.Parse(time.RFC3339, tt.at)
+		fly, err := CanFly(o, at, Part107)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lighting, err := NeedsLighting(o, at, Part107)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fly != tt.fly || lighting != tt.lighting {
+			t.Errorf("%s: got %v, %v, want %v, %v", tt.at, fly, lighting, tt.fly, tt.lighting)
+		}
+	}
+}
+
+func TestCanFlyNow(t *testing.T) {
+	for _, tt := range []struct {
+		at   string
+		want bool
+	}{
+		{"2024-06-21T08:45:00Z", false},
+		{"2024-06-21T16:00:00Z", true},
+		{"2024-12-21T16:00:00Z", true},
+		{"2024-12-21T23:00:00Z", false},
+	} {
+		at, _ := time.Parse(time.RFC3339, tt.at)
+		if got := CanFlyNow(at, 40.71, -74.01); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.at, got, tt.want)
+		}
+	}
+}
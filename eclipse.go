@@ -0,0 +1,164 @@
+package astrotime
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// LunarEclipseKind classifies a lunar eclipse by how deeply the moon enters
+// the earth's shadow.
+type LunarEclipseKind int
+
+// Kinds of lunar eclipse.
+const (
+	// PenumbralEclipse: the moon passes only through the penumbra, and
+	// dims slightly.
+	PenumbralEclipse LunarEclipseKind = iota
+	// PartialEclipse: part of the moon passes through the umbra.
+	PartialEclipse
+	// TotalEclipse: the whole moon passes into the umbra.
+	TotalEclipse
+)
+
+var lunarEclipseKindNames = [...]string{
+	PenumbralEclipse: "Penumbral",
+	PartialEclipse:   "Partial",
+	TotalEclipse:     "Total",
+}
+
+func (k LunarEclipseKind) String() string {
+	if k < 0 || int(k) >= len(lunarEclipseKindNames) {
+		return "LunarEclipseKind(" + strconv.Itoa(int(k)) + ")"
+	}
+	return lunarEclipseKindNames[k]
+}
+
+// LunarEclipse describes an eclipse of the moon. Contact times are good to
+// within a few minutes.
+type LunarEclipse struct {
+	Kind LunarEclipseKind
+
+	// Maximum is the instant of greatest eclipse.
+	Maximum time.Time
+
+	// Gamma is the least distance of the moon's centre from the axis
+	// of the earth's shadow, in earth radii, negative when the moon passes
+	// south of it.
+	Gamma float64
+
+	// PenumbralMagnitude and UmbralMagnitude are the fractions of the
+	// moon's diameter immersed in the penumbra and umbra at greatest
+	// eclipse. UmbralMagnitude is negative for a penumbral eclipse.
+	PenumbralMagnitude, UmbralMagnitude float64
+
+	// Penumbral spans the first to the last contact with the penumbra.
+	Penumbral Interval
+
+	// Partial spans the first to the last contact with the umbra. It is
+	// zero for a penumbral eclipse.
+	Partial Interval
+
+	// Total spans totality. It is zero unless the eclipse is total.
+	Total Interval
+}
+
+// lunarEclipse calculates the eclipse at the full moon of lunation k, which
+// is an integer plus one half, using the method of Meeus, Astronomical
+// Algorithms, chapter 54. It reports false if there is no eclipse.
+func lunarEclipse(k float64) (LunarEclipse, bool) {
+	t := k / 1236.85
+	f := degToRad * (160.7108 + 390.67050284*k + t*t*(-0.0016118+t*(-0.00000227+t*0.000000011)))
+	if math.Abs(math.Sin(f)) > 0.36 {
+		return LunarEclipse{}, false
+	}
+	jde := 2451550.09766 + 29.530588861*k + t*t*(0.00015437+t*(-0.000000150+t*0.00000000073))
+	m := degToRad * (2.5534 + 29.10535670*k - t*t*(0.0000014+t*0.00000011))
+	mp := degToRad * (201.5643 + 385.81693528*k + t*t*(0.0107582+t*(0.00001238-t*0.000000058)))
+	omega := degToRad * (124.7746 - 1.56375588*k + t*t*(0.0020672+t*0.00000215))
+	e := 1 - t*(0.002516+0.0000074*t)
+	f1 := f - degToRad*0.02665*math.Sin(omega)
+	a1 := degToRad * (299.77 + 0.107408*k - 0.009173*t*t)
+
+	jde += -0.4065*math.Sin(mp) + 0.1727*e*math.Sin(m) + 0.0161*math.Sin(2*mp) -
+		0.0097*math.Sin(2*f1) + 0.0073*e*math.Sin(mp-m) - 0.0050*e*math.Sin(mp+m) -
+		0.0023*math.Sin(mp-2*f1) + 0.0021*e*math.Sin(2*m) + 0.0012*math.Sin(mp+2*f1) +
+		0.0006*e*math.Sin(2*mp+m) - 0.0004*math.Sin(3*mp) - 0.0003*e*math.Sin(m+2*f1) +
+		0.0003*math.Sin(a1) - 0.0002*e*math.Sin(m-2*f1) - 0.0002*e*math.Sin(2*mp-m) -
+		0.0002*math.Sin(omega)
+
+	p := 0.2070*e*math.Sin(m) + 0.0024*e*math.Sin(2*m) - 0.0392*math.Sin(mp) +
+		0.0116*math.Sin(2*mp) - 0.0073*e*math.Sin(mp+m) + 0.0067*e*math.Sin(mp-m) +
+		0.0118*math.Sin(2*f1)
+	q := 5.2207 - 0.0048*e*math.Cos(m) + 0.0020*e*math.Cos(2*m) - 0.3299*math.Cos(mp) -
+		0.0060*e*math.Cos(mp+m) + 0.0041*e*math.Cos(mp-m)
+	w := math.Abs(math.Cos(f1))
+	gamma := (p*math.Cos(f1) + q*math.Sin(f1)) * (1 - 0.0048*w)
+	u := 0.0059 + 0.0046*e*math.Cos(m) - 0.0182*math.Cos(mp) + 0.0004*math.Cos(2*mp) -
+		0.0005*math.Cos(m+mp)
+
+	ecl := LunarEclipse{
+		Gamma:              gamma,
+		PenumbralMagnitude: (1.5573 + u - math.Abs(gamma)) / 0.5450,
+		UmbralMagnitude:    (1.0128 - u - math.Abs(gamma)) / 0.5450,
+	}
+	if ecl.PenumbralMagnitude <= 0 {
+		return LunarEclipse{}, false
+	}
+	max := timeFromJulianDate(utFromTT(jde))
+	ecl.Maximum = max
+
+	// span returns the interval around the maximum for a shadow edge at
+	// distance r from the axis, in earth radii.
+	n := 0.5458 + 0.0400*math.Cos(mp) // the moon's hourly motion, in earth radii
+	span := func(r float64) Interval {
+		half := time.Duration(math.Sqrt(r*r-gamma*gamma) / n * float64(time.Hour))
+		return Interval{Start: max.Add(-half), End: max.Add(half)}
+	}
+	ecl.Penumbral = span(1.5573 + u)
+	switch {
+	case ecl.UmbralMagnitude >= 1:
+		ecl.Kind = TotalEclipse
+		ecl.Total = span(0.4678 - u)
+		fallthrough
+	case ecl.UmbralMagnitude > 0:
+		if ecl.Kind == PenumbralEclipse {
+			ecl.Kind = PartialEclipse
+		}
+		ecl.Partial = span(1.0128 - u)
+	}
+	return ecl, true
+}
+
+// NextLunarEclipse returns the first lunar eclipse whose greatest eclipse
+// falls after after.
+func NextLunarEclipse(after time.Time) (LunarEclipse, error) {
+	if y := after.Year(); y < MinYear || y > MaxYear {
+		return LunarEclipse{}, ErrDateOutOfRange
+	}
+	k := math.Floor((decimalYear(julianDate(after.UTC()))-2000)*12.3685) - 1.5
+	for {
+		if ecl, ok := lunarEclipse(k); ok && ecl.Maximum.After(after) {
+			loc := after.Location()
+			ecl.Maximum = ecl.Maximum.In(loc)
+			for _, iv := range []*Interval{&ecl.Penumbral, &ecl.Partial, &ecl.Total} {
+				if !iv.Start.IsZero() {
+					*iv = Interval{iv.Start.In(loc), iv.End.In(loc)}
+				}
+			}
+			return ecl, nil
+		}
+		k++
+	}
+}
+
+// LunarEclipseVisibility returns the parts of the eclipse, from first to last
+// penumbral contact, during which the moon is above the observer's horizon.
+// The result is empty if the eclipse cannot be seen from there.
+func (o Observer) LunarEclipseVisibility(e LunarEclipse) ([]Interval, error) {
+	if err := o.validate(e.Maximum); err != nil {
+		return nil, err
+	}
+	up := func(t time.Time) bool { return o.moonHorizonAltitude(t) >= 0 }
+	return o.windows(e.Penumbral.Start, e.Penumbral.End, up), nil
+}
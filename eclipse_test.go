@@ -0,0 +1,83 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNextLunarEclipse(t *testing.T) {
+	tests := []struct {
+		after    time.Time
+		kind     LunarEclipseKind
+		max      time.Time
+		umbral   float64
+		totality time.Duration
+	}{
+		{time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), TotalEclipse, time.Date(2022, 11, 8, 10, 59, 11, 0, time.UTC), 1.359, 85 * time.Minute},
+		{time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), PenumbralEclipse, time.Date(2023, 5, 5, 17, 22, 54, 0, time.UTC), -0.046, 0},
+		{time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), PartialEclipse, time.Date(2024, 9, 18, 2, 44, 18, 0, time.UTC), 0.085, 0},
+		{time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), TotalEclipse, time.Date(2025, 3, 14, 6, 58, 43, 0, time.UTC), 1.178, 65 * time.Minute},
+	}
+	for _, tt := range tests {
+		got, err := NextLunarEclipse(tt.after)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Kind != tt.kind {
+			t.Errorf("after %v: got %v eclipse, want %v", tt.after, got.Kind, tt.kind)
+		}
+		if d := got.Maximum.Sub(tt.max); d.Abs() > 2*time.Minute {
+			t.Errorf("after %v: got maximum %v, want %v", tt.after, got.Maximum, tt.max)
+		}
+		if math.Abs(got.UmbralMagnitude-tt.umbral) > 0.015 {
+			t.Errorf("after %v: got umbral magnitude %.3f, want %.3f", tt.after, got.UmbralMagnitude, tt.umbral)
+		}
+		if d := got.Total.Duration() - tt.totality; d.Abs() > 2*time.Minute {
+			t.Errorf("after %v: got totality %v, want %v", tt.after, got.Total.Duration(), tt.totality)
+		}
+		if !got.Penumbral.Contains(got.Maximum) {
+			t.Errorf("after %v: penumbral phase %v does not contain the maximum", tt.after, got.Penumbral)
+		}
+		if tt.kind == PenumbralEclipse && !got.Partial.Start.IsZero() {
+			t.Errorf("after %v: got partial phase %v for a penumbral eclipse", tt.after, got.Partial)
+		}
+	}
+}
+
+func TestLunarEclipseVisibility(t *testing.T) {
+	ecl, err := NextLunarEclipse(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The eclipse of 2022 November 8 was seen whole from Hawaii, set
+	// during it over New York, and was missed entirely in London.
+	tests := []struct {
+		name     string
+		o        Observer
+		min, max time.Duration
+	}{
+		{"Honolulu", Observer{Lat: 21.31, Lon: -157.86}, ecl.Penumbral.Duration(), ecl.Penumbral.Duration()},
+		{"New York", Observer{Lat: 40.71, Lon: -74.01}, time.Hour, 4 * time.Hour},
+		{"London", Observer{Lat: 51.51, Lon: -0.13}, 0, 0},
+	}
+	for _, tt := range tests {
+		windows, err := tt.o.LunarEclipseVisibility(ecl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var seen time.Duration
+		for _, w := range windows {
+			seen += w.Duration()
+		}
+		if seen < tt.min-time.Second || seen > tt.max+time.Second {
+			t.Errorf("%s: eclipse visible for %v, want %v to %v", tt.name, seen, tt.min, tt.max)
+		}
+	}
+}
+
+func TestLunarEclipseKindString(t *testing.T) {
+	if got := TotalEclipse.String(); got != "Total" {
+		t.Errorf("got %q, want Total", got)
+	}
+}
@@ -0,0 +1,93 @@
+// Package epoch converts between time.Time and the day counts and epochs
+// used by star catalogs, orbital elements and other astronomical data:
+// Julian and Modified Julian Dates, and Julian and Besselian epochs.
+//
+// The conversions count days on the time scale of the Time they are given,
+// without adding ΔT, so a Julian date of a UTC time is a Julian date in
+// UTC. Catalog epochs such as J2000.0 are strictly in Terrestrial Time,
+// about a minute ahead of UTC, which matters only for the fastest-moving
+// objects.
+package epoch
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// J2000 is the Julian date of the standard epoch J2000.0, 2000
+	// January 1 at 12h.
+	J2000 = 2451545.0
+
+	// B1950 is the Julian date of the Besselian epoch B1950.0.
+	B1950 = 2433282.4235
+
+	// mjdOffset is the Julian date of the Modified Julian Date epoch,
+	// 1858 November 17 at 0h.
+	mjdOffset = 2400000.5
+
+	// unixEpoch is the Julian date of 1970-01-01T00:00:00Z.
+	unixEpoch = 2440587.5
+
+	// julianYear and besselianYear are the lengths of the years the
+	// epochs count, in days.
+	julianYear    = 365.25
+	besselianYear = 365.242198781
+
+	// b1900 is the Julian date of the Besselian epoch B1900.0.
+	b1900 = 2415020.31352
+
+	day = float64(24 * time.Hour)
+)
+
+// JulianDate returns the Julian date of t: the days since noon on
+// 4713 BC January 1 in the proleptic Julian calendar.
+func JulianDate(t time.Time) float64 {
+	// Whole seconds and the fraction separately, to keep nanoseconds
+	// for dates far from 1970.
+	s := t.Unix()
+	return unixEpoch + float64(s)/86400 + float64(t.Nanosecond())/day
+}
+
+// FromJulianDate returns the UTC time of the Julian date jd.
+func FromJulianDate(jd float64) time.Time {
+	days := jd - unixEpoch
+	whole := math.Floor(days)
+	t := time.Unix(int64(whole)*86400, 0).UTC()
+	return t.Add(time.Duration((days - whole) * day))
+}
+
+// MJD returns the Modified Julian Date of t, the Julian date less
+// 2400000.5, which counts days from midnight on 1858 November 17.
+func MJD(t time.Time) float64 {
+	return JulianDate(t) - mjdOffset
+}
+
+// FromMJD returns the UTC time of the Modified Julian Date mjd.
+func FromMJD(mjd float64) time.Time {
+	return FromJulianDate(mjd + mjdOffset)
+}
+
+// JulianEpoch returns t as a Julian epoch, in Julian years of 365.25 days
+// from J2000.0: 2000.0 at 2000 January 1.5.
+func JulianEpoch(t time.Time) float64 {
+	return 2000 + (JulianDate(t)-J2000)/julianYear
+}
+
+// FromJulianEpoch returns the UTC time of the Julian epoch j, such as
+// 2000.0 for J2000.0.
+func FromJulianEpoch(j float64) time.Time {
+	return FromJulianDate(J2000 + (j-2000)*julianYear)
+}
+
+// BesselianEpoch returns t as a Besselian epoch, in tropical years from
+// B1900.0, as used by catalogs before 1984.
+func BesselianEpoch(t time.Time) float64 {
+	return 1900 + (JulianDate(t)-b1900)/besselianYear
+}
+
+// FromBesselianEpoch returns the UTC time of the Besselian epoch b, such as
+// 1950.0 for B1950.0.
+func FromBesselianEpoch(b float64) time.Time {
+	return FromJulianDate(b1900 + (b-1900)*besselianYear)
+}
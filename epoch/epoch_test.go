@@ -0,0 +1,62 @@
+package epoch
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestJulianDate(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		want float64
+	}{
+		{time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC), 2451545.0},
+		// Meeus, Astronomical Algorithms, example 7.a: 1957 October 4.81.
+		{time.Date(1957, 10, 4, 19, 26, 24, 0, time.UTC), 2436116.31},
+		{time.Date(1858, 11, 17, 0, 0, 0, 0, time.UTC), 2400000.5},
+		// Meeus: 333 January 27 at 12h in the Julian calendar, which is
+		// January 28 in the proleptic Gregorian calendar that Go uses.
+		{time.Date(333, 1, 28, 12, 0, 0, 0, time.UTC), 1842713.0},
+	}
+	for _, tt := range tests {
+		if got := JulianDate(tt.t); math.Abs(got-tt.want) > 1e-6 {
+			t.Errorf("JulianDate(%v) = %.6f, want %.6f", tt.t, got, tt.want)
+		}
+		if got := FromJulianDate(tt.want); got.Sub(tt.t).Abs() > time.Millisecond {
+			t.Errorf("FromJulianDate(%.6f) = %v, want %v", tt.want, got, tt.t)
+		}
+	}
+}
+
+func TestMJD(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := MJD(at); got != 60310 {
+		t.Errorf("MJD(%v) = %v, want 60310", at, got)
+	}
+	if got := FromMJD(60310); !got.Equal(at) {
+		t.Errorf("FromMJD(60310) = %v, want %v", got, at)
+	}
+}
+
+func TestEpochs(t *testing.T) {
+	j2000 := time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
+	if got := JulianEpoch(j2000); got != 2000 {
+		t.Errorf("JulianEpoch(J2000) = %v, want 2000", got)
+	}
+	if got := FromJulianEpoch(2000); !got.Equal(j2000) {
+		t.Errorf("FromJulianEpoch(2000) = %v, want %v", got, j2000)
+	}
+	// B1950.0 is JD 2433282.4235, 1949 December 31 at 22:09.
+	b1950 := FromBesselianEpoch(1950)
+	if d := JulianDate(b1950) - B1950; math.Abs(d) > 1e-4 {
+		t.Errorf("FromBesselianEpoch(1950) = %v, off by %.5f days", b1950, d)
+	}
+	if got := BesselianEpoch(j2000); math.Abs(got-2000.001278) > 1e-6 {
+		t.Errorf("BesselianEpoch(J2000) = %.6f, want 2000.001278", got)
+	}
+	at := time.Date(2024, 7, 1, 6, 30, 0, 0, time.UTC)
+	if got := FromBesselianEpoch(BesselianEpoch(at)); got.Sub(at).Abs() > time.Millisecond {
+		t.Errorf("round trip of %v gave %v", at, got)
+	}
+}
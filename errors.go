@@ -0,0 +1,111 @@
+package astrotime
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// MinYear and MaxYear bound the years the package calculates for, set by
+// the span of the ΔT polynomials. The solar series carry higher-order
+// terms that keep them usable to the ends of the range, but away from the
+// present the uncertainty of ΔT, hours by 2000 BC, dominates; see
+// WithDeltaT. Dates outside the range are rejected with ErrDateOutOfRange.
+const (
+	MinYear = -2000
+	MaxYear = 3000
+)
+
+var (
+	// ErrAlwaysAbove is returned when the sun stays above the altitude of
+	// the requested event all day, as in the polar summer, or a Body is
+	// circumpolar.
+	ErrAlwaysAbove = errors.New("astrotime: sun stays above the event altitude all day")
+
+	// ErrAlwaysBelow is returned when the sun stays below the altitude of
+	// the requested event all day, as in the polar winter, or a Body never
+	// rises.
+	ErrAlwaysBelow = errors.New("astrotime: sun stays below the event altitude all day")
+
+	// ErrNoEvent is returned when an event that usually happens once a
+	// day, such as moonrise, is skipped on a calendar day.
+	ErrNoEvent = errors.New("astrotime: event does not happen on this day")
+
+	// ErrSunDown is returned for a calculation, such as Shadow, that needs
+	// the sun above the horizon when it is below.
+	ErrSunDown = errors.New("astrotime: sun is below the horizon")
+
+	// ErrNoSuchDay is returned when no day of the requested year meets the
+	// condition of a search such as FirstSunsetAfter.
+	ErrNoSuchDay = errors.New("astrotime: no day of the year meets the condition")
+
+	// ErrNoTimezone is returned by a TimezoneResolver that does not know
+	// the time zone of a place.
+	ErrNoTimezone = errors.New("astrotime: no time zone for the place")
+
+	// ErrInvalidCoordinates is returned for latitudes and longitudes that
+	// are not finite or lie outside ±90° and ±180°.
+	ErrInvalidCoordinates = errors.New("astrotime: invalid coordinates")
+
+	// ErrDateOutOfRange is returned for dates outside the years the
+	// algorithms are valid for.
+	ErrDateOutOfRange = errors.New("astrotime: date out of supported range")
+)
+
+// isNoEvent reports whether err means the event does not happen on a day.
+func isNoEvent(err error) bool {
+	return errors.Is(err, ErrAlwaysAbove) || errors.Is(err, ErrAlwaysBelow) || errors.Is(err, ErrNoEvent)
+}
+
+// CoordinateError describes an invalid latitude or longitude.
+type CoordinateError struct {
+	// Coordinate is "latitude" or "longitude".
+	Coordinate string
+	Value      float64
+}
+
+func (e *CoordinateError) Error() string {
+	if math.IsNaN(e.Value) || math.IsInf(e.Value, 0) {
+		return fmt.Sprintf("astrotime: %s %v is not finite", e.Coordinate, e.Value)
+	}
+	limit := 90
+	if e.Coordinate == "longitude" {
+		limit = 180
+	}
+	return fmt.Sprintf("astrotime: %s %v out of range [-%d, %d]", e.Coordinate, e.Value, limit, limit)
+}
+
+// Unwrap returns ErrInvalidCoordinates, so that errors.Is matches it.
+func (e *CoordinateError) Unwrap() error {
+	return ErrInvalidCoordinates
+}
+
+// ValidateCoordinates checks that latitude and longitude are finite and
+// within ±90° and ±180° respectively, returning a *CoordinateError if not.
+func ValidateCoordinates(latitude, longitude float64) error {
+	if math.IsNaN(latitude) || math.Abs(latitude) > 90 {
+		return &CoordinateError{Coordinate: "latitude", Value: latitude}
+	}
+	if math.IsNaN(longitude) || math.Abs(longitude) > 180 {
+		return &CoordinateError{Coordinate: "longitude", Value: longitude}
+	}
+	return nil
+}
+
+// ValidateDate checks that t falls in the years MinYear to MaxYear,
+// returning ErrDateOutOfRange if not.
+func ValidateDate(t time.Time) error {
+	if y := t.Year(); y < MinYear || y > MaxYear {
+		return ErrDateOutOfRange
+	}
+	return nil
+}
+
+// validate checks the observer's coordinates and the date t.
+func (o Observer) validate(t time.Time) error {
+	if err := ValidateCoordinates(o.Lat, o.Lon); err != nil {
+		return err
+	}
+	return ValidateDate(t)
+}
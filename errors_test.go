@@ -0,0 +1,58 @@
+package astrotime
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestValidateCoordinates(t *testing.T) {
+	tests := []struct {
+		lat, lon float64
+		want     string
+	}{
+		{38.8895, -77.0352, ""},
+		{90, 180, ""},
+		{-90, -180, ""},
+		{90.5, 0, "astrotime: latitude 90.5 out of range [-90, 90]"},
+		{0, -181, "astrotime: longitude -181 out of range [-180, 180]"},
+		{math.NaN(), 0, "astrotime: latitude NaN is not finite"},
+		{0, math.Inf(1), "astrotime: longitude +Inf is not finite"},
+	}
+	for _, tt := range tests {
+		err := ValidateCoordinates(tt.lat, tt.lon)
+		if tt.want == "" {
+			if err != nil {
+				t.Errorf("ValidateCoordinates(%v, %v) = %v, want nil", tt.lat, tt.lon, err)
+			}
+			continue
+		}
+		var ce *CoordinateError
+		if !errors.As(err, &ce) || !errors.Is(err, ErrInvalidCoordinates) {
+			t.Errorf("ValidateCoordinates(%v, %v) = %#v, want *CoordinateError", tt.lat, tt.lon, err)
+			continue
+		}
+		if got := err.Error(); got != tt.want {
+			t.Errorf("got error %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestValidateDate(t *testing.T) {
+	tests := []struct {
+		year int
+		want error
+	}{
+		{2017, nil},
+		{MinYear, nil},
+		{MaxYear, nil},
+		{MinYear - 1, ErrDateOutOfRange},
+		{MaxYear + 1, ErrDateOutOfRange},
+	}
+	for _, tt := range tests {
+		if err := ValidateDate(time.Date(tt.year, 6, 1, 0, 0, 0, 0, time.UTC)); err != tt.want {
+			t.Errorf("ValidateDate(%d) = %v, want %v", tt.year, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package astrotime
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// EventKind identifies a daily solar event.
+type EventKind int
+
+// Solar events, in the order they happen on an ordinary day.
+const (
+	EventAstronomicalDawn EventKind = iota
+	EventNauticalDawn
+	EventCivilDawn
+	EventSunrise
+	EventSolarNoon
+	EventSunset
+	EventCivilDusk
+	EventNauticalDusk
+	EventAstronomicalDusk
+)
+
+// allEventKinds lists every EventKind in daily order.
+var allEventKinds = []EventKind{
+	EventAstronomicalDawn,
+	EventNauticalDawn,
+	EventCivilDawn,
+	EventSunrise,
+	EventSolarNoon,
+	EventSunset,
+	EventCivilDusk,
+	EventNauticalDusk,
+	EventAstronomicalDusk,
+}
+
+var eventKindNames = [...]string{
+	EventAstronomicalDawn: "AstronomicalDawn",
+	EventNauticalDawn:     "NauticalDawn",
+	EventCivilDawn:        "CivilDawn",
+	EventSunrise:          "Sunrise",
+	EventSolarNoon:        "SolarNoon",
+	EventSunset:           "Sunset",
+	EventCivilDusk:        "CivilDusk",
+	EventNauticalDusk:     "NauticalDusk",
+	EventAstronomicalDusk: "AstronomicalDusk",
+}
+
+func (k EventKind) String() string {
+	if k < 0 || int(k) >= len(eventKindNames) {
+		return "EventKind(" + strconv.Itoa(int(k)) + ")"
+	}
+	return eventKindNames[k]
+}
+
+// Event is an occurrence of a solar event.
+type Event struct {
+	Kind EventKind
+	Time time.Time
+}
+
+// EventTime calculates the time of the event kind on the day t.
+func (o Observer) EventTime(t time.Time, kind EventKind) (time.Time, error) {
+	if o.cache != nil {
+		return o.cachedEventTime(t, kind)
+	}
+	return o.eventTime(t, kind)
+}
+
+// eventTime is EventTime without the cache.
+func (o Observer) eventTime(t time.Time, kind EventKind) (time.Time, error) {
+	switch kind {
+	case EventAstronomicalDawn:
+		return o.twilight(t, o.sunrise, zenithAstronomical)
+	case EventNauticalDawn:
+		return o.twilight(t, o.sunrise, zenithNautical)
+	case EventCivilDawn:
+		return o.twilight(t, o.sunrise, zenithCivil)
+	case EventSunrise:
+		return o.event(t, o.sunrise)
+	case EventSolarNoon:
+		if err := o.validate(t); err != nil {
+			return time.Time{}, err
+		}
+		return o.round(o.solarNoon(o.local(t))), nil
+	case EventSunset:
+		return o.event(t, o.sunset)
+	case EventCivilDusk:
+		return o.twilight(t, o.sunset, zenithCivil)
+	case EventNauticalDusk:
+		return o.twilight(t, o.sunset, zenithNautical)
+	case EventAstronomicalDusk:
+		return o.twilight(t, o.sunset, zenithAstronomical)
+	}
+	return time.Time{}, fmt.Errorf("astrotime: unknown event kind %v", kind)
+}
+
+// twilight calculates the time on the day t at which the sun's centre
+// crosses zenith, ignoring refraction and the observer's elevation.
+func (o Observer) twilight(t time.Time, calc func(t time.Time, latitude, longitude, zenith float64) (time.Time, error), zenith float64) (time.Time, error) {
+	if err := o.validate(t); err != nil {
+		return time.Time{}, err
+	}
+	s, err := calc(o.local(t), o.Lat, o.Lon, zenith)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return o.round(s), nil
+}
+
+// NextEvent returns the soonest event after after of any of kinds, or of
+// any kind if none are given, looking up to a year ahead.
+func (o Observer) NextEvent(after time.Time, kinds ...EventKind) (Event, error) {
+	if len(kinds) == 0 {
+		kinds = allEventKinds
+	}
+	var (
+		next Event
+		err  error
+	)
+	for _, kind := range kinds {
+		kind := kind
+		t, kerr := o.next(after, func(t time.Time) (time.Time, error) {
+			return o.EventTime(t, kind)
+		})
+		switch {
+		case kerr != nil && !isNoEvent(kerr):
+			return Event{}, kerr
+		case kerr != nil:
+			err = kerr
+		case next.Time.IsZero() || t.Before(next.Time):
+			next = Event{Kind: kind, Time: t}
+		}
+	}
+	if next.Time.IsZero() {
+		return Event{}, err
+	}
+	return next, nil
+}
+
+// NextEvent returns the soonest event after after of any of kinds at the
+// location specified in latitude and longitude.
+func NextEvent(after time.Time, latitude, longitude float64, kinds ...EventKind) (Event, error) {
+	return Observer{Lat: latitude, Lon: longitude}.NextEvent(after, kinds...)
+}
+
+// dayEvents appends the events of kinds on the day t to buf in chronological
+// order, skipping kinds that do not happen that day.
+func (o Observer) dayEvents(t time.Time, kinds []EventKind, buf []Event) ([]Event, error) {
+	n := len(buf)
+	for _, kind := range kinds {
+		s, err := o.EventTime(t, kind)
+		if isNoEvent(err) {
+			continue
+		}
+		if err != nil {
+			return buf, err
+		}
+		buf = append(buf, Event{Kind: kind, Time: s})
+	}
+	sortEvents(buf[n:])
+	return buf, nil
+}
+
+// sortEvents sorts events into chronological order.
+func sortEvents(events []Event) {
+	sort.Slice(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+}
+
+// EventsBetween returns the events of kinds, or of every kind if none are
+// given, from start up to end in chronological order. Days on which an
+// event does not happen, such as during the polar summer and winter, are
+// skipped.
+func (o Observer) EventsBetween(start, end time.Time, kinds ...EventKind) ([]Event, error) {
+	if len(kinds) == 0 {
+		kinds = allEventKinds
+	}
+	start, end = o.local(start), o.local(end)
+
+	// Events belonging to a calendar day can fall on the neighboring UTC
+	// days, so look one day beyond the span on either side.
+	var events []Event
+	for t := start.AddDate(0, 0, -1); !t.After(end.AddDate(0, 0, 1)); t = t.AddDate(0, 0, 1) {
+		n := len(events)
+		var err error
+		if events, err = o.dayEvents(t, kinds, events); err != nil {
+			return nil, err
+		}
+		keep := events[:n]
+		for _, ev := range events[n:] {
+			if ev.Time.Before(start) || !ev.Time.Before(end) || (len(keep) > 0 && !ev.Time.After(keep[len(keep)-1].Time)) {
+				continue
+			}
+			keep = append(keep, ev)
+		}
+		events = keep
+	}
+	return events, nil
+}
+
+// EventsBetween returns the events of kinds from start up to end at the
+// location specified in latitude and longitude.
+func EventsBetween(start, end time.Time, latitude, longitude float64, kinds ...EventKind) ([]Event, error) {
+	return Observer{Lat: latitude, Lon: longitude}.EventsBetween(start, end, kinds...)
+}
@@ -0,0 +1,95 @@
+package astrotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEventTimeOrder(t *testing.T) {
+	o := Observer{Lat: 38.8895, Lon: -77.0352}
+	day := p("2017-10-15T12:00:00Z")
+	var prev time.Time
+	for _, kind := range allEventKinds {
+		got, err := o.EventTime(day, kind)
+		if err != nil {
+			t.Fatalf("%v: %v", kind, err)
+		}
+		if !got.After(prev) {
+			t.Errorf("got %v at %s, want after %s", kind, got, prev)
+		}
+		prev = got
+	}
+	if _, err := o.EventTime(day, EventKind(42)); err == nil {
+		t.Error("got no error for unknown event kind")
+	}
+}
+
+func TestNextEvent(t *testing.T) {
+	d := places["melbourne"].times[2]
+	tests := []struct {
+		after time.Time
+		kinds []EventKind
+		want  Event
+	}{
+		{d.sunrise.Add(-10 * time.Minute), nil, Event{EventSunrise, d.sunrise}},
+		{d.sunrise.Add(time.Minute), nil, Event{EventSolarNoon, p("2017-10-15T02:06:04Z")}},
+		{d.sunrise.Add(time.Minute), []EventKind{EventSunset, EventSunrise}, Event{EventSunset, d.sunset}},
+	}
+	for _, tt := range tests {
+		got, err := NextEvent(tt.after, -37.8136, 144.9631, tt.kinds...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := got.Time.Sub(tt.want.Time); got.Kind != tt.want.Kind || d < -time.Minute || d > time.Minute {
+			t.Errorf("got next event %v at %s, want %v at %s", got.Kind, got.Time, tt.want.Kind, tt.want.Time)
+		}
+	}
+}
+
+func TestNextEventWhiteNights(t *testing.T) {
+	// Astronomical dusk does not happen at Reykjavik in midsummer.
+	got, err := NextEvent(p("2017-06-21T12:00:00Z"), 64.1265, -21.8174, EventAstronomicalDusk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Time.Month() != time.September {
+		t.Errorf("got next astronomical dusk %s, want in September", got.Time)
+	}
+	if _, err := NextEvent(p("2017-06-21T12:00:00Z"), 95, 0); !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("got error %v, want ErrInvalidCoordinates", err)
+	}
+}
+
+func TestEventsBetween(t *testing.T) {
+	start, end := p("2017-10-15T00:00:00Z"), p("2017-10-22T00:00:00Z")
+	got, err := EventsBetween(start, end, 38.8895, -77.0352, EventSunrise, EventSunset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 14 {
+		t.Fatalf("got %d events in a week, want 14", len(got))
+	}
+	for i, ev := range got {
+		if ev.Time.Before(start) || !ev.Time.Before(end) {
+			t.Errorf("got event at %s, want within [%s, %s)", ev.Time, start, end)
+		}
+		if i > 0 && !ev.Time.After(got[i-1].Time) {
+			t.Errorf("got event at %s after %s, want chronological order", ev.Time, got[i-1].Time)
+		}
+	}
+}
+
+func TestEventsBetweenPolar(t *testing.T) {
+	// Longyearbyen has no sunset from late April to late August.
+	got, err := EventsBetween(p("2017-01-01T00:00:00Z"), p("2018-01-01T00:00:00Z"), 78.2232, 15.6267, EventSunset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i < len(got); i++ {
+		if gap := got[i].Time.Sub(got[i-1].Time); gap > 100*24*time.Hour {
+			return
+		}
+	}
+	t.Errorf("got %d sunsets with no polar gap", len(got))
+}
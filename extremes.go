@@ -0,0 +1,57 @@
+package astrotime
+
+import "time"
+
+// SunExtremes holds the sunrises and sunsets of a year that are earliest
+// and latest by the clock. They do not fall on the solstices: because the
+// equation of time shifts solar noon through the year, at London the
+// earliest sunset comes in mid December and the latest sunrise around the
+// turn of the year.
+type SunExtremes struct {
+	EarliestSunrise, LatestSunrise time.Time
+	EarliestSunset, LatestSunset   time.Time
+}
+
+// SunExtremes finds the earliest and latest sunrise and sunset of a
+// calendar year in the observer's time zone, or UTC if it has none,
+// comparing the times of day on the clock, daylight saving time included.
+// It calculates every day of the year, as SunTimesRange, and takes the
+// first of days that tie to the precision. Days without a sunrise or
+// sunset are passed over; if the sun neither rises nor sets all year, the
+// error is ErrAlwaysAbove or ErrAlwaysBelow.
+func (o Observer) SunExtremes(year int) (SunExtremes, error) {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, o.zone())
+	days, err := o.SunTimesRange(start, start.AddDate(1, 0, -1))
+	if err != nil {
+		return SunExtremes{}, err
+	}
+	var e SunExtremes
+	for _, d := range days {
+		if !d.Sunrise.IsZero() {
+			if e.EarliestSunrise.IsZero() || clock(d.Sunrise) < clock(e.EarliestSunrise) {
+				e.EarliestSunrise = d.Sunrise
+			}
+			if e.LatestSunrise.IsZero() || clock(d.Sunrise) > clock(e.LatestSunrise) {
+				e.LatestSunrise = d.Sunrise
+			}
+		}
+		if !d.Sunset.IsZero() {
+			if e.EarliestSunset.IsZero() || clock(d.Sunset) < clock(e.EarliestSunset) {
+				e.EarliestSunset = d.Sunset
+			}
+			if e.LatestSunset.IsZero() || clock(d.Sunset) > clock(e.LatestSunset) {
+				e.LatestSunset = d.Sunset
+			}
+		}
+	}
+	if e.EarliestSunrise.IsZero() && e.EarliestSunset.IsZero() {
+		return SunExtremes{}, days[0].Err
+	}
+	return e, nil
+}
+
+// clock returns the time of day of t on the clock of its location.
+func clock(t time.Time) time.Duration {
+	h, m, s := t.Clock()
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second + time.Duration(t.Nanosecond())
+}
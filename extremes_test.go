@@ -0,0 +1,62 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSunExtremes(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip(err)
+	}
+	sydney, err := time.LoadLocation("Australia/Sydney")
+	if err != nil {
+		t.Skip(err)
+	}
+	for _, test := range []struct {
+		o    Observer
+		want SunExtremes
+	}{
+		{
+			Observer{Lat: 51.4769, Lon: -0.0005, Location: london},
+			SunExtremes{
+				EarliestSunrise: time.Date(2024, time.June, 16, 4, 42, 18, 0, london),
+				LatestSunrise:   time.Date(2024, time.December, 30, 8, 5, 35, 0, london),
+				EarliestSunset:  time.Date(2024, time.December, 12, 15, 50, 58, 0, london),
+				LatestSunset:    time.Date(2024, time.June, 24, 21, 21, 15, 0, london),
+			},
+		},
+		{
+			// Without a time zone the clock is UTC, free of summer time.
+			NewObserver(51.4769, -0.0005),
+			SunExtremes{
+				EarliestSunrise: time.Date(2024, time.June, 16, 3, 42, 19, 0, time.UTC),
+				LatestSunrise:   time.Date(2024, time.December, 30, 8, 5, 35, 0, time.UTC),
+				EarliestSunset:  time.Date(2024, time.December, 12, 15, 50, 58, 0, time.UTC),
+				LatestSunset:    time.Date(2024, time.June, 24, 20, 21, 15, 0, time.UTC),
+			},
+		},
+		{
+			// Clocks go forward on 6 October, ending the early sunrises.
+			Observer{Lat: -33.87, Lon: 151.21, Location: sydney},
+			SunExtremes{
+				EarliestSunrise: time.Date(2024, time.October, 5, 5, 26, 12, 0, sydney),
+				LatestSunrise:   time.Date(2024, time.April, 6, 7, 11, 25, 0, sydney),
+				EarliestSunset:  time.Date(2024, time.June, 11, 16, 52, 48, 0, sydney),
+				LatestSunset:    time.Date(2024, time.January, 7, 20, 9, 59, 0, sydney),
+			},
+		},
+	} {
+		got, err := test.o.SunExtremes(2024)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%v, %v: got %+v, want %+v", test.o.Lat, test.o.Lon, got, test.want)
+		}
+	}
+	if _, err := NewObserver(80, 0, WithZenith(30)).SunExtremes(2024); err != ErrAlwaysBelow {
+		t.Errorf("got %v, want ErrAlwaysBelow", err)
+	}
+}
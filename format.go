@@ -0,0 +1,119 @@
+package astrotime
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// displayLayout is the layout of times in the String methods of events.
+const displayLayout = "2006-01-02 15:04:05 MST"
+
+var eventKindLabels = [...]string{
+	EventAstronomicalDawn: "Astronomical dawn",
+	EventNauticalDawn:     "Nautical dawn",
+	EventCivilDawn:        "Civil dawn",
+	EventSunrise:          "Sunrise",
+	EventSolarNoon:        "Solar noon",
+	EventSunset:           "Sunset",
+	EventCivilDusk:        "Civil dusk",
+	EventNauticalDusk:     "Nautical dusk",
+	EventAstronomicalDusk: "Astronomical dusk",
+}
+
+// Label returns the name of the kind for people, such as "Civil dawn".
+func (k EventKind) Label() string {
+	if k < 0 || int(k) >= len(eventKindLabels) {
+		return k.String()
+	}
+	return eventKindLabels[k]
+}
+
+var moonEventKindLabels = [...]string{
+	MoonEventNewMoon:      "New moon",
+	MoonEventFirstQuarter: "First quarter",
+	MoonEventFullMoon:     "Full moon",
+	MoonEventLastQuarter:  "Last quarter",
+	MoonEventPerigee:      "Perigee",
+	MoonEventApogee:       "Apogee",
+	MoonEventMoonrise:     "Moonrise",
+	MoonEventMoonset:      "Moonset",
+	MoonEventTransit:      "Transit",
+	MoonEventAntiTransit:  "Anti-transit",
+}
+
+// Label returns the name of the kind for people, such as "First quarter".
+func (k MoonEventKind) Label() string {
+	if k < 0 || int(k) >= len(moonEventKindLabels) {
+		return k.String()
+	}
+	return moonEventKindLabels[k]
+}
+
+// String returns the event for people, such as "Sunset 2024-06-20
+// 21:20:48 BST".
+func (e Event) String() string {
+	return e.Kind.Label() + " " + e.Time.Format(displayLayout)
+}
+
+// String returns the event for people, such as "Full moon 2024-06-22
+// 01:07:52 UTC".
+func (e MoonEvent) String() string {
+	return e.Kind.Label() + " " + e.Time.Format(displayLayout)
+}
+
+// String returns the event for people, such as "Xiazhi 2024-06-20
+// 20:50:53 UTC".
+func (e SolarTermEvent) String() string {
+	return e.Term.String() + " " + e.Time.Format(displayLayout)
+}
+
+// String returns the interval for people, such as "2024-06-20 21:20:48
+// BST to 2024-06-20 22:08:30 BST".
+func (i Interval) String() string {
+	return i.Start.Format(displayLayout) + " to " + i.End.Format(displayLayout)
+}
+
+// String returns the place for people, such as "51.4769°N 0.0005°W".
+func (p LatLon) String() string {
+	ns, ew := "N", "E"
+	if p.Lat < 0 {
+		ns = "S"
+	}
+	if p.Lon < 0 {
+		ew = "W"
+	}
+	return strconv.FormatFloat(math.Abs(p.Lat), 'f', -1, 64) + "°" + ns + " " +
+		strconv.FormatFloat(math.Abs(p.Lon), 'f', -1, 64) + "°" + ew
+}
+
+// String returns the phase for people, such as "Waxing Gibbous, 78% lit".
+func (p LunarPhase) String() string {
+	return fmt.Sprintf("%v, %.0f%% lit", p.Name, 100*p.Illumination)
+}
+
+var compassPoints = [...]string{
+	"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE",
+	"S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW",
+}
+
+// Compass returns the point of the 16-point compass nearest the azimuth, in
+// degrees clockwise from north, such as "WNW" for 287°.
+func Compass(azimuth float64) string {
+	a := math.Mod(math.Mod(azimuth, 360)+360, 360)
+	return compassPoints[int(math.Round(a/22.5))%len(compassPoints)]
+}
+
+// FormatEvent formats the event for people with the observer's view of it:
+// its label, its time in the layout of the time package, in loc or, if it
+// is nil, in the event's own location, and the azimuth of the sun, such as
+// "Sunset 18:42 EDT, azimuth 287° WNW" with the layout "15:04 MST".
+func (o Observer) FormatEvent(ev Event, layout string, loc *time.Location) string {
+	t := ev.Time
+	if loc != nil {
+		t = t.In(loc)
+	}
+	az, _ := o.sunPosition(ev.Time)
+	return fmt.Sprintf("%s %s, azimuth %.0f° %s", ev.Kind.Label(), t.Format(layout), az, Compass(az))
+}
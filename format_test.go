@@ -0,0 +1,82 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLabel(t *testing.T) {
+	for _, tt := range []struct {
+		got, want string
+	}{
+		{EventSunrise.Label(), "Sunrise"},
+		{EventAstronomicalDusk.Label(), "Astronomical dusk"},
+		{EventKind(42).Label(), "EventKind(42)"},
+		{MoonEventAntiTransit.Label(), "Anti-transit"},
+		{MoonEventKind(42).Label(), "MoonEventKind(42)"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("got %q, want %q", tt.got, tt.want)
+		}
+	}
+}
+
+func TestStrings(t *testing.T) {
+	bst := time.FixedZone("BST", 3600)
+	set := time.Date(2024, 6, 20, 21, 20, 48, 0, bst)
+	for _, tt := range []struct {
+		got, want string
+	}{
+		{Event{EventSunset, set}.String(), "Sunset 2024-06-20 21:20:48 BST"},
+		{MoonEvent{MoonEventFullMoon, set}.String(), "Full moon 2024-06-20 21:20:48 BST"},
+		{SolarTermEvent{Xiazhi, set.UTC()}.String(), "Xiazhi 2024-06-20 20:20:48 UTC"},
+		{Interval{set, set.Add(47*time.Minute + 42*time.Second)}.String(), "2024-06-20 21:20:48 BST to 2024-06-20 22:08:30 BST"},
+		{LatLon{51.4769, -0.0005}.String(), "51.4769°N 0.0005°W"},
+		{LatLon{-33.86, 151.21}.String(), "33.86°S 151.21°E"},
+		{LunarPhase{Angle: 130, Illumination: 0.8214, Name: WaxingGibbous}.String(), "Waxing Gibbous, 82% lit"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("got %q, want %q", tt.got, tt.want)
+		}
+	}
+}
+
+func TestCompass(t *testing.T) {
+	for _, tt := range []struct {
+		azimuth float64
+		want    string
+	}{
+		{0, "N"},
+		{11.24, "N"},
+		{11.26, "NNE"},
+		{90, "E"},
+		{287, "WNW"},
+		{349, "N"},
+		{-90, "W"},
+		{720 + 180, "S"},
+	} {
+		if got := Compass(tt.azimuth); got != tt.want {
+			t.Errorf("Compass(%v) = %q, want %q", tt.azimuth, got, tt.want)
+		}
+	}
+}
+
+func TestFormatEvent(t *testing.T) {
+	edt, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	o := Observer{Lat: 40.7128, Lon: -74.006}
+	day := time.Date(2024, 9, 1, 0, 0, 0, 0, edt)
+	set, err := o.Sunset(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ev := Event{EventSunset, set}
+	if got, want := o.FormatEvent(ev, "15:04 MST", edt), "Sunset 19:27 EDT, azimuth 281° W"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := o.FormatEvent(ev, time.Kitchen, nil), "Sunset 7:27PM, azimuth 281° W"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,46 @@
+package astrotime
+
+import "time"
+
+const (
+	// galacticCentreRA and galacticCentreDec are the J2000 right ascension
+	// and declination of Sagittarius A*, at the core of the Milky Way, in
+	// degrees: 17h45m40s, −29°00′28″.
+	galacticCentreRA  = 266.41683
+	galacticCentreDec = -29.00781
+)
+
+// fixedHorizontal calculates the azimuth and altitude, in degrees, of a
+// fixed object at the right ascension and declination ra and dec at the
+// Julian date jd (UT), as seen by the observer.
+func (o Observer) fixedHorizontal(jd, ra, dec float64) (azimuth, altitude float64) {
+	return equatorialToHorizontal(o.Lat, gast(jd)+o.Lon-ra, dec)
+}
+
+// GalacticCore returns the intervals of the night following the calendar day
+// of t, from solar noon to the next, during which the core of the Milky
+// Way stands at least minAltitude degrees above the horizon in
+// astronomical darkness. The moon is not taken into account; intersect the
+// result with DarkSky for that. The result is empty if the core is not
+// visible that night.
+func (o Observer) GalacticCore(t time.Time, minAltitude float64) ([]Interval, error) {
+	if err := o.validate(t); err != nil {
+		return nil, err
+	}
+	visible := func(t time.Time) bool {
+		if !o.isNight(t) {
+			return false
+		}
+		_, alt := o.fixedHorizontal(julianDate(t.UTC()), galacticCentreRA, galacticCentreDec)
+		return alt >= minAltitude
+	}
+	start, end := o.night(t)
+	return o.windows(start, end, visible), nil
+}
+
+// GalacticCore returns the intervals of the night following the day t
+// during which the core of the Milky Way is at least minAltitude degrees up
+// in astronomical darkness, for an observer at the latitude and longitude.
+func GalacticCore(t time.Time, minAltitude, latitude, longitude float64) ([]Interval, error) {
+	return Observer{Lat: latitude, Lon: longitude}.GalacticCore(t, minAltitude)
+}
@@ -0,0 +1,50 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGalacticCore(t *testing.T) {
+	// Grand Canyon: the core season runs from late winter to autumn.
+	const lat, lon = 36.1, -112.1
+	tests := []struct {
+		month   time.Month
+		visible bool
+	}{
+		{time.January, false},
+		{time.March, true},
+		{time.July, true},
+		{time.November, false},
+	}
+	for _, tt := range tests {
+		windows, err := GalacticCore(time.Date(2024, tt.month, 15, 0, 0, 0, 0, time.UTC), 10, lat, lon)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := len(windows) > 0; got != tt.visible {
+			t.Errorf("%v: got visible %v (%v), want %v", tt.month, got, windows, tt.visible)
+		}
+	}
+
+	o := Observer{Lat: lat, Lon: lon}
+	windows, err := o.GalacticCore(time.Date(2024, 7, 15, 0, 0, 0, 0, time.UTC), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(windows) != 1 {
+		t.Fatalf("got %v, want one window", windows)
+	}
+	w := windows[0]
+	// In July the window opens at nightfall and closes as the core sets.
+	if !o.isNight(w.Start.Add(time.Minute)) || o.isNight(w.Start.Add(-time.Minute)) {
+		t.Errorf("window starts at %v, want astronomical dusk", w.Start)
+	}
+	_, alt := o.fixedHorizontal(julianDate(w.End), galacticCentreRA, galacticCentreDec)
+	if alt < 9.99 || alt > 10.01 {
+		t.Errorf("core altitude %.3f at window end, want 10", alt)
+	}
+	if h := w.Duration().Hours(); h < 4 || h > 5 {
+		t.Errorf("window lasts %.1fh, want 4 to 5", h)
+	}
+}
@@ -0,0 +1,83 @@
+package astrotime
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// goldenHourLow and goldenHourHigh are the default altitudes of the
+	// sun, in degrees, bounding the golden hour.
+	goldenHourLow  = -4
+	goldenHourHigh = 6
+)
+
+// altitudeBand is a range of solar altitudes in degrees.
+type altitudeBand struct {
+	low, high float64
+}
+
+// WithGoldenHour sets the altitudes of the sun, in degrees, between which
+// GoldenHour reports the golden hour, instead of −4° and +6°.
+func WithGoldenHour(low, high float64) Option {
+	return func(o *Observer) {
+		o.goldenHour = &altitudeBand{low, high}
+	}
+}
+
+// solarNoon calculates the time of solar noon, in local time, on the day t.
+func solarNoon(t time.Time, longitude float64) time.Time {
+	m := solNoonUTC(julianCentury(julianDate(t)), longitude)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Add(time.Duration(m * float64(time.Minute))).In(t.Location())
+}
+
+// band returns the morning and evening intervals on the day t during which
+// the sun's altitude lies between b.low and b.high. If the sun does not
+// climb to b.high, the intervals meet at solar noon.
+func (o Observer) band(t time.Time, b altitudeBand) (morning, evening Interval, err error) {
+	if err := o.validate(t); err != nil {
+		return Interval{}, Interval{}, err
+	}
+	t = o.local(t)
+	low, high := 90-b.low, 90-b.high
+
+	if morning.Start, err = o.sunrise(t, o.Lat, o.Lon, low); err != nil {
+		return Interval{}, Interval{}, err
+	}
+	if evening.End, err = o.sunset(t, o.Lat, o.Lon, low); err != nil {
+		return Interval{}, Interval{}, err
+	}
+	morning.End, err = o.sunrise(t, o.Lat, o.Lon, high)
+	if err == nil {
+		evening.Start, err = o.sunset(t, o.Lat, o.Lon, high)
+	}
+	switch {
+	case errors.Is(err, ErrAlwaysBelow):
+		morning.End = o.solarNoon(t)
+		evening.Start = morning.End
+	case err != nil:
+		return Interval{}, Interval{}, err
+	}
+
+	morning = Interval{o.round(morning.Start), o.round(morning.End)}
+	evening = Interval{o.round(evening.Start), o.round(evening.End)}
+	return morning, evening, nil
+}
+
+// GoldenHour calculates the morning and evening golden hours on the day t,
+// while the sun is between −4° and +6° altitude, or the range set by
+// WithGoldenHour. It returns ErrAlwaysAbove if the sun stays above the
+// lower altitude, and ErrAlwaysBelow if it never climbs to it.
+func (o Observer) GoldenHour(t time.Time) (morning, evening Interval, err error) {
+	b := altitudeBand{goldenHourLow, goldenHourHigh}
+	if o.goldenHour != nil {
+		b = *o.goldenHour
+	}
+	return o.band(t, b)
+}
+
+// GoldenHour calculates the morning and evening golden hours on the day t at
+// the location specified in latitude and longitude.
+func GoldenHour(t time.Time, latitude, longitude float64) (morning, evening Interval, err error) {
+	return Observer{Lat: latitude, Lon: longitude}.GoldenHour(t)
+}
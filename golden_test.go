@@ -0,0 +1,55 @@
+package astrotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestGoldenHour(t *testing.T) {
+	day := p("2017-10-15T12:00:00Z")
+	o := Observer{Lat: 38.8895, Lon: -77.0352}
+	morning, evening, err := o.GoldenHour(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sr, ss := sunriseOn(t, o, day), sunsetOn(t, o, day)
+	if !morning.Contains(sr) {
+		t.Errorf("got morning golden hour %v, want it to contain sunrise %s", morning, sr)
+	}
+	if !evening.Contains(ss) {
+		t.Errorf("got evening golden hour %v, want it to contain sunset %s", evening, ss)
+	}
+	for _, i := range []Interval{morning, evening} {
+		if d := i.Duration(); d < 50*time.Minute || d > 80*time.Minute {
+			t.Errorf("got golden hour lasting %s, want about an hour", d)
+		}
+	}
+}
+
+func TestGoldenHourLowSun(t *testing.T) {
+	// The winter sun at Reykjavik never reaches +6°.
+	day := p("2017-12-21T12:00:00Z")
+	morning, evening, err := GoldenHour(day, 64.1265, -21.8174)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if morning.End != evening.Start {
+		t.Errorf("got morning ending %s and evening starting %s, want them to meet at noon", morning.End, evening.Start)
+	}
+}
+
+func TestWithGoldenHour(t *testing.T) {
+	day := p("2017-10-15T12:00:00Z")
+	o := NewObserver(38.8895, -77.0352, WithGoldenHour(0, 10))
+	morning, _, err := o.GoldenHour(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sr := sunriseOn(t, o, day); !morning.Start.After(sr) {
+		t.Errorf("got golden hour from %s, want after sunrise %s", morning.Start, sr)
+	}
+	if _, _, err := GoldenHour(p("2017-06-21T12:00:00Z"), 78.2232, 15.6267); !errors.Is(err, ErrAlwaysAbove) {
+		t.Errorf("got error %v under the midnight sun, want ErrAlwaysAbove", err)
+	}
+}
@@ -0,0 +1,72 @@
+package astrotime
+
+import "time"
+
+// DaylightGrid holds the sunrise, sunset and length of daylight on one day
+// at each place of a grid of latitudes and longitudes, for heatmaps and
+// other maps of daylight. The slices hold one value for each place, row by
+// row of latitude: the value for Lats[i] and Lons[j] is at index
+// i*len(Lons)+j.
+type DaylightGrid struct {
+	Lats, Lons []float64
+
+	// Sunrise and Sunset are the zero Time where the sun does not rise or
+	// set that day.
+	Sunrise, Sunset []time.Time
+
+	// DayLength is the time from sunrise to sunset: 24 hours where the sun
+	// stays up all day and zero where it stays down.
+	DayLength []time.Duration
+}
+
+// At returns the values for Lats[i] and Lons[j].
+func (g *DaylightGrid) At(i, j int) (sunrise, sunset time.Time, dayLength time.Duration) {
+	k := i*len(g.Lons) + j
+	return g.Sunrise[k], g.Sunset[k], g.DayLength[k]
+}
+
+// NewDaylightGrid calculates the daylight on the day t at every place
+// with a latitude in lats and a longitude in lons, configured by opts as
+// for NewObserver. It gives the same results as Observer.Sunrise and
+// Observer.Sunset at each place, but with AlgorithmNOAA works out the sun's
+// position at solar noon once per longitude instead of twice per place.
+func NewDaylightGrid(t time.Time, lats, lons []float64, opts ...Option) (*DaylightGrid, error) {
+	o := NewObserver(0, 0, opts...)
+	if err := ValidateDate(t); err != nil {
+		return nil, err
+	}
+	for _, lat := range lats {
+		if err := ValidateCoordinates(lat, 0); err != nil {
+			return nil, err
+		}
+	}
+	for _, lon := range lons {
+		if err := ValidateCoordinates(0, lon); err != nil {
+			return nil, err
+		}
+	}
+	d := o.onDay(t)
+	n := len(lats) * len(lons)
+	g := &DaylightGrid{
+		Lats:      lats,
+		Lons:      lons,
+		Sunrise:   make([]time.Time, n),
+		Sunset:    make([]time.Time, n),
+		DayLength: make([]time.Duration, n),
+	}
+	for j, lon := range lons {
+		noon := d.noon(lon)
+		for i, lat := range lats {
+			k := i*len(lons) + j
+			var riseErr, setErr error
+			g.Sunrise[k], g.Sunset[k], riseErr, setErr = d.riseSet(noon, lat, lon)
+			switch {
+			case riseErr == ErrAlwaysAbove || setErr == ErrAlwaysAbove:
+				g.DayLength[k] = 24 * time.Hour
+			case riseErr == nil && setErr == nil:
+				g.DayLength[k] = g.Sunset[k].Sub(g.Sunrise[k])
+			}
+		}
+	}
+	return g, nil
+}
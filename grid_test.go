@@ -0,0 +1,54 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewDaylightGrid(t *testing.T) {
+	lats := []float64{-89, -66, -45, 0, 30, 51.5, 66, 70, 89}
+	lons := []float64{-180, -122.4, -0.1, 0, 37.6, 151.2, 179.9}
+	for _, opts := range [][]Option{
+		nil,
+		{WithElevation(1000), WithDeltaT(true)},
+		{WithAlgorithm(AlgorithmVSOP87)},
+	} {
+		for _, day := range []time.Time{p("2024-06-20T00:00:00Z"), p("2024-12-21T08:00:00+08:00")} {
+			g, err := NewDaylightGrid(day, lats, lons, opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, lat := range lats {
+				for j, lon := range lons {
+					o := NewObserver(lat, lon, opts...)
+					rise, set, length := g.At(i, j)
+					wantRise, riseErr := o.Sunrise(day)
+					wantSet, setErr := o.Sunset(day)
+					if !rise.Equal(wantRise) || !set.Equal(wantSet) {
+						t.Errorf("%s at %v, %v: got %s, %s, want %s, %s", day, lat, lon, rise, set, wantRise, wantSet)
+					}
+					var wantLength time.Duration
+					switch {
+					case riseErr == ErrAlwaysAbove:
+						wantLength = 24 * time.Hour
+					case riseErr == nil && setErr == nil:
+						wantLength = wantSet.Sub(wantRise)
+					}
+					if length != wantLength {
+						t.Errorf("%s at %v, %v: day length %s, want %s", day, lat, lon, length, wantLength)
+					}
+				}
+			}
+		}
+	}
+	g, _ := NewDaylightGrid(p("2024-06-20T00:00:00Z"), lats, lons)
+	if _, _, length := g.At(0, 0); length != 0 {
+		t.Errorf("day length near the South Pole in June %s, want 0", length)
+	}
+	if _, _, length := g.At(8, 0); length != 24*time.Hour {
+		t.Errorf("day length near the North Pole in June %s, want 24h", length)
+	}
+	if _, err := NewDaylightGrid(p("2024-06-20T00:00:00Z"), []float64{91}, lons); err == nil {
+		t.Error("latitude 91 accepted")
+	}
+}
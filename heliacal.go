@@ -0,0 +1,80 @@
+package astrotime
+
+import "time"
+
+// defaultArcusVisionis is the depression of the sun, in degrees, assumed
+// when HeliacalCriteria.SunDepression is zero: typical of a first-magnitude
+// star in a clear sky.
+const defaultArcusVisionis = 11
+
+// HeliacalCriteria sets when a star near the sun is visible in twilight.
+type HeliacalCriteria struct {
+	// SunDepression is how far below the horizon, in degrees, the sun
+	// must be for the star to be seen, known as the arcus visionis. Zero
+	// means 11°. Fainter stars need a darker sky and so a larger value.
+	SunDepression float64
+
+	// MinAltitude is the altitude, in degrees, that the star must reach
+	// when the sun is at that depression, above the haze of the horizon.
+	MinAltitude float64
+}
+
+func (c HeliacalCriteria) depression() float64 {
+	if c.SunDepression == 0 {
+		return defaultArcusVisionis
+	}
+	return c.SunDepression
+}
+
+// HeliacalRising returns the first morning after after on which the body is
+// seen again in the dawn after its months hidden in the sun's glare, at
+// the moment the sun reaches the criteria's depression. It returns
+// ErrNoEvent if the body is never hidden or never seen, as for a
+// circumpolar star.
+func (o Observer) HeliacalRising(b Body, after time.Time, c HeliacalCriteria) (time.Time, error) {
+	return o.heliacal(b, after, c, true)
+}
+
+// HeliacalSetting returns the last evening after after on which the body is
+// seen in the dusk before it is lost in the sun's glare, at the moment the
+// sun reaches the criteria's depression. Errors are as for HeliacalRising.
+func (o Observer) HeliacalSetting(b Body, after time.Time, c HeliacalCriteria) (time.Time, error) {
+	return o.heliacal(b, after, c, false)
+}
+
+func (o Observer) heliacal(b Body, after time.Time, c HeliacalCriteria, rising bool) (time.Time, error) {
+	if err := o.validate(after); err != nil {
+		return time.Time{}, err
+	}
+	zenith := 90 + c.depression()
+	// sighting returns the instant of twilight on the day t and whether the
+	// body is seen then.
+	sighting := func(t time.Time) (time.Time, bool) {
+		twilight, err := o.sunrise(t, o.Lat, o.Lon, zenith)
+		if !rising {
+			twilight, err = o.sunset(t, o.Lat, o.Lon, zenith)
+		}
+		if err != nil {
+			return time.Time{}, false
+		}
+		_, alt := o.fixedHorizontal(julianDate(twilight.UTC()), b.RA, b.Dec)
+		return twilight, alt >= c.MinAltitude
+	}
+
+	day, _ := o.dayBounds(after)
+	prevAt, prevSeen := sighting(day.AddDate(0, 0, -1))
+	// Two years covers a full cycle of visibility from any starting day.
+	for i := 0; i < 732; i++ {
+		at, seen := sighting(day.AddDate(0, 0, i))
+		// A rising is seen after a day it was not; a setting is the last
+		// day seen before one it is not.
+		switch {
+		case rising && seen && !prevSeen && at.After(after):
+			return o.round(at), nil
+		case !rising && prevSeen && !seen && prevAt.After(after):
+			return o.round(prevAt), nil
+		}
+		prevAt, prevSeen = at, seen
+	}
+	return time.Time{}, ErrNoEvent
+}
@@ -0,0 +1,48 @@
+package astrotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHeliacal(t *testing.T) {
+	// Sirius seen from Memphis, Egypt, is hidden for about 70 days around
+	// its conjunction with the sun, reappearing in early August.
+	o := Observer{Lat: 29.85, Lon: 31.25}
+	sirius := Body{RA: 101.28708, Dec: -16.71611}
+	c := HeliacalCriteria{MinAltitude: 1}
+	after := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rising, err := o.HeliacalRising(sirius, after, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rising.Before(time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)) || rising.After(time.Date(2024, 8, 12, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("got heliacal rising %v, want early August", rising)
+	}
+	setting, err := o.HeliacalSetting(sirius, after, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if days := rising.Sub(setting).Hours() / 24; days < 60 || days > 85 {
+		t.Errorf("got %.0f days of invisibility from %v to %v, want about 70", days, setting, rising)
+	}
+
+	// A stricter criterion delays the rising.
+	later, err := o.HeliacalRising(sirius, after, HeliacalCriteria{SunDepression: 14, MinAltitude: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !later.After(rising) {
+		t.Errorf("got %v with a deeper sun, want after %v", later, rising)
+	}
+}
+
+func TestHeliacalCircumpolar(t *testing.T) {
+	polaris := Body{RA: 37.95, Dec: 89.26}
+	_, err := Observer{Lat: 51.5}.HeliacalRising(polaris, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), HeliacalCriteria{})
+	if !errors.Is(err, ErrNoEvent) {
+		t.Errorf("got error %v, want ErrNoEvent", err)
+	}
+}
@@ -0,0 +1,124 @@
+// Package hunting calculates legal shooting hours, which most jurisdictions
+// set by offsets from sunrise and sunset: from half an hour before sunrise
+// to half an hour after sunset for many game seasons, and from half an hour
+// before sunrise to sunset for migratory birds in the United States. The
+// rules differ between jurisdictions, species and seasons, so a Rule may be
+// one of the presets or have any offsets.
+//
+// Offsets are durations added to the instants of sunrise and sunset, so
+// they are exact across changes of daylight saving time; the hours are
+// given in the observer's time zone. Game wardens publish tables of the
+// hours that round sunrise and sunset to the minute and may differ from
+// these by a minute.
+package hunting
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// Rule is a regulation setting shooting hours by sunrise and sunset.
+type Rule struct {
+	Name string
+
+	// FromSunrise is the offset from sunrise at which shooting begins, and
+	// FromSunset the offset from sunset at which it ends; negative offsets
+	// are before the event.
+	FromSunrise, FromSunset time.Duration
+}
+
+// Preset rules.
+var (
+	HalfHour        = Rule{Name: "½ hour before sunrise to ½ hour after sunset", FromSunrise: -30 * time.Minute, FromSunset: 30 * time.Minute}
+	MigratoryBirds  = Rule{Name: "½ hour before sunrise to sunset", FromSunrise: -30 * time.Minute}
+	SunriseToSunset = Rule{Name: "Sunrise to sunset"}
+	OneHour         = Rule{Name: "1 hour before sunrise to 1 hour after sunset", FromSunrise: -time.Hour, FromSunset: time.Hour}
+)
+
+// Rules are the preset rules.
+var Rules = []Rule{HalfHour, MigratoryBirds, SunriseToSunset, OneHour}
+
+// Hours are the shooting hours of a day.
+type Hours struct {
+	// Date is midnight at the start of the day, in the observer's time
+	// zone.
+	Date time.Time
+
+	Sunrise, Sunset time.Time
+
+	// Start and End are the legal shooting hours, by the rule.
+	Start, End time.Time
+}
+
+// Duration returns the length of the shooting hours, or zero on a day
+// without them.
+func (h Hours) Duration() time.Duration {
+	return max(0, h.End.Sub(h.Start))
+}
+
+// Contains reports whether t lies within the shooting hours.
+func (h Hours) Contains(t time.Time) bool {
+	return !h.Start.IsZero() && !t.Before(h.Start) && !t.After(h.End)
+}
+
+// Calculate calculates the shooting hours by the rule on the day t, as
+// seen by o. On days when the sun does not rise or set, in the polar
+// regions, it returns Hours with only the Date, and ErrAlwaysAbove or
+// ErrAlwaysBelow: such rules do not say what the hours are then.
+func Calculate(o astrotime.Observer, t time.Time, r Rule) (Hours, error) {
+	if o.Location != nil {
+		t = t.In(o.Location)
+	}
+	h := Hours{Date: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())}
+	rise, err := o.Sunrise(h.Date)
+	if err != nil {
+		return h, err
+	}
+	set, err := o.Sunset(h.Date)
+	if err != nil {
+		return h, err
+	}
+	h.Sunrise, h.Sunset = rise, set
+	h.Start, h.End = rise.Add(r.FromSunrise), set.Add(r.FromSunset)
+	return h, nil
+}
+
+// Legal reports whether t lies within the shooting hours of its day by the
+// rule, as seen by o. On days without them it reports false.
+func Legal(o astrotime.Observer, t time.Time, r Rule) (bool, error) {
+	h, err := Calculate(o, t, r)
+	if err != nil && !polar(err) {
+		return false, err
+	}
+	return h.Contains(t), nil
+}
+
+// Table calculates the shooting hours by the rule for each day from the day
+// of start to the day of end, inclusive, in the observer's time zone.
+// Days without them, when the sun does not rise or set, have only a Date.
+func Table(o astrotime.Observer, start, end time.Time, r Rule) ([]Hours, error) {
+	if o.Location != nil {
+		start, end = start.In(o.Location), end.In(o.Location)
+	}
+	y, m, d := start.Date()
+	last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	var days []Hours
+	for i := 0; ; i++ {
+		day := time.Date(y, m, d+i, 0, 0, 0, 0, start.Location())
+		if time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC).After(last) {
+			return days, nil
+		}
+		h, err := Calculate(o, day, r)
+		if err != nil && !polar(err) {
+			return nil, err
+		}
+		days = append(days, h)
+	}
+}
+
+// polar reports whether err is that the sun does not rise or set.
+func polar(err error) bool {
+	return errors.Is(err, astrotime.ErrAlwaysAbove) || errors.Is(err, astrotime.ErrAlwaysBelow)
+}
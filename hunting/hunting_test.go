@@ -0,0 +1,123 @@
+package hunting
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func albany(t *testing.T) astrotime.Observer {
+	t.Helper()
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	return astrotime.Observer{Lat: 42.65, Lon: -73.75, Location: ny}
+}
+
+func TestCalculate(t *testing.T) {
+	o := albany(t)
+	day := time.Date(2024, 11, 2, 12, 0, 0, 0, o.Location)
+	for _, tt := range []struct {
+		rule       Rule
+		start, end string
+	}{
+		{HalfHour, "07:00", "18:15"},
+		{MigratoryBirds, "07:00", "17:45"},
+		{SunriseToSunset, "07:30", "17:45"},
+		{OneHour, "06:30", "18:45"},
+		{Rule{FromSunrise: 15 * time.Minute, FromSunset: -15 * time.Minute}, "07:45", "17:30"},
+	} {
+		h, err := Calculate(o, day, tt.rule)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.rule.Name, err)
+		}
+		if got := h.Start.Format("15:04"); got != tt.start {
+			t.Errorf("%s: got start %s, want %s", tt.rule.Name, got, tt.start)
+		}
+		if got := h.End.Format("15:04"); got != tt.end {
+			t.Errorf("%s: got end %s, want %s", tt.rule.Name, got, tt.end)
+		}
+	}
+}
+
+func TestTableDST(t *testing.T) {
+	o := albany(t)
+	start := time.Date(2024, 11, 2, 0, 0, 0, 0, o.Location)
+	days, err := Table(o, start, start.AddDate(0, 0, 2), HalfHour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Daylight saving time ends at 2:00 on 3 November, moving the hours an
+	// hour earlier on the clock but not shortening them.
+	want := []struct {
+		date, start, end string
+		length           time.Duration
+	}{
+		{"2024-11-02 EDT", "07:00:33 EDT", "18:15:54 EDT", 11*time.Hour + 15*time.Minute + 21*time.Second},
+		{"2024-11-03 EDT", "06:01:49 EST", "17:14:39 EST", 11*time.Hour + 12*time.Minute + 50*time.Second},
+		{"2024-11-04 EST", "06:03:02 EST", "17:13:29 EST", 11*time.Hour + 10*time.Minute + 27*time.Second},
+	}
+	if len(days) != len(want) {
+		t.Fatalf("got %d days, want %d", len(days), len(want))
+	}
+	for i, w := range want {
+		h := days[i]
+		if got := h.Date.Format("2006-01-02 MST"); got != w.date {
+			t.Errorf("day %d: got date %s, want %s", i, got, w.date)
+		}
+		if got := h.Start.Format("15:04:05 MST"); got != w.start {
+			t.Errorf("%s: got start %s, want %s", w.date, got, w.start)
+		}
+		if got := h.End.Format("15:04:05 MST"); got != w.end {
+			t.Errorf("%s: got end %s, want %s", w.date, got, w.end)
+		}
+		if got := h.Duration(); got != w.length {
+			t.Errorf("%s: got %v, want %v", w.date, got, w.length)
+		}
+	}
+}
+
+func TestLegal(t *testing.T) {
+	o := albany(t)
+	for _, tt := range []struct {
+		clock string
+		want  bool
+	}{
+		{"06:59", false},
+		{"07:01", true},
+		{"18:15", true},
+		{"18:17", false},
+	} {
+		c, _ := time.Parse("15:04", tt.clock)
+		at := time.Date(2024, 11, 2, c.Hour(), c.Minute(), 0, 0, o.Location)
+		got, err := Legal(o, at, HalfHour)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.clock, got, tt.want)
+		}
+	}
+}
+
+func TestPolar(t *testing.T) {
+	o := astrotime.Observer{Lat: 78.2, Lon: 15.6}
+	day := time.Date(2024, 12, 21, 12, 0, 0, 0, time.UTC)
+	h, err := Calculate(o, day, HalfHour)
+	if !errors.Is(err, astrotime.ErrAlwaysBelow) {
+		t.Errorf("got error %v, want ErrAlwaysBelow", err)
+	}
+	if !h.Start.IsZero() || h.Duration() != 0 {
+		t.Errorf("got hours %v to %v, want none", h.Start, h.End)
+	}
+	if ok, err := Legal(o, day, HalfHour); ok || err != nil {
+		t.Errorf("got %v, %v, want false, nil", ok, err)
+	}
+	days, err := Table(o, day, day.AddDate(0, 0, 1), HalfHour)
+	if err != nil || len(days) != 2 {
+		t.Errorf("got %d days, %v, want 2, nil", len(days), err)
+	}
+}
@@ -0,0 +1,236 @@
+// Package ics writes the sun's daily events at a place as an iCalendar
+// (RFC 5545) calendar, which calendar applications can import or subscribe
+// to.
+//
+// Each event is a VEVENT of no duration, marked as transparent so that it
+// does not show the time as busy. Events are given in the observer's time
+// zone, described by a VTIMEZONE built from the time zone database, so that
+// applications show them at the right local time either side of a change
+// of clocks; without a named time zone they are given in UTC.
+package ics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dntj/astrotime"
+	"github.com/dntj/astrotime/table"
+)
+
+// DefaultKinds are the events of a calendar unless others are chosen.
+var DefaultKinds = []astrotime.EventKind{astrotime.EventSunrise, astrotime.EventSunset}
+
+// Calendar is a calendar of the sun's events at a place over a range of
+// days.
+type Calendar struct {
+	// Observer is the place. Its Location is the time zone of the events;
+	// if it is nil, UTC or time.Local, which has no name a calendar
+	// application could look up, the events are given in UTC.
+	Observer astrotime.Observer
+
+	// Start and End are the first and last days of the calendar,
+	// inclusive, read in the observer's time zone.
+	Start, End time.Time
+
+	// Kinds are the events of each day, DefaultKinds if empty. Days on
+	// which an event does not happen have no event for it.
+	Kinds []astrotime.EventKind
+
+	// Name, if set, names the calendar in calendar applications.
+	Name string
+
+	// Stamp is the time the calendar was made, the DTSTAMP of each event;
+	// the current time if zero.
+	Stamp time.Time
+}
+
+// Encode writes the calendar to w.
+func (c Calendar) Encode(w io.Writer) error {
+	kinds := c.Kinds
+	if len(kinds) == 0 {
+		kinds = DefaultKinds
+	}
+	for _, kind := range kinds {
+		if kind < astrotime.EventAstronomicalDawn || kind > astrotime.EventAstronomicalDusk {
+			return fmt.Errorf("ics: unknown event kind %v", kind)
+		}
+	}
+	stamp := c.Stamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	loc := c.Observer.Location
+	if loc == time.Local {
+		loc = nil
+	}
+
+	cw := &writer{w: bufio.NewWriter(w)}
+	cw.line("BEGIN:VCALENDAR")
+	cw.line("VERSION:2.0")
+	cw.line("PRODID:-//dntj//astrotime//EN")
+	cw.line("CALSCALE:GREGORIAN")
+	cw.line("METHOD:PUBLISH")
+	if c.Name != "" {
+		cw.line("X-WR-CALNAME:" + escape(c.Name))
+	}
+	if loc != nil && loc != time.UTC {
+		cw.line("X-WR-TIMEZONE:" + loc.String())
+		cw.timeZone(loc, c.Start.In(loc), c.End.In(loc).AddDate(0, 0, 1))
+	}
+
+	lat := strconv.FormatFloat(c.Observer.Lat, 'f', -1, 64)
+	lon := strconv.FormatFloat(c.Observer.Lon, 'f', -1, 64)
+	o := c.Observer
+	if o.Location == nil {
+		o.Location = time.UTC
+	}
+	err := table.Generate(o, c.Start, c.End, func(r table.Row) error {
+		for _, kind := range kinds {
+			t, err := r.Time(kind)
+			if err != nil {
+				continue
+			}
+			cw.line("BEGIN:VEVENT")
+			cw.line("UID:" + r.Date.Format("20060102") + "-" + strings.ToLower(kind.String()) + "-" + lat + "_" + lon + "@astrotime")
+			cw.line("DTSTAMP:" + stamp.UTC().Format(utcFormat))
+			cw.line("DTSTART" + dateTime(t, loc))
+			cw.line("SUMMARY:" + kind.Label())
+			cw.line("GEO:" + lat + ";" + lon)
+			cw.line("TRANSP:TRANSPARENT")
+			cw.line("END:VEVENT")
+		}
+		return cw.err
+	})
+	if err != nil {
+		return err
+	}
+	cw.line("END:VCALENDAR")
+	if cw.err != nil {
+		return cw.err
+	}
+	return cw.w.Flush()
+}
+
+const (
+	utcFormat   = "20060102T150405Z"
+	localFormat = "20060102T150405"
+)
+
+// dateTime formats t as the value of a date-time property, with its
+// parameters: in loc, named by TZID, or in UTC if loc is nil or UTC.
+func dateTime(t time.Time, loc *time.Location) string {
+	if loc == nil || loc == time.UTC {
+		return ":" + t.UTC().Format(utcFormat)
+	}
+	return ";TZID=" + loc.String() + ":" + t.In(loc).Format(localFormat)
+}
+
+// timeZone writes a VTIMEZONE for loc from start to end: an observance for
+// the offset in force at start, then one for each change of offset, found
+// by stepping a day at a time and bisecting to the second.
+func (w *writer) timeZone(loc *time.Location, start, end time.Time) {
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+	w.line("BEGIN:VTIMEZONE")
+	w.line("TZID:" + loc.String())
+	_, offset := start.Zone()
+	w.observance(start, offset)
+	for t := start; t.Before(end); {
+		next := t.Add(24 * time.Hour)
+		if !sameZone(t, next) {
+			lo, hi := t, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Second)
+				if sameZone(lo, mid) {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			_, from := lo.Zone()
+			w.observance(hi, from)
+			next = hi
+		}
+		t = next
+	}
+	w.line("END:VTIMEZONE")
+}
+
+// observance writes the STANDARD or DAYLIGHT observance of the zone in
+// force from t, when the offset changed from the offset from, in seconds.
+func (w *writer) observance(t time.Time, from int) {
+	kind := "STANDARD"
+	if t.IsDST() {
+		kind = "DAYLIGHT"
+	}
+	name, to := t.Zone()
+	w.line("BEGIN:" + kind)
+	// The onset is given in local time before the change.
+	w.line("DTSTART:" + t.UTC().Add(time.Duration(from)*time.Second).Format(localFormat))
+	w.line("TZOFFSETFROM:" + utcOffset(from))
+	w.line("TZOFFSETTO:" + utcOffset(to))
+	w.line("TZNAME:" + escape(name))
+	w.line("END:" + kind)
+}
+
+// sameZone reports whether a and b have the same zone name and offset.
+func sameZone(a, b time.Time) bool {
+	an, ao := a.Zone()
+	bn, bo := b.Zone()
+	return an == bn && ao == bo && a.IsDST() == b.IsDST()
+}
+
+// utcOffset formats an offset from UTC in seconds, such as +0100, with the
+// seconds only if there are any.
+func utcOffset(secs int) string {
+	sign := byte('+')
+	if secs < 0 {
+		sign, secs = '-', -secs
+	}
+	s := fmt.Sprintf("%c%02d%02d", sign, secs/3600, secs/60%60)
+	if secs%60 != 0 {
+		s += fmt.Sprintf("%02d", secs%60)
+	}
+	return s
+}
+
+// escape escapes s as a TEXT value.
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// maxLine is the longest a content line may be, in octets, before it is
+// folded.
+const maxLine = 75
+
+// writer writes content lines, folded and ended by CRLF, remembering the
+// first error.
+type writer struct {
+	w   *bufio.Writer
+	err error
+}
+
+// line writes the content line s, folding it onto continuation lines,
+// which start with a space, without splitting a UTF-8 sequence.
+func (w *writer) line(s string) {
+	if w.err != nil {
+		return
+	}
+	limit := maxLine
+	for len(s) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(s[i]) {
+			i--
+		}
+		w.w.WriteString(s[:i])
+		w.w.WriteString("\r\n ")
+		s = s[i:]
+		limit = maxLine - 1
+	}
+	w.w.WriteString(s)
+	_, w.err = w.w.WriteString("\r\n")
+}
@@ -0,0 +1,164 @@
+package ics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+var stamp = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestEncode(t *testing.T) {
+	var b strings.Builder
+	c := Calendar{
+		Observer: astrotime.Observer{Lat: 51.4769, Lon: -0.0005},
+		Start:    time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC),
+		Kinds:    []astrotime.EventKind{astrotime.EventSunset},
+		Name:     "Sunset, Greenwich",
+		Stamp:    stamp,
+	}
+	if err := c.Encode(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//dntj//astrotime//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		`X-WR-CALNAME:Sunset\, Greenwich`,
+		"BEGIN:VEVENT",
+		"UID:20240620-sunset-51.4769_-0.0005@astrotime",
+		"DTSTAMP:20240101T000000Z",
+		"DTSTART:20240620T202047Z",
+		"SUMMARY:Sunset",
+		"GEO:51.4769;-0.0005",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+	if b.String() != want {
+		t.Errorf("got\n%s\nwant\n%s", b.String(), want)
+	}
+}
+
+func TestEncodeTimeZone(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip(err)
+	}
+	var b strings.Builder
+	c := Calendar{
+		Observer: astrotime.Observer{Lat: 51.4769, Lon: -0.0005, Location: loc},
+		Start:    time.Date(2024, 3, 30, 0, 0, 0, 0, loc),
+		End:      time.Date(2024, 10, 27, 0, 0, 0, 0, loc),
+		Stamp:    stamp,
+	}
+	if err := c.Encode(&b); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+	for _, s := range []string{
+		"X-WR-TIMEZONE:Europe/London\r\n",
+		"BEGIN:VTIMEZONE\r\nTZID:Europe/London\r\n" +
+			"BEGIN:STANDARD\r\nDTSTART:20240330T000000\r\nTZOFFSETFROM:+0000\r\nTZOFFSETTO:+0000\r\nTZNAME:GMT\r\nEND:STANDARD\r\n" +
+			"BEGIN:DAYLIGHT\r\nDTSTART:20240331T010000\r\nTZOFFSETFROM:+0000\r\nTZOFFSETTO:+0100\r\nTZNAME:BST\r\nEND:DAYLIGHT\r\n" +
+			"BEGIN:STANDARD\r\nDTSTART:20241027T020000\r\nTZOFFSETFROM:+0100\r\nTZOFFSETTO:+0000\r\nTZNAME:GMT\r\nEND:STANDARD\r\n" +
+			"END:VTIMEZONE\r\n",
+		"DTSTART;TZID=Europe/London:20240330T053859\r\n",
+		"DTSTART;TZID=Europe/London:20240331T063643\r\n",
+		"DTSTART;TZID=Europe/London:20241027T064525\r\n",
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output lacks %q", s)
+		}
+	}
+	if n := strings.Count(out, "BEGIN:VEVENT"); n != 2*212 {
+		t.Errorf("got %d events, want %d", n, 2*212)
+	}
+}
+
+func TestEncodePolar(t *testing.T) {
+	var b strings.Builder
+	c := Calendar{
+		Observer: astrotime.Observer{Lat: 78.22, Lon: 15.65},
+		Start:    time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+		End:      time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		Kinds:    []astrotime.EventKind{astrotime.EventSunrise, astrotime.EventSolarNoon},
+		Stamp:    stamp,
+	}
+	if err := c.Encode(&b); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(b.String(), "SUMMARY:Sunrise"); n != 0 {
+		t.Errorf("got %d sunrises in the polar night", n)
+	}
+	if n := strings.Count(b.String(), "SUMMARY:Solar noon"); n != 31 {
+		t.Errorf("got %d solar noons, want 31", n)
+	}
+}
+
+func TestEncodeUnknownKind(t *testing.T) {
+	c := Calendar{Kinds: []astrotime.EventKind{astrotime.EventKind(42)}}
+	if err := c.Encode(&strings.Builder{}); err == nil {
+		t.Error("Encode succeeded with an unknown event kind")
+	}
+}
+
+func TestFold(t *testing.T) {
+	var b strings.Builder
+	c := Calendar{
+		Observer: astrotime.Observer{Lat: 0, Lon: 0},
+		Start:    stamp,
+		End:      stamp,
+		Name:     strings.Repeat("é", 60),
+		Stamp:    stamp,
+	}
+	if err := c.Encode(&b); err != nil {
+		t.Fatal(err)
+	}
+	var name string
+	for i, line := range strings.Split(b.String(), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line %d is %d octets long", i, len(line))
+		}
+		switch {
+		case strings.HasPrefix(line, "X-WR-CALNAME:"):
+			name = line
+		case strings.HasPrefix(line, " ") && name != "":
+			name += line[1:]
+		case name != "":
+			if want := "X-WR-CALNAME:" + strings.Repeat("é", 60); name != want {
+				t.Errorf("unfolded %q, want %q", name, want)
+			}
+			name = ""
+		}
+	}
+}
+
+func TestUTCOffset(t *testing.T) {
+	for _, tt := range []struct {
+		secs int
+		want string
+	}{
+		{0, "+0000"},
+		{3600, "+0100"},
+		{-5*3600 - 30*60, "-0530"},
+		{20700, "+0545"},
+		{-1*3600 - 15*60 - 10, "-011510"},
+	} {
+		if got := utcOffset(tt.secs); got != tt.want {
+			t.Errorf("utcOffset(%d) = %q, want %q", tt.secs, got, tt.want)
+		}
+	}
+}
+
+func TestEscape(t *testing.T) {
+	if got, want := escape("a,b;c\\d\ne"), `a\,b\;c\\d\ne`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,282 @@
+// Package mqtt publishes the sun's events for an observer to MQTT topics,
+// for home automation. It works with any MQTT client through the Client
+// interface; for github.com/eclipse/paho.mqtt.golang, for example:
+//
+//	pub := &mqtt.Publisher{
+//		Client: mqtt.ClientFunc(func(topic string, qos byte, retained bool, payload []byte) error {
+//			tok := client.Publish(topic, qos, retained, payload)
+//			tok.Wait()
+//			return tok.Error()
+//		}),
+//		Observer: astrotime.NewObserver(51.48, -0.01),
+//	}
+//	err := pub.Run(ctx)
+//
+// Under the prefix, "astrotime" by default, a Publisher keeps these topics:
+//
+//	astrotime/state          retained JSON describing the sun now
+//	astrotime/<event>        retained RFC 3339 time of the next event
+//	astrotime/event          JSON of each event as it happens
+//
+// where <event> names the event kind as in the table package, such as
+// "sunset". The state follows the attributes of Home Assistant's sun
+// integration:
+//
+//	{"state": "above_horizon", "elevation": 12.3, "azimuth": 245.1,
+//	 "rising": false, "next_dawn": "...", "next_rising": "...",
+//	 "next_noon": "...", "next_setting": "...", "next_dusk": "..."}
+//
+// and PublishDiscovery announces the topics as sensors to Home Assistant's
+// MQTT discovery.
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// Client publishes messages to an MQTT broker.
+type Client interface {
+	Publish(topic string, qos byte, retained bool, payload []byte) error
+}
+
+// ClientFunc adapts a function to a Client.
+type ClientFunc func(topic string, qos byte, retained bool, payload []byte) error
+
+// Publish calls f.
+func (f ClientFunc) Publish(topic string, qos byte, retained bool, payload []byte) error {
+	return f(topic, qos, retained, payload)
+}
+
+// DefaultKinds are the events published unless others are chosen.
+var DefaultKinds = []astrotime.EventKind{
+	astrotime.EventCivilDawn,
+	astrotime.EventSunrise,
+	astrotime.EventSolarNoon,
+	astrotime.EventSunset,
+	astrotime.EventCivilDusk,
+}
+
+// DefaultPrefix is the prefix of the topics unless another is chosen.
+const DefaultPrefix = "astrotime"
+
+// A Publisher publishes the sun's events for an observer.
+type Publisher struct {
+	Client   Client
+	Observer astrotime.Observer
+
+	// Kinds are the events published, DefaultKinds if empty.
+	Kinds []astrotime.EventKind
+
+	// Prefix is the prefix of the topics, DefaultPrefix if empty.
+	Prefix string
+
+	// QoS is the quality of service of the messages.
+	QoS byte
+
+	// StateInterval, if positive, is how often Run republishes the state
+	// between events, so that the sun's elevation and azimuth stay fresh.
+	StateInterval time.Duration
+
+	// OnError, if set, is called with the errors of publishing while Run
+	// runs, which are otherwise dropped.
+	OnError func(error)
+
+	// now replaces the wall clock in tests.
+	now func() time.Time
+}
+
+// state is the payload of the state topic.
+type state struct {
+	State       string     `json:"state"`
+	Elevation   float64    `json:"elevation"`
+	Azimuth     float64    `json:"azimuth"`
+	Rising      bool       `json:"rising"`
+	NextDawn    *time.Time `json:"next_dawn,omitempty"`
+	NextRising  *time.Time `json:"next_rising,omitempty"`
+	NextNoon    *time.Time `json:"next_noon,omitempty"`
+	NextSetting *time.Time `json:"next_setting,omitempty"`
+	NextDusk    *time.Time `json:"next_dusk,omitempty"`
+}
+
+// PublishState publishes the state of the sun at t, and the time of the
+// next of each of the kinds, as retained messages.
+func (p *Publisher) PublishState(t time.Time) error {
+	o := p.Observer
+	ra, dec := astrotime.SunEquatorial(t)
+	az, alt, err := o.BodyPosition(astrotime.Body{RA: ra, Dec: dec}, t)
+	if err != nil {
+		return err
+	}
+	phase, err := o.Phase(t)
+	if err != nil {
+		return err
+	}
+	s := state{
+		State:     "below_horizon",
+		Elevation: alt,
+		Azimuth:   az,
+		Rising:    astrotime.SolarHourAngle(t, o.Lon) < 0,
+	}
+	if phase == astrotime.Day {
+		s.State = "above_horizon"
+	}
+	for kind, field := range map[astrotime.EventKind]**time.Time{
+		astrotime.EventCivilDawn: &s.NextDawn,
+		astrotime.EventSunrise:   &s.NextRising,
+		astrotime.EventSolarNoon: &s.NextNoon,
+		astrotime.EventSunset:    &s.NextSetting,
+		astrotime.EventCivilDusk: &s.NextDusk,
+	} {
+		if ev, err := o.NextEvent(t, kind); err == nil {
+			*field = &ev.Time
+		}
+	}
+	if err := p.publishJSON(p.topic("state"), true, s); err != nil {
+		return err
+	}
+
+	for _, kind := range p.kinds() {
+		ev, err := o.NextEvent(t, kind)
+		if err != nil {
+			// Nothing within a year, as near the poles.
+			continue
+		}
+		name, err := kind.MarshalText()
+		if err != nil {
+			return err
+		}
+		b, err := ev.Time.MarshalText()
+		if err != nil {
+			return err
+		}
+		if err := p.Client.Publish(p.topic(string(name)), p.QoS, true, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// discovery is the payload of a Home Assistant discovery message.
+type discovery struct {
+	Name                string         `json:"name"`
+	UniqueID            string         `json:"unique_id"`
+	StateTopic          string         `json:"state_topic"`
+	DeviceClass         string         `json:"device_class,omitempty"`
+	ValueTemplate       string         `json:"value_template,omitempty"`
+	JSONAttributesTopic string         `json:"json_attributes_topic,omitempty"`
+	Device              map[string]any `json:"device"`
+}
+
+// PublishDiscovery announces the state and the next of each of the kinds as
+// sensors to Home Assistant, with retained messages under
+// homeassistant/sensor/<nodeID>/, grouped as a device named name.
+func (p *Publisher) PublishDiscovery(nodeID, name string) error {
+	device := map[string]any{"identifiers": []string{nodeID}, "name": name}
+	base := "homeassistant/sensor/" + nodeID + "/"
+	err := p.publishJSON(base+"state/config", true, discovery{
+		Name:                "Sun",
+		UniqueID:            nodeID + "_state",
+		StateTopic:          p.topic("state"),
+		ValueTemplate:       "{{ value_json.state }}",
+		JSONAttributesTopic: p.topic("state"),
+		Device:              device,
+	})
+	if err != nil {
+		return err
+	}
+	for _, kind := range p.kinds() {
+		text, err := kind.MarshalText()
+		if err != nil {
+			return err
+		}
+		err = p.publishJSON(base+string(text)+"/config", true, discovery{
+			Name:        "Next " + kind.Label(),
+			UniqueID:    nodeID + "_" + string(text),
+			StateTopic:  p.topic(string(text)),
+			DeviceClass: "timestamp",
+			Device:      device,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run publishes the state, then each event as it happens, followed by the
+// new state, until ctx is done, when it returns ctx.Err(). It returns an
+// error at once if the first state cannot be published.
+func (p *Publisher) Run(ctx context.Context) error {
+	if err := p.PublishState(p.clock()); err != nil {
+		return err
+	}
+	var s astrotime.Scheduler
+	for _, kind := range p.kinds() {
+		err := s.Add(p.Observer, kind, 0, func(ev astrotime.Event) {
+			p.report(p.publishJSON(p.topic("event"), false, ev))
+			// Publish the state just after the event, so that it is the
+			// next occurrence which is announced.
+			p.report(p.PublishState(ev.Time.Add(time.Second)))
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if p.StateInterval > 0 {
+		go func() {
+			tick := time.NewTicker(p.StateInterval)
+			defer tick.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-tick.C:
+					p.report(p.PublishState(p.clock()))
+				}
+			}
+		}()
+	}
+	return s.Run(ctx)
+}
+
+// publishJSON publishes v as JSON to the topic.
+func (p *Publisher) publishJSON(topic string, retained bool, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return p.Client.Publish(topic, p.QoS, retained, b)
+}
+
+// topic returns the topic of the name under the prefix.
+func (p *Publisher) topic(name string) string {
+	if p.Prefix == "" {
+		return DefaultPrefix + "/" + name
+	}
+	return p.Prefix + "/" + name
+}
+
+func (p *Publisher) kinds() []astrotime.EventKind {
+	if len(p.Kinds) == 0 {
+		return DefaultKinds
+	}
+	return p.Kinds
+}
+
+// report passes a non-nil err to OnError.
+func (p *Publisher) report(err error) {
+	if err != nil && p.OnError != nil {
+		p.OnError(err)
+	}
+}
+
+func (p *Publisher) clock() time.Time {
+	if p.now != nil {
+		return p.now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,135 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// message is a message published to a fakeClient.
+type message struct {
+	qos      byte
+	retained bool
+	payload  string
+}
+
+// fakeClient records the last message to each topic.
+type fakeClient struct {
+	mu       sync.Mutex
+	messages map[string]message
+	err      error
+}
+
+func (c *fakeClient) Publish(topic string, qos byte, retained bool, payload []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	if c.messages == nil {
+		c.messages = map[string]message{}
+	}
+	c.messages[topic] = message{qos, retained, string(payload)}
+	return nil
+}
+
+var greenwich = astrotime.Observer{Lat: 51.4769, Lon: -0.0005}
+
+func TestPublishState(t *testing.T) {
+	c := &fakeClient{}
+	p := &Publisher{Client: c, Observer: greenwich, QoS: 1}
+	if err := p.PublishState(time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	if m := c.messages["astrotime/sunset"]; m.payload != "2024-06-20T20:20:53Z" || !m.retained || m.qos != 1 {
+		t.Errorf("got sunset %+v", m)
+	}
+	if m := c.messages["astrotime/sunrise"]; m.payload != "2024-06-21T03:42:58Z" {
+		t.Errorf("got sunrise %+v", m)
+	}
+	if len(c.messages) != 1+len(DefaultKinds) {
+		t.Errorf("got %d topics, want %d", len(c.messages), 1+len(DefaultKinds))
+	}
+
+	var s struct {
+		State       string  `json:"state"`
+		Elevation   float64 `json:"elevation"`
+		Azimuth     float64 `json:"azimuth"`
+		Rising      bool    `json:"rising"`
+		NextSetting string  `json:"next_setting"`
+		NextDusk    string  `json:"next_dusk"`
+	}
+	m := c.messages["astrotime/state"]
+	if err := json.Unmarshal([]byte(m.payload), &s); err != nil {
+		t.Fatal(err)
+	}
+	if s.State != "above_horizon" || !s.Rising || s.NextSetting != "2024-06-20T20:20:53Z" || s.NextDusk == "" {
+		t.Errorf("got state %+v", s)
+	}
+	if s.Elevation < 61 || s.Elevation > 62.1 || s.Azimuth < 177 || s.Azimuth > 180 {
+		t.Errorf("got elevation %v, azimuth %v, want the sun near its noon height in the south", s.Elevation, s.Azimuth)
+	}
+}
+
+func TestPublishStatePolar(t *testing.T) {
+	c := &fakeClient{}
+	p := &Publisher{Client: c, Observer: astrotime.Observer{Lat: 78.22, Lon: 15.65}, Prefix: "home/sun"}
+	if err := p.PublishState(time.Date(2024, 12, 21, 12, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.messages["home/sun/state"]; !ok {
+		t.Error("no state under the prefix")
+	}
+	if m := c.messages["home/sun/sunrise"]; m.payload < "2025-02" {
+		t.Errorf("got sunrise %q, want one after the polar night", m.payload)
+	}
+}
+
+func TestPublishDiscovery(t *testing.T) {
+	c := &fakeClient{}
+	p := &Publisher{Client: c, Observer: greenwich, Kinds: []astrotime.EventKind{astrotime.EventSunset}}
+	if err := p.PublishDiscovery("greenwich", "Greenwich sun"); err != nil {
+		t.Fatal(err)
+	}
+	var d map[string]any
+	m := c.messages["homeassistant/sensor/greenwich/sunset/config"]
+	if err := json.Unmarshal([]byte(m.payload), &d); err != nil {
+		t.Fatal(err)
+	}
+	if d["name"] != "Next Sunset" || d["state_topic"] != "astrotime/sunset" || d["device_class"] != "timestamp" || d["unique_id"] != "greenwich_sunset" || !m.retained {
+		t.Errorf("got %v", d)
+	}
+	m = c.messages["homeassistant/sensor/greenwich/state/config"]
+	if err := json.Unmarshal([]byte(m.payload), &d); err != nil {
+		t.Fatal(err)
+	}
+	if d["json_attributes_topic"] != "astrotime/state" || d["value_template"] != "{{ value_json.state }}" {
+		t.Errorf("got %v", d)
+	}
+	if len(c.messages) != 2 {
+		t.Errorf("got %d topics, want 2", len(c.messages))
+	}
+}
+
+func TestRun(t *testing.T) {
+	c := &fakeClient{}
+	p := &Publisher{Client: c, Observer: greenwich, now: func() time.Time { return time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC) }}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := p.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+	if _, ok := c.messages["astrotime/state"]; !ok {
+		t.Error("Run did not publish the state")
+	}
+
+	c.err = errors.New("broker down")
+	if err := p.Run(context.Background()); err != c.err {
+		t.Errorf("got %v, want %v", err, c.err)
+	}
+}
@@ -0,0 +1,193 @@
+// Package webhook POSTs a JSON payload to URLs at solar events, offset by
+// fixed durations, so that the sun can trigger automations in other
+// services:
+//
+//	n := &webhook.Notifier{
+//		Observer: astrotime.NewObserver(51.48, -0.01),
+//		URLs:     []string{"https://example.com/hooks/lights"},
+//		Hooks:    []webhook.Hook{{Kind: astrotime.EventSunset, Offset: -30 * time.Minute}},
+//	}
+//	err := n.Run(ctx)
+//
+// The payload is
+//
+//	{"kind": "sunset", "offset_seconds": -1800,
+//	 "event_time": "2024-06-20T20:20:48Z", "time": "2024-06-20T19:50:48Z",
+//	 "latitude": 51.48, "longitude": -0.01}
+//
+// where kind names the event as in the table package, event_time is the
+// event and time is when the hook was due. A request which fails, or is
+// answered with 429 or a 5xx status, is retried with exponential backoff.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// Defaults of a Notifier.
+const (
+	DefaultRetries = 3
+	DefaultBackoff = time.Second
+)
+
+// Hook is an event, offset by a fixed duration, to notify at.
+type Hook struct {
+	Kind   astrotime.EventKind
+	Offset time.Duration
+}
+
+// Payload is the JSON body POSTed at a hook.
+type Payload struct {
+	Kind      astrotime.EventKind `json:"kind"`
+	Offset    float64             `json:"offset_seconds"`
+	EventTime time.Time           `json:"event_time"`
+	Time      time.Time           `json:"time"`
+	Latitude  float64             `json:"latitude"`
+	Longitude float64             `json:"longitude"`
+}
+
+// A Notifier POSTs to its URLs at its hooks.
+type Notifier struct {
+	Observer astrotime.Observer
+	URLs     []string
+	Hooks    []Hook
+
+	// Client sends the requests, http.DefaultClient if nil.
+	Client *http.Client
+
+	// Retries is how many times a failed request is retried, DefaultRetries
+	// if zero; a negative value disables retries.
+	Retries int
+
+	// Backoff is the delay before the first retry, doubling for each
+	// retry after it, DefaultBackoff if zero.
+	Backoff time.Duration
+
+	// OnError, if set, is called with the errors of notifying while Run
+	// runs, which are otherwise dropped.
+	OnError func(error)
+}
+
+// StatusError is the error for a request answered with a status other than
+// 2xx.
+type StatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("webhook: %s answered %d %s", e.URL, e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// Run notifies at each of the hooks until ctx is done, when it waits for
+// notifications under way and returns ctx.Err().
+func (n *Notifier) Run(ctx context.Context) error {
+	var s astrotime.Scheduler
+	for _, h := range n.Hooks {
+		h := h
+		err := s.Add(n.Observer, h.Kind, h.Offset, func(ev astrotime.Event) {
+			if err := n.Notify(ctx, n.payload(h, ev)); err != nil && n.OnError != nil {
+				n.OnError(err)
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return s.Run(ctx)
+}
+
+// payload returns the payload of the hook for the event.
+func (n *Notifier) payload(h Hook, ev astrotime.Event) Payload {
+	return Payload{
+		Kind:      ev.Kind,
+		Offset:    h.Offset.Seconds(),
+		EventTime: ev.Time,
+		Time:      ev.Time.Add(h.Offset),
+		Latitude:  n.Observer.Lat,
+		Longitude: n.Observer.Lon,
+	}
+}
+
+// Notify POSTs p to each of the URLs in turn, retrying failures, and
+// returns the first error.
+func (n *Notifier) Notify(ctx context.Context, p Payload) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	var first error
+	for _, url := range n.URLs {
+		if err := n.post(ctx, url, body); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// post POSTs body to url, retrying with backoff.
+func (n *Notifier) post(ctx context.Context, url string, body []byte) error {
+	retries := n.Retries
+	switch {
+	case retries == 0:
+		retries = DefaultRetries
+	case retries < 0:
+		retries = 0
+	}
+	backoff := n.Backoff
+	if backoff <= 0 {
+		backoff = DefaultBackoff
+	}
+	for try := 0; ; try++ {
+		err := n.send(ctx, url, body)
+		if err == nil || !retryable(err) || try == retries {
+			return err
+		}
+		timer := time.NewTimer(backoff << try)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// send POSTs body to url once.
+func (n *Notifier) send(ctx context.Context, url string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := n.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &StatusError{URL: url, StatusCode: resp.StatusCode}
+	}
+	return nil
+}
+
+// retryable reports whether a request failing with err may succeed later:
+// it failed to be sent, or was answered 429 or 5xx.
+func retryable(err error) bool {
+	se, ok := err.(*StatusError)
+	if !ok {
+		return true
+	}
+	return se.StatusCode == http.StatusTooManyRequests || se.StatusCode >= 500
+}
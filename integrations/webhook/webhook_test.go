@@ -0,0 +1,124 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// server answers with the statuses in turn, then 200, counting requests
+// and keeping the last body.
+func server(t *testing.T, statuses ...int) (*httptest.Server, *atomic.Int32, *[]byte) {
+	var n atomic.Int32
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := int(n.Add(1)) - 1
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("got %s with Content-Type %q", r.Method, r.Header.Get("Content-Type"))
+		}
+		body, _ = io.ReadAll(r.Body)
+		if i < len(statuses) {
+			w.WriteHeader(statuses[i])
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &n, &body
+}
+
+var sunset = astrotime.Event{Kind: astrotime.EventSunset, Time: time.Date(2024, 6, 20, 20, 20, 48, 0, time.UTC)}
+
+func TestNotify(t *testing.T) {
+	srv, n, body := server(t)
+	nt := &Notifier{Observer: astrotime.Observer{Lat: 51.48, Lon: -0.01}, URLs: []string{srv.URL}}
+	p := nt.payload(Hook{Kind: astrotime.EventSunset, Offset: -30 * time.Minute}, sunset)
+	if err := nt.Notify(context.Background(), p); err != nil {
+		t.Fatal(err)
+	}
+	if n.Load() != 1 {
+		t.Errorf("got %d requests, want 1", n.Load())
+	}
+	want := `{"kind":"sunset","offset_seconds":-1800,"event_time":"2024-06-20T20:20:48Z","time":"2024-06-20T19:50:48Z","latitude":51.48,"longitude":-0.01}`
+	if string(*body) != want {
+		t.Errorf("got %s, want %s", *body, want)
+	}
+	var got Payload
+	if err := json.Unmarshal(*body, &got); err != nil || got != p {
+		t.Errorf("got %+v, %v, want %+v", got, err, p)
+	}
+}
+
+func TestNotifyRetries(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		statuses []int
+		retries  int
+		requests int32
+		status   int
+	}{
+		{"recovers", []int{500, 429}, 0, 3, 0},
+		{"gives up", []int{503, 503, 503}, 2, 3, 503},
+		{"no retries", []int{502}, -1, 1, 502},
+		{"client error", []int{404}, 0, 1, 404},
+	} {
+		srv, n, _ := server(t, tt.statuses...)
+		nt := &Notifier{URLs: []string{srv.URL}, Retries: tt.retries, Backoff: time.Millisecond}
+		err := nt.Notify(context.Background(), Payload{})
+		var se *StatusError
+		switch {
+		case tt.status == 0 && err != nil:
+			t.Errorf("%s: got %v", tt.name, err)
+		case tt.status != 0 && (!errors.As(err, &se) || se.StatusCode != tt.status):
+			t.Errorf("%s: got %v, want status %d", tt.name, err, tt.status)
+		}
+		if n.Load() != tt.requests {
+			t.Errorf("%s: got %d requests, want %d", tt.name, n.Load(), tt.requests)
+		}
+	}
+}
+
+func TestNotifyCanceled(t *testing.T) {
+	srv, n, _ := server(t, 500, 500)
+	nt := &Notifier{URLs: []string{srv.URL}, Backoff: time.Hour}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := nt.Notify(ctx, Payload{}); err == nil {
+		t.Error("Notify succeeded")
+	}
+	if time.Since(start) > 5*time.Second || n.Load() != 1 {
+		t.Errorf("Notify waited %v for %d requests", time.Since(start), n.Load())
+	}
+}
+
+func TestNotifyURLs(t *testing.T) {
+	bad, _, _ := server(t, 400)
+	good, n, _ := server(t)
+	nt := &Notifier{URLs: []string{bad.URL, good.URL}}
+	if err := nt.Notify(context.Background(), Payload{}); err == nil {
+		t.Error("Notify succeeded despite the first URL failing")
+	}
+	if n.Load() != 1 {
+		t.Error("the second URL was not notified")
+	}
+}
+
+func TestRun(t *testing.T) {
+	nt := &Notifier{Observer: astrotime.Observer{Lat: 91}, Hooks: []Hook{{Kind: astrotime.EventSunset}}}
+	if err := nt.Run(context.Background()); !errors.Is(err, astrotime.ErrInvalidCoordinates) {
+		t.Errorf("got %v, want ErrInvalidCoordinates", err)
+	}
+	nt.Observer.Lat = 51.48
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := nt.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+}
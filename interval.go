@@ -0,0 +1,67 @@
+package astrotime
+
+import "time"
+
+// Interval is a span of time from Start up to End.
+type Interval struct {
+	Start, End time.Time
+}
+
+// Duration returns the length of the interval.
+func (i Interval) Duration() time.Duration {
+	return i.End.Sub(i.Start)
+}
+
+// Contains reports whether t lies within the interval.
+func (i Interval) Contains(t time.Time) bool {
+	return !t.Before(i.Start) && t.Before(i.End)
+}
+
+// windowStep is the interval at which conditions are sampled when searching
+// for the windows in which they hold.
+const windowStep = 5 * time.Minute
+
+// windows returns the intervals within [start, end) during which cond
+// holds, sampling it once per windowStep and refining each change to the
+// second. The bounds of the intervals are rounded to the observer's
+// precision.
+func (o Observer) windows(start, end time.Time, cond func(time.Time) bool) []Interval {
+	// edge refines a change of cond between lo and hi.
+	edge := func(lo, hi time.Time, was bool) time.Time {
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2)
+			if cond(mid) == was {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		return hi
+	}
+
+	var windows []Interval
+	prev, on := start, cond(start)
+	if on {
+		windows = append(windows, Interval{Start: start, End: end})
+	}
+	for prev.Before(end) {
+		next := prev.Add(windowStep)
+		if next.After(end) {
+			next = end
+		}
+		if c := cond(next); c != on {
+			at := edge(prev, next, on)
+			if c {
+				windows = append(windows, Interval{Start: at, End: end})
+			} else {
+				windows[len(windows)-1].End = at
+			}
+			on = c
+		}
+		prev = next
+	}
+	for i, iv := range windows {
+		windows[i] = Interval{o.round(iv.Start), o.round(iv.End)}
+	}
+	return windows
+}
@@ -0,0 +1,134 @@
+// Package irradiance models the sunlight reaching the ground under a clear
+// sky, from the sun's position and distance as astrotime calculates them,
+// for sizing and forecasting solar panels and collectors.
+//
+// Irradiance is split the usual three ways, in watts per square meter:
+// global horizontal irradiance (GHI) falls on level ground from the whole
+// sky, direct normal irradiance (DNI) comes straight from the sun's disk
+// onto a surface facing it, and diffuse horizontal irradiance (DHI)
+// reaches level ground from the rest of the sky. With the sun at zenith
+// angle z, GHI = DNI cos z + DHI.
+//
+// Two clear-sky models are given. Haurwitz's needs nothing but the sun's
+// zenith angle and gives only GHI. Ineichen and Perez's takes the site's
+// elevation and the Linke turbidity of the air, a measure of its haze and
+// water vapour, and gives all three components; it is the one most used in
+// photovoltaic modelling. Neither accounts for cloud.
+//
+// A Surface transposes irradiance onto a tilted plane, such as a solar
+// panel, from the angle at which the sun's rays meet it, and Optimize
+// finds the orientation of a panel that catches the most over a span of
+// days.
+//
+//	sky, err := irradiance.ClearSky(observer, time.Now(), irradiance.DefaultTurbidity)
+//	panel := irradiance.Surface{Tilt: 35, Azimuth: 180}
+//	poa, err := panel.ClearSky(observer, time.Now(), 0)
+package irradiance
+
+import (
+	"math"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+const (
+	degToRad = math.Pi / 180
+
+	// SolarConstant is the mean irradiance of sunlight at the top of the
+	// atmosphere one astronomical unit from the sun, in W/m², after Kopp
+	// and Lean (2011).
+	SolarConstant = 1361.0
+
+	// DefaultTurbidity is a Linke turbidity typical of a clear day at
+	// middle latitudes. Values run from about 2 in clean, dry air to 6 or
+	// more in hazy or polluted air.
+	DefaultTurbidity = 3.0
+)
+
+// Irradiance is the sunlight reaching the ground, in W/m².
+type Irradiance struct {
+	GHI, DNI, DHI float64
+}
+
+// Extraterrestrial calculates the irradiance of sunlight at the top of the
+// atmosphere on a surface facing the sun at t, in W/m²: the solar constant
+// scaled by the inverse square of the earth's distance from the sun, 3.3%
+// above it in January and 3.3% below in July.
+func Extraterrestrial(t time.Time) float64 {
+	r := astrotime.SunDistance(t)
+	return SolarConstant / (r * r)
+}
+
+// Haurwitz calculates the global horizontal irradiance under a clear sky,
+// in W/m², with the sun's centre at the zenith angle, in degrees, by the
+// model of Haurwitz (1945). It is zero with the sun down.
+func Haurwitz(zenith float64) float64 {
+	cosZ := math.Cos(degToRad * zenith)
+	if cosZ <= 0 {
+		return 0
+	}
+	return 1098 * cosZ * math.Exp(-0.059/cosZ)
+}
+
+// Ineichen calculates the irradiance under a clear sky with the sun's
+// centre at the zenith angle, in degrees, the extraterrestrial irradiance
+// extra, in W/m², at a site elevation meters above sea level, for the
+// Linke turbidity of the air, by the model of Ineichen and Perez (2002) as
+// implemented in pvlib. It is zero with the sun down.
+func Ineichen(zenith, extra, elevation, turbidity float64) Irradiance {
+	cosZ := math.Cos(degToRad * zenith)
+	if cosZ <= 0 {
+		return Irradiance{}
+	}
+	am := astrotime.AirMass(90-zenith) * pressure(elevation) / 101325
+
+	fh1 := math.Exp(-elevation / 8000)
+	fh2 := math.Exp(-elevation / 1250)
+	cg1 := 5.09e-5*elevation + 0.868
+	cg2 := 3.92e-5*elevation + 0.0387
+	ghi := cg1 * extra * cosZ * math.Exp(-cg2*am*(fh1+fh2*(turbidity-1)))
+
+	b := 0.664 + 0.163/fh1
+	dni := extra * b * math.Exp(-0.09*am*(turbidity-1))
+	// Ineichen's empirical correction keeps the beam within the global
+	// irradiance at low turbidity.
+	limit := ghi * (1 - (0.1-0.2*math.Exp(-turbidity))/(0.1+0.882/fh1)) / cosZ
+	dni = math.Max(0, math.Min(dni, limit))
+	return Irradiance{GHI: ghi, DNI: dni, DHI: ghi - dni*cosZ}
+}
+
+// ClearSky calculates the irradiance under a clear sky at t by the model
+// of Ineichen and Perez, at the observer's place and elevation, for the
+// Linke turbidity of the air, or DefaultTurbidity if it is zero. It is
+// zero with the sun down.
+func ClearSky(o astrotime.Observer, t time.Time, turbidity float64) (Irradiance, error) {
+	zenith, err := SunZenith(o, t)
+	if err != nil {
+		return Irradiance{}, err
+	}
+	if turbidity == 0 {
+		turbidity = DefaultTurbidity
+	}
+	return Ineichen(zenith, Extraterrestrial(t), o.Elevation, turbidity), nil
+}
+
+// SunZenith calculates the angle of the sun's centre from the zenith at t
+// for the observer, in degrees, from its geometric altitude.
+func SunZenith(o astrotime.Observer, t time.Time) (float64, error) {
+	_, altitude, err := sunPosition(o, t)
+	return 90 - altitude, err
+}
+
+// sunPosition calculates the azimuth and geometric altitude of the sun at
+// t for the observer, in degrees.
+func sunPosition(o astrotime.Observer, t time.Time) (azimuth, altitude float64, err error) {
+	ra, dec := astrotime.SunEquatorial(t)
+	return o.BodyPosition(astrotime.Body{RA: ra, Dec: dec}, t)
+}
+
+// pressure estimates the air pressure at the elevation, in pascals, from
+// the standard atmosphere.
+func pressure(elevation float64) float64 {
+	return 100 * math.Pow((44331.514-elevation)/11880.516, 1/0.1902632)
+}
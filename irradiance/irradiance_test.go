@@ -0,0 +1,80 @@
+package irradiance
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestExtraterrestrial(t *testing.T) {
+	for _, test := range []struct {
+		t    time.Time
+		want float64
+	}{
+		// Perihelion and aphelion.
+		{time.Date(2024, time.January, 3, 0, 0, 0, 0, time.UTC), 1407.6},
+		{time.Date(2024, time.July, 5, 0, 0, 0, 0, time.UTC), 1316.3},
+	} {
+		if got := Extraterrestrial(test.t); math.Abs(got-test.want) > 0.5 {
+			t.Errorf("%s: got %.1f, want %.1f", test.t.Format("2006-01-02"), got, test.want)
+		}
+	}
+}
+
+func TestHaurwitz(t *testing.T) {
+	for _, test := range []struct {
+		zenith, want float64
+	}{
+		{0, 1035.09},
+		{30, 888.27},
+		{90, 0},
+		{120, 0},
+	} {
+		if got := Haurwitz(test.zenith); math.Abs(got-test.want) > 0.01 {
+			t.Errorf("Haurwitz(%v): got %.2f, want %.2f", test.zenith, got, test.want)
+		}
+	}
+}
+
+func TestIneichen(t *testing.T) {
+	for _, test := range []struct {
+		zenith, extra, elevation, turbidity float64
+		want                                Irradiance
+	}{
+		{0, 1361, 0, 3, Irradiance{1051.89, 940.18, 111.71}},
+		{60, 1361, 0, 3, Irradiance{468.59, 786.07, 75.55}},
+		{30, 1400, 1500, 2, Irradiance{1029.68, 1104.83, 72.87}},
+		{85, 1361, 0, 5, Irradiance{14.02, 27.55, 11.61}},
+		{95, 1361, 0, 3, Irradiance{}},
+	} {
+		got := Ineichen(test.zenith, test.extra, test.elevation, test.turbidity)
+		if math.Abs(got.GHI-test.want.GHI) > 0.01 || math.Abs(got.DNI-test.want.DNI) > 0.01 || math.Abs(got.DHI-test.want.DHI) > 0.01 {
+			t.Errorf("Ineichen(%v, %v, %v, %v): got %+v, want %+v", test.zenith, test.extra, test.elevation, test.turbidity, got, test.want)
+		}
+	}
+}
+
+func TestClearSky(t *testing.T) {
+	o := astrotime.NewObserver(39.74, -105.18, astrotime.WithElevation(1829))
+	noon := time.Date(2024, time.June, 20, 19, 0, 0, 0, time.UTC)
+	got, err := ClearSky(o, noon, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zenith, _ := SunZenith(o, noon)
+	want := Ineichen(zenith, Extraterrestrial(noon), 1829, DefaultTurbidity)
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if got.GHI < 1000 || got.GHI > 1150 {
+		t.Errorf("got GHI %.0f at a high site at midsummer noon, want about 1080", got.GHI)
+	}
+	if got, _ := ClearSky(o, noon.Add(12*time.Hour), 0); got != (Irradiance{}) {
+		t.Errorf("got %+v at night, want zero", got)
+	}
+	if _, err := ClearSky(astrotime.NewObserver(91, 0), noon, 0); err == nil {
+		t.Error("got no error for an invalid latitude")
+	}
+}
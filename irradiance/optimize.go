@@ -0,0 +1,179 @@
+package irradiance
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// sampleStep is the interval at which the sky is sampled for Energy and
+// the optimizers.
+const sampleStep = 30 * time.Minute
+
+// ErrEmptyRange is returned for a span of time whose end is not after its
+// start.
+var ErrEmptyRange = errors.New("irradiance: end of range is not after its start")
+
+// sample is the sun's position and the clear sky at one sampled time,
+// with the sines and cosines that the angle of incidence takes.
+type sample struct {
+	cosZ, sinZ, cosAz, sinAz float64
+	sky                      Irradiance
+}
+
+// samples samples the clear sky for the observer from start up to end,
+// once per sampleStep at the middle of each step, keeping the daylight.
+func samples(o astrotime.Observer, start, end time.Time, turbidity float64) ([]sample, error) {
+	if !end.After(start) {
+		return nil, ErrEmptyRange
+	}
+	if turbidity == 0 {
+		turbidity = DefaultTurbidity
+	}
+	var ss []sample
+	for t := start.Add(sampleStep / 2); t.Before(end); t = t.Add(sampleStep) {
+		azimuth, altitude, err := sunPosition(o, t)
+		if err != nil {
+			return nil, err
+		}
+		if altitude <= 0 {
+			continue
+		}
+		zenith := 90 - altitude
+		ss = append(ss, sample{
+			cosZ:  math.Cos(degToRad * zenith),
+			sinZ:  math.Sin(degToRad * zenith),
+			cosAz: math.Cos(degToRad * azimuth),
+			sinAz: math.Sin(degToRad * azimuth),
+			sky:   Ineichen(zenith, Extraterrestrial(t), o.Elevation, turbidity),
+		})
+	}
+	return ss, nil
+}
+
+// energy sums the irradiance on the surface over the samples, in Wh/m²,
+// as Irradiance would give it for each.
+func (s Surface) energy(ss []sample) float64 {
+	albedo := s.Albedo
+	if albedo == 0 {
+		albedo = DefaultAlbedo
+	}
+	cosTilt, sinTilt := math.Cos(degToRad*s.Tilt), math.Sin(degToRad*s.Tilt)
+	cosAz, sinAz := math.Cos(degToRad*s.Azimuth), math.Sin(degToRad*s.Azimuth)
+	var sum float64
+	for _, x := range ss {
+		cos := x.cosZ*cosTilt + x.sinZ*sinTilt*(x.cosAz*cosAz+x.sinAz*sinAz)
+		sum += x.sky.DNI*math.Max(0, cos) + x.sky.DHI*(1+cosTilt)/2 + x.sky.GHI*albedo*(1-cosTilt)/2
+	}
+	return sum * sampleStep.Hours()
+}
+
+// Energy calculates the energy the surface receives under a clear sky
+// from start up to end, in Wh/m², for the observer and turbidity as
+// ClearSky. It samples the sky every half hour.
+func (s Surface) Energy(o astrotime.Observer, start, end time.Time, turbidity float64) (float64, error) {
+	ss, err := samples(o, start, end, turbidity)
+	if err != nil {
+		return 0, err
+	}
+	return s.energy(ss), nil
+}
+
+// Optimum is a surface and the energy it receives under a clear sky over
+// a span of time, in Wh/m².
+type Optimum struct {
+	Start, End time.Time
+	Surface    Surface
+	Energy     float64
+}
+
+// Optimize finds the tilt and azimuth of a fixed surface, with the
+// albedo, that receives the most energy under a clear sky from start up
+// to end, for the observer and turbidity as ClearSky, to a tenth of a
+// degree. It searches a grid of orientations and then refines the best.
+// Over a year the result is near the latitude, facing the equator, a
+// little flatter for the diffuse light; real panels also lose to cloud,
+// which favours flatter tilts still.
+func Optimize(o astrotime.Observer, start, end time.Time, turbidity, albedo float64) (Optimum, error) {
+	ss, err := samples(o, start, end, turbidity)
+	if err != nil {
+		return Optimum{}, err
+	}
+	s := optimize(ss, Surface{Albedo: albedo}, false)
+	return Optimum{Start: start, End: end, Surface: s, Energy: s.energy(ss)}, nil
+}
+
+// OptimizeSeasonal finds the best orientation for a surface re-tilted n
+// times over the span from start up to end, which it divides into n periods
+// of equal length, best begun at an equinox or solstice: the azimuth that
+// Optimize finds for the whole span, and for each period the tilt that
+// receives the most energy with it. The optima are in order, one per
+// period.
+func OptimizeSeasonal(o astrotime.Observer, start, end time.Time, n int, turbidity, albedo float64) ([]Optimum, error) {
+	if n < 1 {
+		return nil, errors.New("irradiance: need at least one period")
+	}
+	whole, err := Optimize(o, start, end, turbidity, albedo)
+	if err != nil {
+		return nil, err
+	}
+	optima := make([]Optimum, n)
+	length := end.Sub(start) / time.Duration(n)
+	for i := range optima {
+		from, to := start.Add(time.Duration(i)*length), start.Add(time.Duration(i+1)*length)
+		if i == n-1 {
+			to = end
+		}
+		ss, err := samples(o, from, to, turbidity)
+		if err != nil {
+			return nil, err
+		}
+		s := optimize(ss, whole.Surface, true)
+		optima[i] = Optimum{Start: from, End: to, Surface: s, Energy: s.energy(ss)}
+	}
+	return optima, nil
+}
+
+// optimize finds the tilt, and unless fixedAzimuth the azimuth, of the
+// surface s that receives the most energy over the samples: the best of
+// a grid of 5° in tilt and 10° in azimuth, refined by a pattern search
+// that halves its steps down to a tenth of a degree.
+func optimize(ss []sample, s Surface, fixedAzimuth bool) Surface {
+	best, bestEnergy := s, math.Inf(-1)
+	try := func(c Surface) bool {
+		c.Tilt = math.Max(0, math.Min(90, c.Tilt))
+		c.Azimuth = math.Mod(c.Azimuth+360, 360)
+		if e := c.energy(ss); e > bestEnergy {
+			best, bestEnergy = c, e
+			return true
+		}
+		return false
+	}
+	azimuths := []float64{s.Azimuth}
+	if !fixedAzimuth {
+		azimuths = nil
+		for az := 0.0; az < 360; az += 10 {
+			azimuths = append(azimuths, az)
+		}
+	}
+	for _, az := range azimuths {
+		for tilt := 0.0; tilt <= 90; tilt += 5 {
+			try(Surface{Tilt: tilt, Azimuth: az, Albedo: s.Albedo})
+		}
+	}
+	for step := 2.5; step >= 0.1; {
+		c := best
+		improved := try(Surface{Tilt: c.Tilt + step, Azimuth: c.Azimuth, Albedo: s.Albedo}) ||
+			try(Surface{Tilt: c.Tilt - step, Azimuth: c.Azimuth, Albedo: s.Albedo})
+		if !fixedAzimuth {
+			improved = try(Surface{Tilt: best.Tilt, Azimuth: best.Azimuth + 2*step, Albedo: s.Albedo}) ||
+				try(Surface{Tilt: best.Tilt, Azimuth: best.Azimuth - 2*step, Albedo: s.Albedo}) || improved
+		}
+		if !improved {
+			step /= 2
+		}
+	}
+	return best
+}
@@ -0,0 +1,91 @@
+package irradiance
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestEnergy(t *testing.T) {
+	o := astrotime.NewObserver(39.74, -105.18, astrotime.WithElevation(1829))
+	start := time.Date(2024, time.June, 20, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	s := Surface{Tilt: 30, Azimuth: 180}
+	got, err := s.Energy(o, start, end, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want float64
+	for t := start.Add(sampleStep / 2); t.Before(end); t = t.Add(sampleStep) {
+		poa, _ := s.ClearSky(o, t, 0)
+		want += poa.Global * sampleStep.Hours()
+	}
+	if math.Abs(got-want) > 1e-6*want {
+		t.Errorf("got %v Wh/m², want %v", got, want)
+	}
+	if _, err := s.Energy(o, end, start, 0); err != ErrEmptyRange {
+		t.Errorf("got %v for a reversed range, want ErrEmptyRange", err)
+	}
+}
+
+func TestOptimize(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	for _, test := range []struct {
+		o             astrotime.Observer
+		tilt, azimuth float64
+	}{
+		{astrotime.NewObserver(39.74, -105.18, astrotime.WithElevation(1829)), 35.9, 179.7},
+		{astrotime.NewObserver(51.48, 0), 43.8, 180.6},
+		// South of the equator panels face north.
+		{astrotime.NewObserver(-33.87, 151.21), 30.5, 0.3},
+	} {
+		got, err := Optimize(test.o, start, end, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(got.Surface.Tilt-test.tilt) > 0.1 || math.Abs(got.Surface.Azimuth-test.azimuth) > 0.1 {
+			t.Errorf("%v: got tilt %.2f, azimuth %.2f, want %.1f, %.1f", test.o.Lat, got.Surface.Tilt, got.Surface.Azimuth, test.tilt, test.azimuth)
+		}
+		// The optimum beats its neighbours.
+		for _, s := range []Surface{
+			{Tilt: got.Surface.Tilt + 2, Azimuth: got.Surface.Azimuth},
+			{Tilt: got.Surface.Tilt - 2, Azimuth: got.Surface.Azimuth},
+			{Tilt: got.Surface.Tilt, Azimuth: got.Surface.Azimuth + 5},
+			{Tilt: got.Surface.Tilt, Azimuth: got.Surface.Azimuth - 5},
+		} {
+			if e, _ := s.Energy(test.o, start, end, 0); e >= got.Energy {
+				t.Errorf("%v: %+v receives %v Wh/m², more than the optimum's %v", test.o.Lat, s, e, got.Energy)
+			}
+		}
+	}
+}
+
+func TestOptimizeSeasonal(t *testing.T) {
+	o := astrotime.NewObserver(39.74, -105.18, astrotime.WithElevation(1829))
+	start := time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	optima, err := OptimizeSeasonal(o, start, end, 2, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(optima) != 2 {
+		t.Fatalf("got %d periods, want 2", len(optima))
+	}
+	summer, winter := optima[0], optima[1]
+	if !summer.Start.Equal(start) || !summer.End.Equal(winter.Start) || !winter.End.Equal(end) {
+		t.Errorf("got periods %s to %s and %s to %s", summer.Start, summer.End, winter.Start, winter.End)
+	}
+	if summer.Surface.Tilt > 25 || winter.Surface.Tilt < 50 {
+		t.Errorf("got tilts of %.1f° in summer and %.1f° in winter", summer.Surface.Tilt, winter.Surface.Tilt)
+	}
+	fixed, _ := Optimize(o, start, end, 0, 0)
+	if summer.Surface.Azimuth != fixed.Surface.Azimuth || summer.Energy+winter.Energy <= fixed.Energy {
+		t.Errorf("re-tilting gets %v Wh/m² at %.1f°, fixed %v at %.1f°", summer.Energy+winter.Energy, summer.Surface.Azimuth, fixed.Energy, fixed.Surface.Azimuth)
+	}
+	if _, err := OptimizeSeasonal(o, start, end, 0, 0, 0); err == nil {
+		t.Error("got no error for no periods")
+	}
+}
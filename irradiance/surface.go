@@ -0,0 +1,83 @@
+package irradiance
+
+import (
+	"math"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+const radToDeg = 180 / math.Pi
+
+// DefaultAlbedo is the reflectance of grass and most open ground.
+const DefaultAlbedo = 0.2
+
+// Surface is a flat surface tilted from the horizontal, such as a solar
+// panel or collector: the plane of array of a photovoltaic system.
+type Surface struct {
+	// Tilt is the angle of the surface from the horizontal, in degrees:
+	// zero when it lies flat, 90 when it stands vertical.
+	Tilt float64
+
+	// Azimuth is the direction the surface faces, in degrees clockwise
+	// from north: 180 for a panel tilted towards the south.
+	Azimuth float64
+
+	// Albedo is the fraction of sunlight the ground in front of the
+	// surface reflects, or DefaultAlbedo if zero; fresh snow reflects 0.8.
+	Albedo float64
+}
+
+// POA is the irradiance on the plane of a Surface, in W/m², split into
+// the light that comes straight from the sun, that scattered by the sky,
+// and that reflected by the ground. Global is their sum.
+type POA struct {
+	Global, Direct, SkyDiffuse, GroundReflected float64
+}
+
+// Incidence calculates the angle of incidence of the sun's rays on the
+// surface, in degrees from its normal, with the sun's centre at the zenith
+// angle and azimuth, in degrees: zero when the sun shines straight onto
+// it, and over 90 when the sun is behind it.
+func (s Surface) Incidence(zenith, azimuth float64) float64 {
+	z, b := degToRad*zenith, degToRad*s.Tilt
+	cos := math.Cos(z)*math.Cos(b) + math.Sin(z)*math.Sin(b)*math.Cos(degToRad*(azimuth-s.Azimuth))
+	return radToDeg * math.Acos(math.Max(-1, math.Min(1, cos)))
+}
+
+// Irradiance transposes the irradiance sky, on level ground and from the
+// sun's direction, onto the surface, with the sun's centre at the zenith
+// angle and azimuth, in degrees. It takes the diffuse light of the sky to
+// be the same from every direction, the isotropic model of Liu and Jordan,
+// which underestimates it somewhat towards the sun.
+func (s Surface) Irradiance(sky Irradiance, zenith, azimuth float64) POA {
+	albedo := s.Albedo
+	if albedo == 0 {
+		albedo = DefaultAlbedo
+	}
+	var p POA
+	if zenith < 90 {
+		p.Direct = sky.DNI * math.Max(0, math.Cos(degToRad*s.Incidence(zenith, azimuth)))
+	}
+	cosTilt := math.Cos(degToRad * s.Tilt)
+	p.SkyDiffuse = sky.DHI * (1 + cosTilt) / 2
+	p.GroundReflected = sky.GHI * albedo * (1 - cosTilt) / 2
+	p.Global = p.Direct + p.SkyDiffuse + p.GroundReflected
+	return p
+}
+
+// ClearSky calculates the irradiance on the surface at t under a clear
+// sky, as the package's ClearSky calculates it on level ground for the
+// observer and turbidity.
+func (s Surface) ClearSky(o astrotime.Observer, t time.Time, turbidity float64) (POA, error) {
+	azimuth, altitude, err := sunPosition(o, t)
+	if err != nil {
+		return POA{}, err
+	}
+	if turbidity == 0 {
+		turbidity = DefaultTurbidity
+	}
+	zenith := 90 - altitude
+	sky := Ineichen(zenith, Extraterrestrial(t), o.Elevation, turbidity)
+	return s.Irradiance(sky, zenith, azimuth), nil
+}
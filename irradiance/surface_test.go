@@ -0,0 +1,80 @@
+package irradiance
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestIncidence(t *testing.T) {
+	for _, test := range []struct {
+		s                     Surface
+		zenith, azimuth, want float64
+	}{
+		{Surface{}, 40, 123, 40},
+		{Surface{Tilt: 30, Azimuth: 180}, 30, 180, 0},
+		{Surface{Tilt: 90, Azimuth: 180}, 60, 180, 30},
+		{Surface{Tilt: 90, Azimuth: 180}, 60, 0, 150},
+		{Surface{Tilt: 90, Azimuth: 90}, 90, 180, 90},
+	} {
+		if got := test.s.Incidence(test.zenith, test.azimuth); math.Abs(got-test.want) > 1e-9 {
+			t.Errorf("%+v.Incidence(%v, %v): got %v, want %v", test.s, test.zenith, test.azimuth, got, test.want)
+		}
+	}
+}
+
+func TestSurfaceIrradiance(t *testing.T) {
+	sky := Ineichen(60, 1361, 0, 3)
+	for _, test := range []struct {
+		s    Surface
+		want POA
+	}{
+		// A flat surface sees the global horizontal irradiance.
+		{Surface{}, POA{Global: sky.GHI, Direct: sky.DNI / 2, SkyDiffuse: sky.DHI}},
+		// A south wall with the sun 30° up in the south.
+		{Surface{Tilt: 90, Azimuth: 180}, POA{
+			Direct:          sky.DNI * math.Cos(math.Pi/6),
+			SkyDiffuse:      sky.DHI / 2,
+			GroundReflected: sky.GHI * DefaultAlbedo / 2,
+		}},
+		// And a north wall over snow.
+		{Surface{Tilt: 90, Azimuth: 0, Albedo: 0.8}, POA{
+			SkyDiffuse:      sky.DHI / 2,
+			GroundReflected: sky.GHI * 0.8 / 2,
+		}},
+	} {
+		got := test.s.Irradiance(sky, 60, 180)
+		want := test.want
+		if want.Global == 0 {
+			want.Global = want.Direct + want.SkyDiffuse + want.GroundReflected
+		}
+		if math.Abs(got.Global-want.Global) > 1e-9 || math.Abs(got.Direct-want.Direct) > 1e-9 ||
+			math.Abs(got.SkyDiffuse-want.SkyDiffuse) > 1e-9 || math.Abs(got.GroundReflected-want.GroundReflected) > 1e-9 {
+			t.Errorf("%+v: got %+v, want %+v", test.s, got, want)
+		}
+	}
+}
+
+func TestSurfaceClearSky(t *testing.T) {
+	o := astrotime.NewObserver(39.74, -105.18, astrotime.WithElevation(1829))
+	noon := time.Date(2024, time.December, 21, 19, 0, 0, 0, time.UTC)
+	flat, err := Surface{}.ClearSky(o, noon, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sky, _ := ClearSky(o, noon, 0)
+	if math.Abs(flat.Global-sky.GHI) > 1e-9 {
+		t.Errorf("got %v on a flat surface, want the GHI %v", flat.Global, sky.GHI)
+	}
+	// In winter a steep panel facing south catches far more than level
+	// ground.
+	tilted, _ := Surface{Tilt: 60, Azimuth: 180}.ClearSky(o, noon, 0)
+	if tilted.Global < 1.5*flat.Global {
+		t.Errorf("got %v on a panel tilted 60°, %v flat, want half as much again", tilted.Global, flat.Global)
+	}
+	if _, err := (Surface{}).ClearSky(astrotime.NewObserver(91, 0), noon, 0); err == nil {
+		t.Error("got no error for an invalid latitude")
+	}
+}
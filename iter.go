@@ -0,0 +1,45 @@
+//go:build go1.23
+
+package astrotime
+
+import (
+	"iter"
+	"time"
+)
+
+// Events returns an iterator over the solar events of kinds, or of every
+// kind if none are given, after start in chronological order. The sequence
+// is unbounded; it ends early only if no event occurs for a whole year or
+// the observer is invalid.
+func (o Observer) Events(start time.Time, kinds ...EventKind) iter.Seq[Event] {
+	if len(kinds) == 0 {
+		kinds = allEventKinds
+	}
+	return func(yield func(Event) bool) {
+		start := o.local(start)
+		last := start
+		var day []Event
+		for d, idle := 0, 0; idle <= 366; d++ {
+			var err error
+			if day, err = o.dayEvents(start.AddDate(0, 0, d), kinds, day[:0]); err != nil {
+				return
+			}
+			idle++
+			for _, ev := range day {
+				if !ev.Time.After(last) {
+					continue
+				}
+				if !yield(ev) {
+					return
+				}
+				last, idle = ev.Time, 0
+			}
+		}
+	}
+}
+
+// Events returns an iterator over the solar events of kinds after start at
+// the location specified in latitude and longitude.
+func Events(start time.Time, latitude, longitude float64, kinds ...EventKind) iter.Seq[Event] {
+	return Observer{Lat: latitude, Lon: longitude}.Events(start, kinds...)
+}
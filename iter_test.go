@@ -0,0 +1,41 @@
+//go:build go1.23
+
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvents(t *testing.T) {
+	start := p("2017-10-15T00:00:00Z")
+	var got []Event
+	for ev := range Events(start, 38.8895, -77.0352) {
+		got = append(got, ev)
+		if len(got) == 3*len(allEventKinds) {
+			break
+		}
+	}
+	for i, ev := range got {
+		if !ev.Time.After(start) {
+			t.Errorf("got event %v at %s, want after %s", ev.Kind, ev.Time, start)
+		}
+		if i > 0 && !ev.Time.After(got[i-1].Time) {
+			t.Errorf("got %v at %s after %v at %s, want chronological order", ev.Kind, ev.Time, got[i-1].Kind, got[i-1].Time)
+		}
+		if want := allEventKinds[(i+int(got[0].Kind))%len(allEventKinds)]; ev.Kind != want {
+			t.Errorf("got event %d of kind %v, want %v", i, ev.Kind, want)
+		}
+	}
+}
+
+func TestEventsPolar(t *testing.T) {
+	// Longyearbyen has no sunset between April and August.
+	start := p("2017-05-01T00:00:00Z")
+	for ev := range Events(start, 78.2232, 15.6267, EventSunset) {
+		if ev.Time.Sub(start) < 90*24*time.Hour {
+			t.Errorf("got sunset %s, want none before August", ev.Time)
+		}
+		break
+	}
+}
@@ -0,0 +1,26 @@
+package astrotime
+
+import "math"
+
+// LongitudeFromWest converts a west-positive longitude, the convention of
+// NOAA's published algorithms, to the east-positive longitude taken by this
+// package.
+func LongitudeFromWest(west float64) float64 {
+	return NormalizeLongitude(-west)
+}
+
+// LongitudeToWest converts an east-positive longitude to the west-positive
+// convention.
+func LongitudeToWest(east float64) float64 {
+	return NormalizeLongitude(-east)
+}
+
+// NormalizeLongitude wraps a longitude in degrees into the range
+// [-180, 180), so that for example 350° east becomes 10° west.
+func NormalizeLongitude(lon float64) float64 {
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+	return lon - 180
+}
@@ -0,0 +1,30 @@
+package astrotime
+
+import "testing"
+
+func TestNormalizeLongitude(t *testing.T) {
+	tests := []struct {
+		lon, want float64
+	}{
+		{0, 0},
+		{-77.0352, -77.0352},
+		{350, -10},
+		{-190, 170},
+		{180, -180},
+		{540, -180},
+	}
+	for _, tt := range tests {
+		if got := NormalizeLongitude(tt.lon); got != tt.want {
+			t.Errorf("NormalizeLongitude(%v) = %v, want %v", tt.lon, got, tt.want)
+		}
+	}
+}
+
+func TestLongitudeFromWest(t *testing.T) {
+	if got, want := LongitudeFromWest(77.0352), -77.0352; got != want {
+		t.Errorf("LongitudeFromWest(77.0352) = %v, want %v", got, want)
+	}
+	if got, want := LongitudeToWest(144.9631), -144.9631; got != want {
+		t.Errorf("LongitudeToWest(144.9631) = %v, want %v", got, want)
+	}
+}
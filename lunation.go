@@ -0,0 +1,197 @@
+package astrotime
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Corrections to the mean new and full moon, in days (Meeus, Astronomical
+// Algorithms, chapter 49): the coefficients for new and full moon, the power
+// of E and the multiples of M, M', F and Ω in the argument.
+var newFullTerms = [...][7]float64{
+	{-0.40720, -0.40614, 0, 0, 1, 0, 0},
+	{0.17241, 0.17302, 1, 1, 0, 0, 0},
+	{0.01608, 0.01614, 0, 0, 2, 0, 0},
+	{0.01039, 0.01043, 0, 0, 0, 2, 0},
+	{0.00739, 0.00734, 1, -1, 1, 0, 0},
+	{-0.00514, -0.00515, 1, 1, 1, 0, 0},
+	{0.00208, 0.00209, 2, 2, 0, 0, 0},
+	{-0.00111, -0.00111, 0, 0, 1, -2, 0},
+	{-0.00057, -0.00057, 0, 0, 1, 2, 0},
+	{0.00056, 0.00056, 1, 1, 2, 0, 0},
+	{-0.00042, -0.00042, 0, 0, 3, 0, 0},
+	{0.00042, 0.00042, 1, 1, 0, 2, 0},
+	{0.00038, 0.00038, 1, 1, 0, -2, 0},
+	{-0.00024, -0.00024, 1, -1, 2, 0, 0},
+	{-0.00017, -0.00017, 0, 0, 0, 0, 1},
+	{-0.00007, -0.00007, 0, 2, 1, 0, 0},
+	{0.00004, 0.00004, 0, 0, 2, -2, 0},
+	{0.00004, 0.00004, 0, 3, 0, 0, 0},
+	{0.00003, 0.00003, 0, 1, 1, -2, 0},
+	{0.00003, 0.00003, 0, 0, 2, 2, 0},
+	{-0.00003, -0.00003, 0, 1, 1, 2, 0},
+	{0.00003, 0.00003, 0, -1, 1, 2, 0},
+	{-0.00002, -0.00002, 0, -1, 1, -2, 0},
+	{-0.00002, -0.00002, 0, 1, 3, 0, 0},
+	{0.00002, 0.00002, 0, 0, 4, 0, 0},
+}
+
+// Corrections to the mean quarters, in days: the coefficient, the power of E
+// and the multiples of M, M', F and Ω in the argument.
+var quarterTerms = [...][6]float64{
+	{-0.62801, 0, 0, 1, 0, 0},
+	{0.17172, 1, 1, 0, 0, 0},
+	{-0.01183, 1, 1, 1, 0, 0},
+	{0.00862, 0, 0, 2, 0, 0},
+	{0.00804, 0, 0, 0, 2, 0},
+	{0.00454, 1, -1, 1, 0, 0},
+	{0.00204, 2, 2, 0, 0, 0},
+	{-0.00180, 0, 0, 1, -2, 0},
+	{-0.00070, 0, 0, 1, 2, 0},
+	{-0.00040, 0, 0, 3, 0, 0},
+	{-0.00034, 1, -1, 2, 0, 0},
+	{0.00032, 1, 1, 0, 2, 0},
+	{0.00032, 1, 1, 0, -2, 0},
+	{-0.00028, 2, 2, 1, 0, 0},
+	{0.00027, 1, 1, 2, 0, 0},
+	{-0.00017, 0, 0, 0, 0, 1},
+	{-0.00005, 0, -1, 1, -2, 0},
+	{0.00004, 0, 0, 2, 2, 0},
+	{-0.00004, 0, 1, 1, 2, 0},
+	{0.00004, 0, -2, 1, 0, 0},
+	{0.00003, 0, 1, 1, -2, 0},
+	{0.00003, 0, 3, 0, 0, 0},
+	{0.00002, 0, 0, 2, -2, 0},
+	{0.00002, 0, -1, 1, 2, 0},
+	{-0.00002, 0, 1, 3, 0, 0},
+}
+
+// Planetary corrections common to all phases: the constant and rate of each
+// argument in degrees, and its coefficient in days.
+var lunationPlanetTerms = [...][3]float64{
+	{299.77, 0.107408, 0.000325},
+	{251.88, 0.016321, 0.000165},
+	{251.83, 26.651886, 0.000164},
+	{349.42, 36.412478, 0.000126},
+	{84.66, 18.206239, 0.000110},
+	{141.74, 53.303771, 0.000062},
+	{207.14, 2.453732, 0.000060},
+	{154.84, 7.306860, 0.000056},
+	{34.52, 27.261239, 0.000047},
+	{207.19, 0.121824, 0.000042},
+	{291.34, 1.844379, 0.000040},
+	{161.72, 24.198154, 0.000037},
+	{239.56, 25.513099, 0.000035},
+	{331.55, 3.592518, 0.000023},
+}
+
+// lunation calculates the Julian ephemeris date of the lunar phase k, where
+// integer k counts new moons from that of 2000 January 6 and the fractions
+// .25, .5 and .75 select first quarter, full moon and last quarter.
+func lunation(k float64) float64 {
+	t := k / 1236.85
+	jde := 2451550.09766 + 29.530588861*k + t*t*(0.00015437+t*(-0.000000150+t*0.00000000073))
+	e := 1 - t*(0.002516+0.0000074*t)
+	m := 2.5534 + 29.10535670*k + t*t*(-0.0000014-t*0.00000011)
+	mp := 201.5643 + 385.81693528*k + t*t*(0.0107582+t*(0.00001238-t*0.000000058))
+	f := 160.7108 + 390.67050284*k + t*t*(-0.0016118+t*(-0.00000227+t*0.000000011))
+	omega := 124.7746 - 1.56375588*k + t*t*(0.0020672+t*0.00000215)
+
+	arg := func(cm, cmp, cf, co float64) float64 {
+		return math.Sin(degToRad * (cm*m + cmp*mp + cf*f + co*omega))
+	}
+	phase := k - math.Floor(k)
+	switch {
+	case phase < 0.1 || phase > 0.9:
+		for _, c := range newFullTerms {
+			jde += c[0] * math.Pow(e, c[2]) * arg(c[3], c[4], c[5], c[6])
+		}
+	case phase > 0.4 && phase < 0.6:
+		for _, c := range newFullTerms {
+			jde += c[1] * math.Pow(e, c[2]) * arg(c[3], c[4], c[5], c[6])
+		}
+	default:
+		for _, c := range quarterTerms {
+			jde += c[0] * math.Pow(e, c[1]) * arg(c[2], c[3], c[4], c[5])
+		}
+		w := 0.00306 - 0.00038*e*math.Cos(degToRad*m) + 0.00026*math.Cos(degToRad*mp) -
+			0.00002*math.Cos(degToRad*(mp-m)) + 0.00002*math.Cos(degToRad*(mp+m)) + 0.00002*math.Cos(degToRad*2*f)
+		if phase < 0.5 {
+			jde += w
+		} else {
+			jde -= w
+		}
+	}
+
+	for i, c := range lunationPlanetTerms {
+		a := c[0] + c[1]*k
+		if i == 0 {
+			a -= 0.009173 * t * t
+		}
+		jde += c[2] * math.Sin(degToRad*a)
+	}
+	return jde
+}
+
+// lunationFraction returns the fraction of a lunation at which the
+// principal phase occurs.
+func lunationFraction(phase MoonPhaseName) (float64, error) {
+	switch phase {
+	case NewMoon:
+		return 0, nil
+	case FirstQuarter:
+		return 0.25, nil
+	case FullMoon:
+		return 0.5, nil
+	case LastQuarter:
+		return 0.75, nil
+	}
+	return 0, fmt.Errorf("astrotime: %v is not a principal phase of the moon", phase)
+}
+
+// moonPhaseNear returns the instants of the principal phase in the
+// lunations around t, in order.
+func moonPhaseNear(t time.Time, phase MoonPhaseName) ([]time.Time, error) {
+	frac, err := lunationFraction(phase)
+	if err != nil {
+		return nil, err
+	}
+	k := math.Floor((decimalYear(julianDate(t.UTC())) - 2000) * 12.3685)
+	times := make([]time.Time, 0, 4)
+	for i := -2.0; i <= 1; i++ {
+		times = append(times, timeFromJulianDate(utFromTT(lunation(k+i+frac))))
+	}
+	return times, nil
+}
+
+// NextMoonPhase returns the instant of the next principal phase of the moon
+// after after: NewMoon, FirstQuarter, FullMoon or LastQuarter. The times are
+// good to within about a minute.
+func NextMoonPhase(after time.Time, phase MoonPhaseName) (time.Time, error) {
+	times, err := moonPhaseNear(after.AddDate(0, 0, 30), phase)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, t := range times {
+		if t.After(after) {
+			return t.In(after.Location()), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("astrotime: no %v found after %s", phase, after)
+}
+
+// PreviousMoonPhase returns the instant of the last principal phase of the
+// moon before before.
+func PreviousMoonPhase(before time.Time, phase MoonPhaseName) (time.Time, error) {
+	times, err := moonPhaseNear(before, phase)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for i := len(times) - 1; i >= 0; i-- {
+		if times[i].Before(before) {
+			return times[i].In(before.Location()), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("astrotime: no %v found before %s", phase, before)
+}
@@ -0,0 +1,59 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLunation(t *testing.T) {
+	// Meeus, Astronomical Algorithms, examples 49.a and 49.b.
+	tests := []struct {
+		k, jde float64
+	}{
+		{-283, 2443192.65118},
+		{544.75, 2467636.49186},
+	}
+	for _, tt := range tests {
+		if got := lunation(tt.k); math.Abs(got-tt.jde) > 0.00002 {
+			t.Errorf("lunation(%v) = %.5f, want %.5f", tt.k, got, tt.jde)
+		}
+	}
+}
+
+func TestNextMoonPhase(t *testing.T) {
+	tests := []struct {
+		after string
+		phase MoonPhaseName
+		want  string
+	}{
+		{"2017-08-01T00:00:00Z", NewMoon, "2017-08-21T18:30:00Z"},
+		{"2017-08-21T18:31:00Z", NewMoon, "2017-09-20T05:30:00Z"},
+		{"2017-08-01T00:00:00Z", FullMoon, "2017-08-07T18:11:00Z"},
+		{"2017-08-22T00:00:00Z", FirstQuarter, "2017-08-29T08:13:00Z"},
+		{"2017-08-22T00:00:00Z", LastQuarter, "2017-09-13T06:25:00Z"},
+		{"2024-01-01T00:00:00Z", FullMoon, "2024-01-25T17:54:00Z"},
+	}
+	for _, tt := range tests {
+		got, err := NextMoonPhase(p(tt.after), tt.phase)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Sub(p(tt.want)).Abs() > 2*time.Minute {
+			t.Errorf("got %v after %s at %s, want %s", tt.phase, tt.after, got, tt.want)
+		}
+	}
+}
+
+func TestPreviousMoonPhase(t *testing.T) {
+	got, err := PreviousMoonPhase(p("2017-09-01T00:00:00Z"), NewMoon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := p("2017-08-21T18:30:00Z"); got.Sub(want).Abs() > 2*time.Minute {
+		t.Errorf("got previous new moon %s, want %s", got, want)
+	}
+	if _, err := PreviousMoonPhase(p("2017-09-01T00:00:00Z"), WaxingCrescent); err == nil {
+		t.Error("got no error for a non-principal phase")
+	}
+}
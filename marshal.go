@@ -0,0 +1,276 @@
+package astrotime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// The result types marshal to JSON and text with stable names and RFC 3339
+// times in the offset of their location; the name of the location is not
+// kept, so times unmarshal in a fixed zone of the same offset.
+
+// eventKindText are the names of the event kinds in JSON and text, as in
+// the suncron and table packages.
+var eventKindText = [...]string{
+	EventAstronomicalDawn: "astronomical_dawn",
+	EventNauticalDawn:     "nautical_dawn",
+	EventCivilDawn:        "civil_dawn",
+	EventSunrise:          "sunrise",
+	EventSolarNoon:        "solar_noon",
+	EventSunset:           "sunset",
+	EventCivilDusk:        "civil_dusk",
+	EventNauticalDusk:     "nautical_dusk",
+	EventAstronomicalDusk: "astronomical_dusk",
+}
+
+// MarshalText implements encoding.TextMarshaler, naming the kind in
+// lower case with underscores, such as "civil_dawn".
+func (k EventKind) MarshalText() ([]byte, error) {
+	if k < 0 || int(k) >= len(eventKindText) {
+		return nil, fmt.Errorf("astrotime: cannot marshal %v", k)
+	}
+	return []byte(eventKindText[k]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the names
+// MarshalText gives.
+func (k *EventKind) UnmarshalText(text []byte) error {
+	for i, name := range eventKindText {
+		if string(text) == name {
+			*k = EventKind(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("astrotime: unknown event kind %q", text)
+}
+
+// eventJSON is the JSON form of an Event.
+type eventJSON struct {
+	Kind EventKind `json:"kind"`
+	Time time.Time `json:"time"`
+}
+
+// MarshalJSON implements json.Marshaler as {"kind": "sunrise", "time":
+// "2024-06-20T04:42:38+01:00"}.
+func (e Event) MarshalJSON() ([]byte, error) {
+	return json.Marshal(eventJSON(e))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var v eventJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*e = Event(v)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler as the kind and the time,
+// separated by a space, such as "sunrise 2024-06-20T04:42:38+01:00".
+func (e Event) MarshalText() ([]byte, error) {
+	kind, err := e.Kind.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	t, err := e.Time.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(string(kind) + " " + string(t)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (e *Event) UnmarshalText(text []byte) error {
+	kind, t, ok := strings.Cut(string(text), " ")
+	if !ok {
+		return fmt.Errorf("astrotime: event %q lacks a time", text)
+	}
+	var v Event
+	if err := v.Kind.UnmarshalText([]byte(kind)); err != nil {
+		return err
+	}
+	if err := v.Time.UnmarshalText([]byte(t)); err != nil {
+		return err
+	}
+	*e = v
+	return nil
+}
+
+// intervalJSON is the JSON form of an Interval.
+type intervalJSON struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// MarshalJSON implements json.Marshaler as {"start": ..., "end": ...}.
+func (i Interval) MarshalJSON() ([]byte, error) {
+	return json.Marshal(intervalJSON(i))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *Interval) UnmarshalJSON(data []byte) error {
+	var v intervalJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*i = Interval(v)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler as an ISO 8601 interval of
+// the start and end, such as
+// "2024-06-20T21:20:48+01:00/2024-06-20T22:08:30+01:00".
+func (i Interval) MarshalText() ([]byte, error) {
+	start, err := i.Start.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	end, err := i.End.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(string(start) + "/" + string(end)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *Interval) UnmarshalText(text []byte) error {
+	start, end, ok := strings.Cut(string(text), "/")
+	if !ok {
+		return fmt.Errorf("astrotime: interval %q lacks a /", text)
+	}
+	var v Interval
+	if err := v.Start.UnmarshalText([]byte(start)); err != nil {
+		return err
+	}
+	if err := v.End.UnmarshalText([]byte(end)); err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// errorCodes name the package's errors in JSON and text.
+var errorCodes = []struct {
+	err  error
+	code string
+}{
+	{ErrAlwaysAbove, "always_above"},
+	{ErrAlwaysBelow, "always_below"},
+	{ErrNoEvent, "no_event"},
+	{ErrInvalidCoordinates, "invalid_coordinates"},
+	{ErrDateOutOfRange, "date_out_of_range"},
+}
+
+// errorCode returns the code of err, or its message if it has none.
+func errorCode(err error) string {
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+	return err.Error()
+}
+
+// errorOfCode returns the error of the code, or an error with the code as
+// its message if it is not one.
+func errorOfCode(code string) error {
+	for _, c := range errorCodes {
+		if code == c.code {
+			return c.err
+		}
+	}
+	return errors.New(code)
+}
+
+// sunTimesJSON is the JSON form of SunTimes.
+type sunTimesJSON struct {
+	Sunrise *time.Time `json:"sunrise,omitempty"`
+	Sunset  *time.Time `json:"sunset,omitempty"`
+	Error   string     `json:"error,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler as {"sunrise": ..., "sunset":
+// ...}, leaving out zero Times, with "error" naming Err if it is set:
+// "always_above", "always_below", "no_event", "invalid_coordinates" or
+// "date_out_of_range", or the message of any other error. A
+// *CoordinateError unmarshals as ErrInvalidCoordinates, and other errors
+// as an error with the message.
+func (s SunTimes) MarshalJSON() ([]byte, error) {
+	var v sunTimesJSON
+	if !s.Sunrise.IsZero() {
+		v.Sunrise = &s.Sunrise
+	}
+	if !s.Sunset.IsZero() {
+		v.Sunset = &s.Sunset
+	}
+	if s.Err != nil {
+		v.Error = errorCode(s.Err)
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *SunTimes) UnmarshalJSON(data []byte) error {
+	var v sunTimesJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*s = SunTimes{}
+	if v.Sunrise != nil {
+		s.Sunrise = *v.Sunrise
+	}
+	if v.Sunset != nil {
+		s.Sunset = *v.Sunset
+	}
+	if v.Error != "" {
+		s.Err = errorOfCode(v.Error)
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler as the sunrise and sunset,
+// separated by a space, with - for a zero Time, followed by the error as
+// in MarshalJSON if there is one, such as "- - always_above".
+func (s SunTimes) MarshalText() ([]byte, error) {
+	parts := make([]string, 2, 3)
+	for i, t := range [...]time.Time{s.Sunrise, s.Sunset} {
+		parts[i] = "-"
+		if !t.IsZero() {
+			b, err := t.MarshalText()
+			if err != nil {
+				return nil, err
+			}
+			parts[i] = string(b)
+		}
+	}
+	if s.Err != nil {
+		parts = append(parts, errorCode(s.Err))
+	}
+	return []byte(strings.Join(parts, " ")), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SunTimes) UnmarshalText(text []byte) error {
+	parts := strings.SplitN(string(text), " ", 3)
+	if len(parts) < 2 {
+		return fmt.Errorf("astrotime: sun times %q lack a sunset", text)
+	}
+	var v SunTimes
+	for i, t := range [...]*time.Time{&v.Sunrise, &v.Sunset} {
+		if parts[i] == "-" {
+			continue
+		}
+		if err := t.UnmarshalText([]byte(parts[i])); err != nil {
+			return err
+		}
+	}
+	if len(parts) == 3 {
+		v.Err = errorOfCode(parts[2])
+	}
+	*s = v
+	return nil
+}
@@ -0,0 +1,179 @@
+package astrotime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestEventKindText(t *testing.T) {
+	for _, kind := range allEventKinds {
+		b, err := kind.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got EventKind
+		if err := got.UnmarshalText(b); err != nil || got != kind {
+			t.Errorf("%v: round trip through %q gave %v, %v", kind, b, got, err)
+		}
+	}
+	if b, _ := EventCivilDawn.MarshalText(); string(b) != "civil_dawn" {
+		t.Errorf("got %q, want civil_dawn", b)
+	}
+	if _, err := EventKind(42).MarshalText(); err == nil {
+		t.Error("EventKind(42) marshaled")
+	}
+	var k EventKind
+	if err := k.UnmarshalText([]byte("CivilDawn")); err == nil {
+		t.Error("CivilDawn unmarshaled")
+	}
+}
+
+func TestEventJSON(t *testing.T) {
+	loc := time.FixedZone("BST", 3600)
+	e := Event{Kind: EventSunrise, Time: time.Date(2024, 6, 20, 4, 42, 38, 0, loc)}
+	b, err := json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"kind":"sunrise","time":"2024-06-20T04:42:38+01:00"}`; string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+	var got Event
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Kind != e.Kind || !got.Time.Equal(e.Time) {
+		t.Errorf("got %v, want %v", got, e)
+	}
+	if err := json.Unmarshal([]byte(`{"kind":"moonrise","time":"2024-06-20T04:42:38Z"}`), &got); err == nil {
+		t.Error("moonrise unmarshaled")
+	}
+}
+
+func TestEventText(t *testing.T) {
+	e := Event{Kind: EventSunset, Time: time.Date(2024, 6, 20, 20, 20, 48, 0, time.UTC)}
+	b, err := e.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "sunset 2024-06-20T20:20:48Z"; string(b) != want {
+		t.Errorf("got %q, want %q", b, want)
+	}
+	var got Event
+	if err := got.UnmarshalText(b); err != nil || got != e {
+		t.Errorf("got %v, %v, want %v", got, err, e)
+	}
+	for _, s := range []string{"sunset", "sunset tomorrow", "dusk 2024-06-20T20:20:48Z"} {
+		if err := got.UnmarshalText([]byte(s)); err == nil {
+			t.Errorf("%q unmarshaled", s)
+		}
+	}
+}
+
+func TestIntervalMarshal(t *testing.T) {
+	i := Interval{
+		Start: time.Date(2024, 6, 20, 20, 20, 48, 0, time.UTC),
+		End:   time.Date(2024, 6, 20, 21, 8, 30, 0, time.UTC),
+	}
+	b, err := json.Marshal(i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"start":"2024-06-20T20:20:48Z","end":"2024-06-20T21:08:30Z"}`; string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+	var got Interval
+	if err := json.Unmarshal(b, &got); err != nil || got != i {
+		t.Errorf("got %v, %v, want %v", got, err, i)
+	}
+
+	b, err = i.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "2024-06-20T20:20:48Z/2024-06-20T21:08:30Z"; string(b) != want {
+		t.Errorf("got %q, want %q", b, want)
+	}
+	got = Interval{}
+	if err := got.UnmarshalText(b); err != nil || got != i {
+		t.Errorf("got %v, %v, want %v", got, err, i)
+	}
+	if err := got.UnmarshalText([]byte("2024-06-20T20:20:48Z")); err == nil {
+		t.Error("interval without an end unmarshaled")
+	}
+}
+
+func TestSunTimesMarshal(t *testing.T) {
+	rise := time.Date(2024, 6, 20, 3, 42, 38, 0, time.UTC)
+	set := time.Date(2024, 6, 20, 20, 20, 48, 0, time.UTC)
+	for _, tt := range []struct {
+		s          SunTimes
+		json, text string
+		err        error
+	}{
+		{
+			SunTimes{Sunrise: rise, Sunset: set},
+			`{"sunrise":"2024-06-20T03:42:38Z","sunset":"2024-06-20T20:20:48Z"}`,
+			"2024-06-20T03:42:38Z 2024-06-20T20:20:48Z",
+			nil,
+		},
+		{
+			SunTimes{Err: ErrAlwaysBelow},
+			`{"error":"always_below"}`,
+			"- - always_below",
+			ErrAlwaysBelow,
+		},
+		{
+			SunTimes{Sunset: set, Err: ErrAlwaysAbove},
+			`{"sunset":"2024-06-20T20:20:48Z","error":"always_above"}`,
+			"- 2024-06-20T20:20:48Z always_above",
+			ErrAlwaysAbove,
+		},
+		{
+			SunTimes{Err: ValidateCoordinates(91, 0)},
+			`{"error":"invalid_coordinates"}`,
+			"- - invalid_coordinates",
+			ErrInvalidCoordinates,
+		},
+	} {
+		b, err := json.Marshal(tt.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tt.json {
+			t.Errorf("got %s, want %s", b, tt.json)
+		}
+		var got SunTimes
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Sunrise.Equal(tt.s.Sunrise) || !got.Sunset.Equal(tt.s.Sunset) || !errors.Is(got.Err, tt.err) {
+			t.Errorf("%s unmarshaled as %v", b, got)
+		}
+
+		b, err = tt.s.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != tt.text {
+			t.Errorf("got %q, want %q", b, tt.text)
+		}
+		got = SunTimes{}
+		if err := got.UnmarshalText(b); err != nil {
+			t.Fatal(err)
+		}
+		if !got.Sunrise.Equal(tt.s.Sunrise) || !got.Sunset.Equal(tt.s.Sunset) || !errors.Is(got.Err, tt.err) {
+			t.Errorf("%q unmarshaled as %v", b, got)
+		}
+	}
+
+	var got SunTimes
+	if err := got.UnmarshalText([]byte("- - sky fell in")); err != nil || got.Err == nil || got.Err.Error() != "sky fell in" {
+		t.Errorf("got %v, %v", got, err)
+	}
+	if err := got.UnmarshalText([]byte("-")); err == nil {
+		t.Error("sun times without a sunset unmarshaled")
+	}
+}
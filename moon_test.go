@@ -0,0 +1,20 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMoonPosition(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 47.a: 1992 April 12, 0h TD.
+	lon, lat, dist := moonPosition(julianCentury(2448724.5))
+	if math.Abs(lon-133.162655) > 1e-4 {
+		t.Errorf("got longitude %.6f, want 133.162655", lon)
+	}
+	if math.Abs(lat+3.229126) > 1e-4 {
+		t.Errorf("got latitude %.6f, want -3.229126", lat)
+	}
+	if math.Abs(dist-368409.7) > 0.5 {
+		t.Errorf("got distance %.1f km, want 368409.7", dist)
+	}
+}
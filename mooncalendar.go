@@ -0,0 +1,147 @@
+package astrotime
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+// MoonEventKind identifies a lunar event.
+type MoonEventKind int
+
+// Lunar events.
+const (
+	MoonEventNewMoon MoonEventKind = iota
+	MoonEventFirstQuarter
+	MoonEventFullMoon
+	MoonEventLastQuarter
+	MoonEventPerigee
+	MoonEventApogee
+	MoonEventMoonrise
+	MoonEventMoonset
+	MoonEventTransit
+	MoonEventAntiTransit
+)
+
+var moonEventKindNames = [...]string{
+	MoonEventNewMoon:      "NewMoon",
+	MoonEventFirstQuarter: "FirstQuarter",
+	MoonEventFullMoon:     "FullMoon",
+	MoonEventLastQuarter:  "LastQuarter",
+	MoonEventPerigee:      "Perigee",
+	MoonEventApogee:       "Apogee",
+	MoonEventMoonrise:     "Moonrise",
+	MoonEventMoonset:      "Moonset",
+	MoonEventTransit:      "Transit",
+	MoonEventAntiTransit:  "AntiTransit",
+}
+
+func (k MoonEventKind) String() string {
+	if k < 0 || int(k) >= len(moonEventKindNames) {
+		return "MoonEventKind(" + strconv.Itoa(int(k)) + ")"
+	}
+	return moonEventKindNames[k]
+}
+
+// MoonEvent is an occurrence of a lunar event.
+type MoonEvent struct {
+	Kind MoonEventKind
+	Time time.Time
+}
+
+// principalPhases maps the principal phases of the moon to their events.
+var principalPhases = []struct {
+	phase MoonPhaseName
+	kind  MoonEventKind
+}{
+	{NewMoon, MoonEventNewMoon},
+	{FirstQuarter, MoonEventFirstQuarter},
+	{FullMoon, MoonEventFullMoon},
+	{LastQuarter, MoonEventLastQuarter},
+}
+
+// MoonEventsBetween returns every lunar event in [start, end) in
+// chronological order: the principal phases, perigee and apogee, and the
+// observer's moonrises, moonsets, transits and anti-transits. The moon's
+// altitude is sampled once across the whole span rather than day by day, so
+// a year of events costs little more than a year of samples.
+func (o Observer) MoonEventsBetween(start, end time.Time) ([]MoonEvent, error) {
+	if err := o.validate(start); err != nil {
+		return nil, err
+	}
+	if err := o.validate(end); err != nil {
+		return nil, err
+	}
+	start, end = o.local(start), o.local(end)
+
+	var events []MoonEvent
+	add := func(kind MoonEventKind, t time.Time) {
+		events = append(events, MoonEvent{Kind: kind, Time: o.round(t.In(start.Location()))})
+	}
+	for _, p := range principalPhases {
+		for t := start.Add(-time.Nanosecond); ; {
+			var err error
+			if t, err = NextMoonPhase(t, p.phase); err != nil {
+				return nil, err
+			}
+			if !t.Before(end) {
+				break
+			}
+			add(p.kind, t)
+		}
+	}
+	for _, apogee := range []bool{false, true} {
+		kind := MoonEventPerigee
+		if apogee {
+			kind = MoonEventApogee
+		}
+		for t := start.Add(-time.Nanosecond); ; {
+			var err error
+			if t, err = nextMoonApsis(t, apogee); err != nil {
+				return nil, err
+			}
+			if !t.Before(end) {
+				break
+			}
+			add(kind, t)
+		}
+	}
+	rises, sets := o.moonCrossings(start, end)
+	for _, t := range rises {
+		add(MoonEventMoonrise, t)
+	}
+	for _, t := range sets {
+		add(MoonEventMoonset, t)
+	}
+	transits, antiTransits := o.moonMeridianCrossings(start, end)
+	for _, t := range transits {
+		add(MoonEventTransit, t)
+	}
+	for _, t := range antiTransits {
+		add(MoonEventAntiTransit, t)
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+	return events, nil
+}
+
+// MoonMonth returns the lunar events of a calendar month in the observer's
+// time zone, or UTC if it has none.
+func (o Observer) MoonMonth(year int, month time.Month) ([]MoonEvent, error) {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, o.zone())
+	return o.MoonEventsBetween(start, start.AddDate(0, 1, 0))
+}
+
+// MoonYear returns the lunar events of a calendar year in the observer's
+// time zone, or UTC if it has none.
+func (o Observer) MoonYear(year int) ([]MoonEvent, error) {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, o.zone())
+	return o.MoonEventsBetween(start, start.AddDate(1, 0, 0))
+}
+
+// zone returns the observer's time zone, defaulting to UTC.
+func (o Observer) zone() *time.Location {
+	if o.Location == nil {
+		return time.UTC
+	}
+	return o.Location
+}
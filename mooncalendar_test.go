@@ -0,0 +1,82 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMoonMonth(t *testing.T) {
+	o := Observer{Lat: 51.4779, Lon: 0}
+	events, err := o.MoonMonth(2024, time.October)
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := make(map[MoonEventKind]int)
+	for i, ev := range events {
+		if ev.Time.Month() != time.October {
+			t.Errorf("%v at %v is outside October", ev.Kind, ev.Time)
+		}
+		if i > 0 && ev.Time.Before(events[i-1].Time) {
+			t.Errorf("%v at %v is out of order", ev.Kind, ev.Time)
+		}
+		counts[ev.Kind]++
+	}
+	want := map[MoonEventKind]int{
+		MoonEventNewMoon:      1,
+		MoonEventFirstQuarter: 1,
+		MoonEventFullMoon:     1,
+		MoonEventLastQuarter:  1,
+		MoonEventPerigee:      1,
+		MoonEventApogee:       2,
+	}
+	for kind, n := range want {
+		if counts[kind] != n {
+			t.Errorf("got %d %v events, want %d", counts[kind], kind, n)
+		}
+	}
+	for _, kind := range []MoonEventKind{MoonEventMoonrise, MoonEventMoonset} {
+		if n := counts[kind]; n < 29 || n > 31 {
+			t.Errorf("got %d %v events, want 29 to 31", n, kind)
+		}
+	}
+
+	// The calendar agrees with the single-day calculation.
+	for _, ev := range events {
+		if ev.Kind != MoonEventMoonrise {
+			continue
+		}
+		got, err := o.Moonrise(ev.Time)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(ev.Time) {
+			t.Errorf("Moonrise(%v) = %v, want %v", ev.Time, got, ev.Time)
+		}
+	}
+}
+
+func TestMoonYear(t *testing.T) {
+	events, err := Observer{Lat: -33.87, Lon: 151.21}.MoonYear(2024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	full := 0
+	for _, ev := range events {
+		if ev.Kind == MoonEventFullMoon {
+			full++
+		}
+	}
+	// 2024 had twelve full moons, from January 25 to December 15.
+	if full != 12 {
+		t.Errorf("got %d full moons, want 12", full)
+	}
+}
+
+func TestMoonEventKindString(t *testing.T) {
+	if got := MoonEventPerigee.String(); got != "Perigee" {
+		t.Errorf("got %q, want %q", got, "Perigee")
+	}
+	if got := MoonEventKind(99).String(); got != "MoonEventKind(99)" {
+		t.Errorf("got %q, want %q", got, "MoonEventKind(99)")
+	}
+}
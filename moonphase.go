@@ -0,0 +1,76 @@
+package astrotime
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// MoonPhaseName names the phase of the moon.
+type MoonPhaseName int
+
+// Phases of the moon, in the order they occur.
+const (
+	NewMoon MoonPhaseName = iota
+	WaxingCrescent
+	FirstQuarter
+	WaxingGibbous
+	FullMoon
+	WaningGibbous
+	LastQuarter
+	WaningCrescent
+)
+
+var moonPhaseNames = [...]string{
+	NewMoon:        "New Moon",
+	WaxingCrescent: "Waxing Crescent",
+	FirstQuarter:   "First Quarter",
+	WaxingGibbous:  "Waxing Gibbous",
+	FullMoon:       "Full Moon",
+	WaningGibbous:  "Waning Gibbous",
+	LastQuarter:    "Last Quarter",
+	WaningCrescent: "Waning Crescent",
+}
+
+func (n MoonPhaseName) String() string {
+	if n < 0 || int(n) >= len(moonPhaseNames) {
+		return "MoonPhaseName(" + strconv.Itoa(int(n)) + ")"
+	}
+	return moonPhaseNames[n]
+}
+
+// LunarPhase describes the phase of the moon at an instant.
+type LunarPhase struct {
+	// Angle is how far the moon is ahead of the sun in ecliptic longitude,
+	// in degrees: 0° at new moon, 90° at first quarter, 180° at full moon
+	// and 270° at last quarter.
+	Angle float64
+
+	// Illumination is the fraction of the moon's disc that is lit.
+	Illumination float64
+
+	// Name is the phase the angle falls in. Each of the eight names
+	// covers 45° centred on its nominal angle, so that for example the
+	// moon is called full between 157.5° and 202.5°.
+	Name MoonPhaseName
+}
+
+// MoonPhase calculates the phase of the moon at t.
+func MoonPhase(t time.Time) LunarPhase {
+	jc := julianCentury(julianDate(t.UTC()))
+	moonLon, moonLat, _ := moonPosition(jc)
+	sunLon := solarTrueLon(jc)
+
+	angle := math.Mod(moonLon-sunLon, 360)
+	if angle < 0 {
+		angle += 360
+	}
+
+	// The elongation proper includes the moon's latitude.
+	cosElong := math.Cos(degToRad*moonLat) * math.Cos(degToRad*angle)
+	return LunarPhase{
+		Angle:        angle,
+		Illumination: (1 - cosElong) / 2,
+		Name:         MoonPhaseName(int(math.Mod(angle+22.5, 360)/45) % 8),
+	}
+}
@@ -0,0 +1,37 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMoonPhase(t *testing.T) {
+	tests := []struct {
+		at    string
+		angle float64
+		name  MoonPhaseName
+	}{
+		{"2017-08-21T18:30:00Z", 0, NewMoon},
+		{"2017-08-29T08:13:00Z", 90, FirstQuarter},
+		{"2017-09-06T07:03:00Z", 180, FullMoon},
+		{"2017-09-13T06:25:00Z", 270, LastQuarter},
+		{"2017-09-10T00:00:00Z", 226, WaningGibbous},
+		{"2017-08-24T00:00:00Z", 28.3, WaxingCrescent},
+	}
+	for _, tt := range tests {
+		got := MoonPhase(p(tt.at))
+		diff := math.Mod(got.Angle-tt.angle+540, 360) - 180
+		if math.Abs(diff) > 1 {
+			t.Errorf("got phase angle %.2f at %s, want %v", got.Angle, tt.at, tt.angle)
+		}
+		if got.Name != tt.name {
+			t.Errorf("got phase %v at %s, want %v", got.Name, tt.at, tt.name)
+		}
+		if want := (1 - math.Cos(degToRad*tt.angle)) / 2; math.Abs(got.Illumination-want) > 0.02 {
+			t.Errorf("got illumination %.3f at %s, want %.3f", got.Illumination, tt.at, want)
+		}
+	}
+	if got := FullMoon.String(); got != "Full Moon" {
+		t.Errorf("got %q, want %q", got, "Full Moon")
+	}
+}
@@ -0,0 +1,76 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// earthRadius is the equatorial radius of the earth in kilometers.
+const earthRadius = 6378.14
+
+// moonEquatorial calculates the moon's apparent geocentric right ascension
+// and declination in degrees, and its distance in kilometers, at the Julian
+// ephemeris date jde.
+func moonEquatorial(jde float64) (ra, dec, dist float64) {
+	t := julianCentury(jde)
+	lon, lat, dist := moonPosition(t)
+	dpsi, deps := nutationApprox(t)
+	ra, dec = eclipticToEquatorial(lon+dpsi, lat, eclipticMeanObliquity(t)+deps)
+	return ra, dec, dist
+}
+
+// topocentric corrects the hour angle and declination of a body, in
+// degrees, for the parallax seen by an observer at the latitude and
+// elevation in meters, given the sine of the body's equatorial horizontal
+// parallax.
+func topocentric(ha, dec, sinPi, latitude, elevation float64) (haTopo, decTopo float64) {
+	const flattening = 0.99664719 // b/a for the earth's ellipsoid
+	phi := degToRad * latitude
+	u := math.Atan(flattening * math.Tan(phi))
+	rhoSin := flattening*math.Sin(u) + elevation/(earthRadius*1000)*math.Sin(phi)
+	rhoCos := math.Cos(u) + elevation/(earthRadius*1000)*math.Cos(phi)
+
+	h, d := degToRad*ha, degToRad*dec
+	den := math.Cos(d) - rhoCos*sinPi*math.Cos(h)
+	da := math.Atan2(-rhoCos*sinPi*math.Sin(h), den)
+	dt := math.Atan2((math.Sin(d)-rhoSin*sinPi)*math.Cos(da), den)
+	return ha - radToDeg*da, radToDeg * dt
+}
+
+// MoonPosition calculates the azimuth of the moon, in degrees clockwise
+// from north, and its altitude above the horizon in degrees at t, as seen
+// from the observer's latitude, longitude and elevation. The position is
+// topocentric: it includes the lunar parallax, which lowers the moon by up
+// to a degree near the horizon. The altitude is geometric, without
+// atmospheric refraction.
+func (o Observer) MoonPosition(t time.Time) (azimuth, altitude float64, err error) {
+	if err := o.validate(t); err != nil {
+		return math.NaN(), math.NaN(), err
+	}
+	azimuth, altitude, _ = o.moonHorizontal(julianDate(t.UTC()))
+	return azimuth, altitude, nil
+}
+
+// moonHorizontal calculates the topocentric azimuth and altitude of the
+// moon in degrees, and its distance from the earth's centre in kilometers,
+// at the Julian date jd (UT).
+func (o Observer) moonHorizontal(jd float64) (azimuth, altitude, dist float64) {
+	ha, dec, dist := o.moonTopocentric(jd)
+	azimuth, altitude = equatorialToHorizontal(o.Lat, ha, dec)
+	return azimuth, altitude, dist
+}
+
+// moonTopocentric calculates the moon's topocentric local hour angle and
+// declination in degrees, and its distance from the earth's centre in
+// kilometers, at the Julian date jd (UT).
+func (o Observer) moonTopocentric(jd float64) (ha, dec, dist float64) {
+	ra, dec, dist := moonEquatorial(o.ttFromUT(jd))
+	ha, dec = topocentric(gast(jd)+o.Lon-ra, dec, earthRadius/dist, o.Lat, o.Elevation)
+	return ha, dec, dist
+}
+
+// MoonPosition calculates the topocentric azimuth and altitude of the moon
+// at t for an observer at sea level at the latitude and longitude.
+func MoonPosition(t time.Time, latitude, longitude float64) (azimuth, altitude float64, err error) {
+	return Observer{Lat: latitude, Lon: longitude}.MoonPosition(t)
+}
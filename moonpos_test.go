@@ -0,0 +1,55 @@
+package astrotime
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMoonEquatorial(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 47.a.
+	ra, dec, dist := moonEquatorial(2448724.5)
+	if math.Abs(ra-134.688470) > 1e-3 || math.Abs(dec-13.768368) > 1e-3 {
+		t.Errorf("got %.6f, %.6f, want 134.688470, 13.768368", ra, dec)
+	}
+	if math.Abs(dist-368409.7) > 0.1 {
+		t.Errorf("got distance %.1f, want 368409.7", dist)
+	}
+}
+
+func TestTopocentric(t *testing.T) {
+	// Meeus example 40.a: Mars seen from Palomar.
+	sinPi := math.Sin(degToRad * 23.592 / 3600)
+	ha, dec := topocentric(288.7958, -15.771083, sinPi, 33.356111, 1706)
+	if math.Abs(ha-288.790425) > 1e-4 || math.Abs(dec+15.775) > 1e-4 {
+		t.Errorf("got %.6f, %.6f, want 288.790425, -15.775000", ha, dec)
+	}
+}
+
+func TestObserverMoonPosition(t *testing.T) {
+	o := Observer{Lat: 51.4779, Lon: -0.0015}
+	for h := 0; h < 48; h += 5 {
+		at := time.Date(2024, 3, 10, h%24, 0, 0, 0, time.UTC).AddDate(0, 0, h/24)
+		az, alt, err := o.MoonPosition(at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if az < 0 || az >= 360 || alt < -90 || alt > 90 {
+			t.Errorf("%v: got azimuth %.2f, altitude %.2f out of range", at, az, alt)
+		}
+
+		// The geocentric altitude differs by the parallax, π cos(alt).
+		jd := julianDate(at)
+		ra, dec, dist := moonEquatorial(ttFromUT(jd))
+		_, geo := equatorialToHorizontal(o.Lat, gast(jd)+o.Lon-ra, dec)
+		want := radToDeg * math.Asin(earthRadius/dist) * math.Cos(degToRad*geo)
+		if d := geo - alt; math.Abs(d-want) > 0.02 {
+			t.Errorf("%v: parallax %.3f, want %.3f", at, d, want)
+		}
+	}
+
+	if _, _, err := MoonPosition(time.Now(), 91, 0); !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("got error %v, want ErrInvalidCoordinates", err)
+	}
+}
@@ -0,0 +1,102 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// moonScanStep is the interval at which the moon's altitude is sampled when
+// searching for moonrise and moonset. The moon cannot rise and set again
+// within it outside the polar regions.
+const moonScanStep = 20 * time.Minute
+
+// moonHorizonAltitude returns how far the moon's topocentric altitude is
+// above the altitude at which its upper limb touches the observer's
+// horizon, at t.
+func (o Observer) moonHorizonAltitude(t time.Time) float64 {
+	_, alt, dist := o.moonHorizontal(julianDate(t.UTC()))
+	semidiameter := radToDeg * math.Asin(moonRadius/dist)
+	return alt + semidiameter + o.refraction() + horizonDip(o.Elevation)
+}
+
+// moonCrossings finds every moonrise and moonset in [start, end), sampling
+// the altitude once per moonScanStep and refining each horizon crossing to
+// the second.
+func (o Observer) moonCrossings(start, end time.Time) (rises, sets []time.Time) {
+	t0, h0 := start, o.moonHorizonAltitude(start)
+	for t0.Before(end) {
+		t1 := t0.Add(moonScanStep)
+		h1 := o.moonHorizonAltitude(t1)
+		if (h0 < 0) != (h1 < 0) {
+			lo, hi := t0, t1
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if (o.moonHorizonAltitude(mid) < 0) == (h0 < 0) {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			if !hi.Before(start) && hi.Before(end) {
+				if h0 < 0 {
+					rises = append(rises, hi)
+				} else {
+					sets = append(sets, hi)
+				}
+			}
+		}
+		t0, h0 = t1, h1
+	}
+	return rises, sets
+}
+
+// dayBounds returns the start of the observer's calendar day containing t
+// and the start of the next one.
+func (o Observer) dayBounds(t time.Time) (start, end time.Time) {
+	t = o.local(t)
+	y, m, d := t.Date()
+	start = time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	return start, time.Date(y, m, d+1, 0, 0, 0, 0, t.Location())
+}
+
+// Moonrise calculates the first moonrise on the calendar day of t, when the
+// moon's upper limb appears above the horizon. About once a month the
+// moon does not rise within a calendar day, and ErrNoEvent is returned.
+func (o Observer) Moonrise(t time.Time) (time.Time, error) {
+	return o.moonEvent(t, true)
+}
+
+// Moonset calculates the first moonset on the calendar day of t. About once
+// a month the moon does not set within a calendar day, and ErrNoEvent is
+// returned.
+func (o Observer) Moonset(t time.Time) (time.Time, error) {
+	return o.moonEvent(t, false)
+}
+
+func (o Observer) moonEvent(t time.Time, rise bool) (time.Time, error) {
+	if err := o.validate(t); err != nil {
+		return time.Time{}, err
+	}
+	start, end := o.dayBounds(t)
+	rises, sets := o.moonCrossings(start, end)
+	times := sets
+	if rise {
+		times = rises
+	}
+	if len(times) == 0 {
+		return time.Time{}, ErrNoEvent
+	}
+	return o.round(times[0]), nil
+}
+
+// Moonrise calculates the first moonrise on the day t for an observer at
+// sea level at the latitude and longitude.
+func Moonrise(t time.Time, latitude, longitude float64) (time.Time, error) {
+	return Observer{Lat: latitude, Lon: longitude}.Moonrise(t)
+}
+
+// Moonset calculates the first moonset on the day t for an observer at sea
+// level at the latitude and longitude.
+func Moonset(t time.Time, latitude, longitude float64) (time.Time, error) {
+	return Observer{Lat: latitude, Lon: longitude}.Moonset(t)
+}
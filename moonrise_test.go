@@ -0,0 +1,54 @@
+package astrotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMoonriseMoonset(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	o := Observer{Lat: 40.7128, Lon: -74.0060, Location: ny}
+	// New moon of 2024 October 2: the moon rises and sets with the sun.
+	day := time.Date(2024, 10, 2, 12, 0, 0, 0, ny)
+	tests := []struct {
+		name  string
+		event func(time.Time) (time.Time, error)
+		want  time.Time
+	}{
+		{"moonrise", o.Moonrise, time.Date(2024, 10, 2, 6, 41, 0, 0, ny)},
+		{"moonset", o.Moonset, time.Date(2024, 10, 2, 18, 31, 0, 0, ny)},
+	}
+	for _, tt := range tests {
+		got, err := tt.event(day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := got.Sub(tt.want); d < -2*time.Minute || d > 2*time.Minute {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMoonriseSkipped(t *testing.T) {
+	// Moonrise comes about 50 minutes later each day, so once a lunar
+	// month a calendar day has none: at Greenwich in March 2024, the 30th.
+	o := Observer{Lat: 51.4779, Lon: 0}
+	var skipped []int
+	for d := 10; d < 38; d++ {
+		day := time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC)
+		_, err := o.Moonrise(day)
+		switch {
+		case errors.Is(err, ErrNoEvent):
+			skipped = append(skipped, day.Day())
+		case err != nil:
+			t.Fatal(err)
+		}
+	}
+	if len(skipped) != 1 || skipped[0] != 30 {
+		t.Errorf("got days without moonrise %v, want [30]", skipped)
+	}
+}
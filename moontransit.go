@@ -0,0 +1,59 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// moonMeridianCrossings finds every transit, when the moon crosses the
+// observer's meridian at its highest, and anti-transit, when it crosses the
+// opposite meridian below the pole, in [start, end).
+func (o Observer) moonMeridianCrossings(start, end time.Time) (transits, antiTransits []time.Time) {
+	sinHA := func(t time.Time) (float64, float64) {
+		ha, _, _ := o.moonTopocentric(julianDate(t.UTC()))
+		return math.Sin(degToRad * ha), math.Cos(degToRad * ha)
+	}
+	t0 := start
+	s0, _ := sinHA(t0)
+	for t0.Before(end) {
+		t1 := t0.Add(moonScanStep)
+		s1, c1 := sinHA(t1)
+		if (s0 < 0) != (s1 < 0) {
+			lo, hi := t0, t1
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if s, _ := sinHA(mid); (s < 0) == (s0 < 0) {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			if !hi.Before(start) && hi.Before(end) {
+				// The hour angle increases through 0° at transit and
+				// through 180° at anti-transit.
+				if c1 > 0 {
+					transits = append(transits, hi)
+				} else {
+					antiTransits = append(antiTransits, hi)
+				}
+			}
+		}
+		t0, s0 = t1, s1
+	}
+	return transits, antiTransits
+}
+
+// MoonTransit calculates the first transit of the moon on the calendar day
+// of t, when it crosses the meridian and stands highest in the sky. Transit
+// comes about 50 minutes later each day, so about once a month a calendar
+// day has none and ErrNoEvent is returned.
+func (o Observer) MoonTransit(t time.Time) (time.Time, error) {
+	if err := o.validate(t); err != nil {
+		return time.Time{}, err
+	}
+	transits, _ := o.moonMeridianCrossings(o.dayBounds(t))
+	if len(transits) == 0 {
+		return time.Time{}, ErrNoEvent
+	}
+	return o.round(transits[0]), nil
+}
@@ -0,0 +1,41 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMoonTransit(t *testing.T) {
+	o := Observer{Lat: 40.7128, Lon: -74.0060}
+	for d := 1; d <= 10; d++ {
+		day := time.Date(2024, 10, d, 0, 0, 0, 0, time.UTC)
+		tr, err := o.MoonTransit(day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The moon stands higher at transit than just before or after.
+		_, alt, _ := o.MoonPosition(tr)
+		for _, dt := range []time.Duration{-10 * time.Minute, 10 * time.Minute} {
+			if _, a, _ := o.MoonPosition(tr.Add(dt)); a >= alt {
+				t.Errorf("%v: altitude %.3f at %v exceeds %.3f at transit", day, a, dt, alt)
+			}
+		}
+		// It is due south from New York, give or take a degree.
+		if az, _, _ := o.MoonPosition(tr); az < 179 || az > 181 {
+			t.Errorf("%v: got azimuth %.2f at transit, want 180", day, az)
+		}
+	}
+}
+
+func TestMoonTransitSkipped(t *testing.T) {
+	o := Observer{Lat: 51.4779, Lon: 0}
+	skipped := 0
+	for d := 0; d < 28; d++ {
+		if _, err := o.MoonTransit(time.Date(2024, 3, 1+d, 0, 0, 0, 0, time.UTC)); err != nil {
+			skipped++
+		}
+	}
+	if skipped != 1 {
+		t.Errorf("got %d days without transit, want 1", skipped)
+	}
+}
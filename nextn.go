@@ -0,0 +1,67 @@
+package astrotime
+
+import "time"
+
+// NextSunrises returns the next n sunrises after after, or none if n is not
+// positive. The observer is validated once, and each day's sunrise comes
+// straight from the terms of its solar noon, as in SunTimesRange, rather
+// than through Sunrise for each day in turn. Days without a sunrise are
+// skipped; if none occurs for a year the sunrises found so far are
+// returned with the error.
+func (o Observer) NextSunrises(after time.Time, n int) ([]time.Time, error) {
+	return o.nextN(after, n, true)
+}
+
+// NextSunsets returns the next n sunsets after after, as for NextSunrises.
+func (o Observer) NextSunsets(after time.Time, n int) ([]time.Time, error) {
+	return o.nextN(after, n, false)
+}
+
+// nextN collects the next n sunrises, or sunsets unless rising is set,
+// after after, calculating each day once.
+func (o Observer) nextN(after time.Time, n int, rising bool) ([]time.Time, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+	if err := o.validate(after); err != nil {
+		return nil, err
+	}
+	after = o.local(after)
+	times := make([]time.Time, 0, n)
+	last := after
+	for i, idle := 0, 0; len(times) < n; i++ {
+		t := after.AddDate(0, 0, i)
+		if err := ValidateDate(t); err != nil {
+			return times, err
+		}
+		d := o.onDay(t)
+		s, err := d.crossing(d.noon(o.Lon), o.Lat, o.Lon, d.zenith, rising)
+		switch {
+		case err != nil && !isNoEvent(err):
+			return times, err
+		case err == nil && s.After(last):
+			times = append(times, s)
+			last, idle = s, 0
+		case idle > 366:
+			return times, err
+		default:
+			idle++
+		}
+	}
+	return times, nil
+}
+
+// NextNSunrises returns the next n sunrises after after at the location
+// specified in latitude and longitude. Fewer are returned if the sun stops
+// rising for more than a year or the location is invalid.
+func NextNSunrises(after time.Time, n int, latitude, longitude float64) []time.Time {
+	times, _ := Observer{Lat: latitude, Lon: longitude}.NextSunrises(after, n)
+	return times
+}
+
+// NextNSunsets returns the next n sunsets after after at the location
+// specified in latitude and longitude, as for NextNSunrises.
+func NextNSunsets(after time.Time, n int, latitude, longitude float64) []time.Time {
+	times, _ := Observer{Lat: latitude, Lon: longitude}.NextSunsets(after, n)
+	return times
+}
@@ -0,0 +1,75 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextNSunrises(t *testing.T) {
+	after := p("2017-12-15T15:14:00Z")
+	got := NextNSunrises(after, 7, 38.8895, -77.0352)
+	if len(got) != 7 {
+		t.Fatalf("got %d sunrises, want 7", len(got))
+	}
+	for i, sr := range got {
+		if want := Sunrise(after.AddDate(0, 0, i+1), 38.8895, -77.0352); sr != want {
+			t.Errorf("got sunrise %s, want %s", sr, want)
+		}
+	}
+}
+
+func TestNextNSunsets(t *testing.T) {
+	got := NextNSunsets(p("2017-04-10T00:00:00Z"), 30, 78.2232, 15.6267)
+	if len(got) != 30 {
+		t.Fatalf("got %d sunsets, want 30", len(got))
+	}
+	if last := got[len(got)-1]; last.Sub(got[0]) < 100*24*time.Hour {
+		t.Errorf("got last sunset %s, want after the polar summer", last)
+	}
+	if got := NextNSunsets(p("2017-04-10T00:00:00Z"), 3, 91, 0); len(got) != 0 {
+		t.Errorf("got %d sunsets for an invalid latitude, want 0", len(got))
+	}
+}
+
+func TestNextSunrisesNonPositive(t *testing.T) {
+	after := p("2017-12-15T15:14:00Z")
+	for _, n := range []int{0, -1} {
+		if got := NextNSunrises(after, n, 51, 0); got != nil {
+			t.Errorf("NextNSunrises(%d) = %v, want nil", n, got)
+		}
+		if got, err := (Observer{Lat: 51}).NextSunsets(after, n); got != nil || err != nil {
+			t.Errorf("NextSunsets(%d) = %v, %v, want nil, nil", n, got, err)
+		}
+	}
+}
+
+func TestNextSunsetsMatchSunTimesRange(t *testing.T) {
+	after := p("2024-03-28T18:00:00Z")
+	for _, o := range []Observer{
+		NewObserver(51.5, -0.13, WithAlgorithm(AlgorithmVSOP87)),
+		NewObserver(-33.9, 151.2, WithElevation(100)),
+	} {
+		got, err := o.NextSunsets(after, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		days, err := o.SunTimesRange(after, after.AddDate(0, 0, 6))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var want []time.Time
+		for _, d := range days {
+			if d.Sunset.After(after) && len(want) < 5 {
+				want = append(want, d.Sunset)
+			}
+		}
+		if len(got) != len(want) {
+			t.Fatalf("got %d sunsets, want %d", len(got), len(want))
+		}
+		for i := range got {
+			if !got[i].Equal(want[i]) {
+				t.Errorf("got sunset %s, want %s", got[i], want[i])
+			}
+		}
+	}
+}
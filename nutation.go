@@ -0,0 +1,138 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// nutationTerm is a periodic term of the IAU 1980 theory of nutation: the
+// multiples of D, M, M′, F and Ω in its argument, and its coefficients for
+// Δψ and Δε in units of 0.0001″ and their rates per Julian century.
+type nutationTerm struct {
+	args                 [5]float64
+	psi, psiT, eps, epsT float64
+}
+
+// nutationTerms is the IAU 1980 series, in the order of Meeus table 22.A.
+var nutationTerms = [...]nutationTerm{
+	{[5]float64{0, 0, 0, 0, 1}, -171996, -174.2, 92025, 8.9},
+	{[5]float64{-2, 0, 0, 2, 2}, -13187, -1.6, 5736, -3.1},
+	{[5]float64{0, 0, 0, 2, 2}, -2274, -0.2, 977, -0.5},
+	{[5]float64{0, 0, 0, 0, 2}, 2062, 0.2, -895, 0.5},
+	{[5]float64{0, 1, 0, 0, 0}, 1426, -3.4, 54, -0.1},
+	{[5]float64{0, 0, 1, 0, 0}, 712, 0.1, -7, 0},
+	{[5]float64{-2, 1, 0, 2, 2}, -517, 1.2, 224, -0.6},
+	{[5]float64{0, 0, 0, 2, 1}, -386, -0.4, 200, 0},
+	{[5]float64{0, 0, 1, 2, 2}, -301, 0, 129, -0.1},
+	{[5]float64{-2, -1, 0, 2, 2}, 217, -0.5, -95, 0.3},
+	{[5]float64{-2, 0, 1, 0, 0}, -158, 0, 0, 0},
+	{[5]float64{-2, 0, 0, 2, 1}, 129, 0.1, -70, 0},
+	{[5]float64{0, 0, -1, 2, 2}, 123, 0, -53, 0},
+	{[5]float64{2, 0, 0, 0, 0}, 63, 0, 0, 0},
+	{[5]float64{0, 0, 1, 0, 1}, 63, 0.1, -33, 0},
+	{[5]float64{2, 0, -1, 2, 2}, -59, 0, 26, 0},
+	{[5]float64{0, 0, -1, 0, 1}, -58, -0.1, 32, 0},
+	{[5]float64{0, 0, 1, 2, 1}, -51, 0, 27, 0},
+	{[5]float64{-2, 0, 2, 0, 0}, 48, 0, 0, 0},
+	{[5]float64{0, 0, -2, 2, 1}, 46, 0, -24, 0},
+	{[5]float64{2, 0, 0, 2, 2}, -38, 0, 16, 0},
+	{[5]float64{0, 0, 2, 2, 2}, -31, 0, 13, 0},
+	{[5]float64{0, 0, 2, 0, 0}, 29, 0, 0, 0},
+	{[5]float64{-2, 0, 1, 2, 2}, 29, 0, -12, 0},
+	{[5]float64{0, 0, 0, 2, 0}, 26, 0, 0, 0},
+	{[5]float64{-2, 0, 0, 2, 0}, -22, 0, 0, 0},
+	{[5]float64{0, 0, -1, 2, 1}, 21, 0, -10, 0},
+	{[5]float64{0, 2, 0, 0, 0}, 17, -0.1, 0, 0},
+	{[5]float64{2, 0, -1, 0, 1}, 16, 0, -8, 0},
+	{[5]float64{-2, 2, 0, 2, 2}, -16, 0.1, 7, 0},
+	{[5]float64{0, 1, 0, 0, 1}, -15, 0, 9, 0},
+	{[5]float64{-2, 0, 1, 0, 1}, -13, 0, 7, 0},
+	{[5]float64{0, -1, 0, 0, 1}, -12, 0, 6, 0},
+	{[5]float64{0, 0, 2, -2, 0}, 11, 0, 0, 0},
+	{[5]float64{2, 0, -1, 2, 1}, -10, 0, 5, 0},
+	{[5]float64{2, 0, 1, 2, 2}, -8, 0, 3, 0},
+	{[5]float64{0, 1, 0, 2, 2}, 7, 0, -3, 0},
+	{[5]float64{-2, 1, 1, 0, 0}, -7, 0, 0, 0},
+	{[5]float64{0, -1, 0, 2, 2}, -7, 0, 3, 0},
+	{[5]float64{2, 0, 0, 2, 1}, -7, 0, 3, 0},
+	{[5]float64{2, 0, 1, 0, 0}, 6, 0, 0, 0},
+	{[5]float64{-2, 0, 2, 2, 2}, 6, 0, -3, 0},
+	{[5]float64{-2, 0, 1, 2, 1}, 6, 0, -3, 0},
+	{[5]float64{2, 0, -2, 0, 1}, -6, 0, 3, 0},
+	{[5]float64{2, 0, 0, 0, 1}, -6, 0, 3, 0},
+	{[5]float64{0, -1, 1, 0, 0}, 5, 0, 0, 0},
+	{[5]float64{-2, -1, 0, 2, 1}, -5, 0, 3, 0},
+	{[5]float64{-2, 0, 0, 0, 1}, -5, 0, 3, 0},
+	{[5]float64{0, 0, 2, 2, 1}, -5, 0, 3, 0},
+	{[5]float64{-2, 0, 2, 0, 1}, 4, 0, 0, 0},
+	{[5]float64{-2, 1, 0, 2, 1}, 4, 0, 0, 0},
+	{[5]float64{0, 0, 1, -2, 0}, 4, 0, 0, 0},
+	{[5]float64{-1, 0, 1, 0, 0}, -4, 0, 0, 0},
+	{[5]float64{-2, 1, 0, 0, 0}, -4, 0, 0, 0},
+	{[5]float64{1, 0, 0, 0, 0}, -4, 0, 0, 0},
+	{[5]float64{0, 0, 1, 2, 0}, 3, 0, 0, 0},
+	{[5]float64{0, 0, -2, 2, 2}, -3, 0, 0, 0},
+	{[5]float64{-1, -1, 1, 0, 0}, -3, 0, 0, 0},
+	{[5]float64{0, 1, 1, 0, 0}, -3, 0, 0, 0},
+	{[5]float64{0, -1, 1, 2, 2}, -3, 0, 0, 0},
+	{[5]float64{2, -1, -1, 2, 2}, -3, 0, 0, 0},
+	{[5]float64{0, 0, 3, 2, 2}, -3, 0, 0, 0},
+	{[5]float64{2, -1, 0, 2, 2}, -3, 0, 0, 0},
+}
+
+// nutation calculates the nutation in longitude and obliquity, in degrees,
+// by the full IAU 1980 series, for t in Julian centuries of TT since
+// J2000.0, good to a few hundredths of a second of arc.
+func nutation(t float64) (dpsi, deps float64) {
+	arg := [5]float64{
+		297.85036 + t*(445267.111480+t*(-0.0019142+t/189474)), // D
+		357.52772 + t*(35999.050340+t*(-0.0001603-t/300000)),  // M
+		134.96298 + t*(477198.867398+t*(0.0086972+t/56250)),   // M′
+		93.27191 + t*(483202.017538+t*(-0.0036825+t/327270)),  // F
+		125.04452 + t*(-1934.136261+t*(0.0020708+t/450000)),   // Ω
+	}
+	for _, term := range nutationTerms {
+		var a float64
+		for i, n := range term.args {
+			a += n * arg[i]
+		}
+		a *= degToRad
+		dpsi += (term.psi + term.psiT*t) * math.Sin(a)
+		deps += (term.eps + term.epsT*t) * math.Cos(a)
+	}
+	return dpsi / 3600e4, deps / 3600e4
+}
+
+// Nutation calculates the nutation in longitude, Δψ, and in obliquity, Δε,
+// in degrees at t: the periodic nodding of the earth's axis, chiefly with
+// the 18.6-year cycle of the moon's node, of up to 17″ and 9″. Adding them
+// to the mean ecliptic longitude and obliquity gives their values for the
+// true equinox of date.
+func Nutation(t time.Time) (longitude, obliquity float64) {
+	return nutation(julianCentury(ttFromUT(julianDate(t.UTC()))))
+}
+
+// aberrationConstant is the constant of annual aberration κ, in degrees.
+const aberrationConstant = 20.49552 / 3600
+
+// Aberration calculates the annual aberration of a star at the right
+// ascension and declination, in degrees, at t: the corrections, also in
+// degrees, to add to its mean position for the displacement by the earth's
+// orbital velocity, of up to 20.5″. It uses the method of Meeus chapter 23,
+// including the terms in the eccentricity of the earth's orbit.
+func Aberration(t time.Time, ra, dec float64) (dra, ddec float64) {
+	tc := julianCentury(ttFromUT(julianDate(t.UTC())))
+	sun := degToRad * solarTrueLon(tc)
+	eps := degToRad * eclipticMeanObliquity(tc)
+	e := earthOrbitEccentricity(tc)
+	pi := degToRad * (102.93735 + tc*(1.71946+0.00046*tc))
+	a, d := degToRad*ra, degToRad*dec
+	k := aberrationConstant
+
+	dra = (-k*(math.Cos(a)*math.Cos(sun)*math.Cos(eps)+math.Sin(a)*math.Sin(sun)) +
+		e*k*(math.Cos(a)*math.Cos(pi)*math.Cos(eps)+math.Sin(a)*math.Sin(pi))) / math.Cos(d)
+	q := math.Tan(eps)*math.Cos(d) - math.Sin(a)*math.Sin(d)
+	ddec = -k*(math.Cos(sun)*math.Cos(eps)*q+math.Cos(a)*math.Sin(d)*math.Sin(sun)) +
+		e*k*(math.Cos(pi)*math.Cos(eps)*q+math.Cos(a)*math.Sin(d)*math.Sin(pi))
+	return dra, ddec
+}
@@ -0,0 +1,41 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNutation(t *testing.T) {
+	// Meeus example 22.a: 1987 April 10, 0h TD, Δψ = −3.788″, Δε = +9.443″.
+	dpsi, deps := nutation(-0.127296372348)
+	if math.Abs(dpsi*3600+3.788) > 0.001 || math.Abs(deps*3600-9.443) > 0.001 {
+		t.Errorf("got %.3f″, %.3f″, want -3.788″, 9.443″", dpsi*3600, deps*3600)
+	}
+	// The short series holds to half a second of arc.
+	for tc := -2.0; tc <= 2; tc += 0.0137 {
+		dpsi, deps := nutation(tc)
+		psi, eps := nutationApprox(tc)
+		if math.Abs(dpsi-psi)*3600 > 0.5 || math.Abs(deps-eps)*3600 > 0.1 {
+			t.Errorf("T = %.4f: got %.3f″, %.3f″, short series gives %.3f″, %.3f″", tc, dpsi*3600, deps*3600, psi*3600, eps*3600)
+		}
+	}
+}
+
+func TestNutationExported(t *testing.T) {
+	// The same instant in UT, ΔT = 55s earlier.
+	lon, obl := Nutation(time.Date(1987, 4, 9, 23, 59, 4, 0, time.UTC))
+	if math.Abs(lon*3600+3.788) > 0.01 || math.Abs(obl*3600-9.443) > 0.01 {
+		t.Errorf("got %.3f″, %.3f″, want -3.788″, 9.443″", lon*3600, obl*3600)
+	}
+}
+
+func TestAberration(t *testing.T) {
+	// Meeus example 23.a: θ Persei on 2028 November 13.19 TD, at its mean
+	// place of date, Δα = +30.045″, Δδ = +6.697″.
+	at := time.Date(2028, 11, 13, 4, 33, 36, 0, time.UTC).Add(-DeltaT(time.Date(2028, 11, 13, 0, 0, 0, 0, time.UTC)))
+	dra, ddec := Aberration(at, 41.5599646, 49.3520685)
+	if math.Abs(dra*3600-30.045) > 0.1 || math.Abs(ddec*3600-6.697) > 0.1 {
+		t.Errorf("got %.3f″, %.3f″, want 30.045″, 6.697″", dra*3600, ddec*3600)
+	}
+}
@@ -0,0 +1,127 @@
+package astrotime
+
+import "time"
+
+// Observer is a location on earth from which solar events are observed.
+//
+// An Observer is a value: its settings are fixed by the options it was made
+// with, its methods never change it, and the package keeps no mutable state
+// of its own, so one configured Observer may be shared by any number of
+// goroutines. Copies share only the cache of WithCache, which
+// synchronizes itself.
+type Observer struct {
+	// Lat and Lon are the latitude and longitude of the observer in
+	// degrees, positive north and east.
+	Lat, Lon float64
+
+	// Elevation is the height of the observer above sea level in meters.
+	// Rise and set times are corrected for the dip of the horizon seen
+	// from that height.
+	Elevation float64
+
+	// Location is the time zone results are expressed in, and in which
+	// the calendar day of a query is interpreted. If nil, the location of
+	// the time passed to each method is used; Resolve sets it to the
+	// place's own zone.
+	Location *time.Location
+
+	zenithAngle  float64
+	noRefraction bool
+	atmosphere   bool
+	pressure     float64
+	temperature  float64
+	precision    time.Duration
+	goldenHour   *altitudeBand
+	blueHour     *altitudeBand
+	darkSky      *darkSkyLimits
+	terrestrial  bool
+	algorithm    Algorithm
+	cache        *eventCache
+	refine       refinement
+	deltaTTable  *DeltaTTable
+}
+
+// local returns t in the observer's time zone.
+func (o Observer) local(t time.Time) time.Time {
+	if o.Location == nil {
+		return t
+	}
+	return t.In(o.Location)
+}
+
+// Sunrise calculates the sunrise on the day t.
+func (o Observer) Sunrise(t time.Time) (time.Time, error) {
+	if o.cache != nil {
+		return o.cachedEventTime(t, EventSunrise)
+	}
+	return o.event(t, o.sunrise)
+}
+
+// Sunset calculates the sunset on the day t.
+func (o Observer) Sunset(t time.Time) (time.Time, error) {
+	if o.cache != nil {
+		return o.cachedEventTime(t, EventSunset)
+	}
+	return o.event(t, o.sunset)
+}
+
+// NextSunrise returns date/time of the next sunrise after after, looking up
+// to a year ahead.
+func (o Observer) NextSunrise(after time.Time) (time.Time, error) {
+	return o.next(after, o.Sunrise)
+}
+
+// NextSunset returns date/time of the next sunset after after, looking up
+// to a year ahead.
+func (o Observer) NextSunset(after time.Time) (time.Time, error) {
+	return o.next(after, o.Sunset)
+}
+
+// SunriseAzimuth calculates the direction of sunrise, in degrees clockwise
+// from north, on the day t.
+func (o Observer) SunriseAzimuth(t time.Time) (float64, error) {
+	if err := o.validate(t); err != nil {
+		return 0, err
+	}
+	return sunriseAzimuth(o.local(t), o.Lat, o.Lon, o.zenith())
+}
+
+// SunsetAzimuth calculates the direction of sunset, in degrees clockwise
+// from north, on the day t.
+func (o Observer) SunsetAzimuth(t time.Time) (float64, error) {
+	if err := o.validate(t); err != nil {
+		return 0, err
+	}
+	return sunsetAzimuth(o.local(t), o.Lat, o.Lon, o.zenith())
+}
+
+// event validates the query and calculates the crossing of the observer's
+// zenith angle on the day t using calc.
+func (o Observer) event(t time.Time, calc func(t time.Time, latitude, longitude, zenith float64) (time.Time, error)) (time.Time, error) {
+	if err := o.validate(t); err != nil {
+		return time.Time{}, err
+	}
+	s, err := calc(o.local(t), o.Lat, o.Lon, o.zenith())
+	if err != nil {
+		return time.Time{}, err
+	}
+	return o.round(s), nil
+}
+
+// next returns the first time returned by event after after, trying the day
+// of after and each following day for up to a year.
+func (o Observer) next(after time.Time, event func(time.Time) (time.Time, error)) (time.Time, error) {
+	after = o.local(after)
+	var err error
+	for i := 0; i <= 366; i++ {
+		var s time.Time
+		s, err = event(after.AddDate(0, 0, i))
+		if err == nil && after.Before(s) {
+			return s, nil
+		}
+		if err != nil && !isNoEvent(err) {
+			return time.Time{}, err
+		}
+	}
+	return time.Time{}, err
+}
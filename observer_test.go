@@ -0,0 +1,128 @@
+package astrotime
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestObserver(t *testing.T) {
+	for n, place := range places {
+		o := Observer{Lat: place.lat, Lon: place.lon}
+		for _, d := range place.times {
+			name := fmt.Sprintf("%s on %v", n, d.day)
+			t.Run(name, func(t *testing.T) {
+				if got := sunriseOn(t, o, d.day); got != d.sunrise {
+					t.Errorf("got sunrise %s, want %s", got, d.sunrise)
+				}
+				if got := sunsetOn(t, o, d.day); got != d.sunset {
+					t.Errorf("got sunset %s, want %s", got, d.sunset)
+				}
+			})
+		}
+	}
+}
+
+func TestObserverLocation(t *testing.T) {
+	loc := time.FixedZone("AEST", 10*60*60)
+	o := Observer{Lat: -37.8136, Lon: 144.9631, Location: loc}
+	got := sunriseOn(t, o, p("2017-07-09T22:00:00Z"))
+	if got.Location() != loc {
+		t.Errorf("got location %v, want %v", got.Location(), loc)
+	}
+	if want := Sunrise(p("2017-07-09T22:00:00Z").In(loc), o.Lat, o.Lon); !got.Equal(want) {
+		t.Errorf("got sunrise %s, want %s", got, want)
+	}
+}
+
+func TestObserverErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		o    Observer
+		day  time.Time
+		want error
+	}{
+		{"midnight sun", Observer{Lat: 78.2232, Lon: 15.6267}, p("2017-06-21T12:00:00Z"), ErrAlwaysAbove},
+		{"polar night", Observer{Lat: 78.2232, Lon: 15.6267}, p("2017-12-21T12:00:00Z"), ErrAlwaysBelow},
+		{"antarctic summer", Observer{Lat: -77.8419, Lon: 166.6863}, p("2017-12-21T12:00:00Z"), ErrAlwaysAbove},
+		{"latitude", Observer{Lat: 91, Lon: 0}, p("2017-06-21T12:00:00Z"), ErrInvalidCoordinates},
+		{"longitude", Observer{Lat: 0, Lon: math.NaN()}, p("2017-06-21T12:00:00Z"), ErrInvalidCoordinates},
+		{"date", Observer{Lat: 0, Lon: 0}, time.Date(3001, 1, 1, 0, 0, 0, 0, time.UTC), ErrDateOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.o.Sunrise(tt.day); !errors.Is(err, tt.want) {
+				t.Errorf("got sunrise error %v, want %v", err, tt.want)
+			}
+			if _, err := tt.o.Sunset(tt.day); !errors.Is(err, tt.want) {
+				t.Errorf("got sunset error %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestObserverNextSunrise(t *testing.T) {
+	o := Observer{Lat: 78.2232, Lon: 15.6267}
+	got, err := o.NextSunrise(p("2017-12-21T12:00:00Z"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Month() != time.February {
+		t.Errorf("got next sunrise %s after polar night, want in February", got)
+	}
+}
+
+// TestObserverConcurrent shares one configured Observer, Calculator and
+// batch between goroutines; run with -race.
+func TestObserverConcurrent(t *testing.T) {
+	table, err := NewDeltaTTable([]DeltaTEntry{{Year: 2020, DeltaT: 69 * time.Second}, {Year: 2030, DeltaT: 71 * time.Second}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := NewObserver(59.3, 18.1, WithElevation(40), WithCache(64), WithDeltaT(true), WithDeltaTTable(table))
+	start := p("2024-05-01T00:00:00+02:00")
+	c, err := NewCalculator(start, WithDeltaT(true), WithDeltaTTable(table))
+	if err != nil {
+		t.Fatal(err)
+	}
+	places := []LatLon{{59.3, 18.1}, {-33.9, 18.4}, {35.7, 139.7}, {70, 25}}
+	want, err := o.EventsBetween(start, start.AddDate(0, 0, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantBatch, err := BatchSunTimes(start, places, WithDeltaT(true), WithDeltaTTable(table))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			events, err := o.EventsBetween(start, start.AddDate(0, 0, 10))
+			if err != nil || len(events) != len(want) {
+				t.Errorf("got %d events, %v, want %d", len(events), err, len(want))
+				return
+			}
+			for i := range events {
+				if events[i] != want[i] {
+					t.Errorf("event %d is %v, want %v", i, events[i], want[i])
+				}
+			}
+			batch, _ := BatchSunTimes(start, places, WithDeltaT(true), WithDeltaTTable(table))
+			for i, pl := range places {
+				if batch[i] != wantBatch[i] {
+					t.Errorf("batch at %+v got %+v, want %+v", pl, batch[i], wantBatch[i])
+				}
+				if s, _ := c.Sunrise(pl.Lat, pl.Lon); !s.Equal(wantBatch[i].Sunrise) {
+					t.Errorf("calculator sunrise at %+v got %s, want %s", pl, s, wantBatch[i].Sunrise)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
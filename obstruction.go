@@ -0,0 +1,65 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// Obstruction is something standing above the horizon that hides the sun,
+// such as a neighbouring building or a ridge, seen as a band of azimuths
+// up to an angular height.
+type Obstruction struct {
+	// From and To bound the azimuths the obstruction spans, in degrees
+	// clockwise from north, running clockwise from From to To: an
+	// obstruction from 350° to 10° spans north, and one from 0° to 360°
+	// the whole horizon.
+	From, To float64
+
+	// Altitude is the angular height of its top above the horizon, in
+	// degrees.
+	Altitude float64
+}
+
+// hides reports whether the obstruction hides the sun at the azimuth and
+// altitude.
+func (ob Obstruction) hides(azimuth, altitude float64) bool {
+	span := math.Mod(ob.To-ob.From+360, 360)
+	if ob.To-ob.From >= 360 {
+		span = 360
+	}
+	return math.Mod(azimuth-ob.From+360, 360) <= span && altitude < ob.Altitude
+}
+
+// DirectSun returns the intervals of the calendar day of t during which
+// the sun's centre is above the horizon and clear of every obstruction.
+// The first interval begins when direct sun first clears the horizon and
+// obstructions and the last ends when the sun disappears behind them;
+// gaps between them are spent behind another. The sun's position is its
+// geometric one, sampled every five minutes and refined to the second, so
+// glimpses shorter than the sampling may be missed. The result is empty if
+// the sun is never in view that day.
+func (o Observer) DirectSun(t time.Time, obstructions ...Obstruction) ([]Interval, error) {
+	return o.sunlit(t, func(azimuth, altitude float64) bool {
+		for _, ob := range obstructions {
+			if ob.hides(azimuth, altitude) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// sunlit returns the intervals of the calendar day of t during which the
+// sun's centre is above the horizon at an azimuth and altitude for which
+// visible reports true.
+func (o Observer) sunlit(t time.Time, visible func(azimuth, altitude float64) bool) ([]Interval, error) {
+	if err := o.validate(t); err != nil {
+		return nil, err
+	}
+	t = o.local(t)
+	start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return o.windows(start, start.AddDate(0, 0, 1), func(t time.Time) bool {
+		az, alt := o.sunPosition(t)
+		return alt > 0 && visible(az, alt)
+	}), nil
+}
@@ -0,0 +1,42 @@
+package astrotime
+
+import "testing"
+
+func TestDirectSun(t *testing.T) {
+	o := NewObserver(51.4769, -0.0005)
+	day := p("2024-03-20T12:00:00Z")
+	for _, test := range []struct {
+		obstructions []Obstruction
+		want         []string
+	}{
+		{nil, []string{"2024-03-20T06:07:07Z", "2024-03-20T18:08:28Z"}},
+		// A building to the east holds the sun back until it clears 15°.
+		{[]Obstruction{{From: 60, To: 120, Altitude: 15}}, []string{"2024-03-20T07:45:08Z", "2024-03-20T18:08:28Z"}},
+		// A tower to the south hides it around noon.
+		{
+			[]Obstruction{{From: 60, To: 120, Altitude: 15}, {From: 170, To: 190, Altitude: 50}},
+			[]string{"2024-03-20T07:45:08Z", "2024-03-20T11:35:56Z", "2024-03-20T12:38:38Z", "2024-03-20T18:08:28Z"},
+		},
+		// The sun never climbs above 39° in March.
+		{[]Obstruction{{From: 0, To: 360, Altitude: 50}}, nil},
+		// Nor does it pass north.
+		{[]Obstruction{{From: 350, To: 10, Altitude: 50}}, []string{"2024-03-20T06:07:07Z", "2024-03-20T18:08:28Z"}},
+	} {
+		got, err := o.DirectSun(day, test.obstructions...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(test.want)/2 {
+			t.Errorf("%v: got %v, want %v", test.obstructions, got, test.want)
+			continue
+		}
+		for i, iv := range got {
+			if !iv.Start.Equal(p(test.want[2*i])) || !iv.End.Equal(p(test.want[2*i+1])) {
+				t.Errorf("%v: got %v, want %v", test.obstructions, got, test.want)
+			}
+		}
+	}
+	if _, err := NewObserver(91, 0).DirectSun(day); err == nil {
+		t.Error("got no error for an invalid latitude")
+	}
+}
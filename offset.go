@@ -0,0 +1,54 @@
+package astrotime
+
+import "time"
+
+// NextEventAt returns the first time after after that lies offset from an
+// occurrence of kind; a negative offset is before the event. For example,
+// with an offset of -30 minutes and kind EventSunset, a call made 15
+// minutes before today's sunset returns a time half an hour before
+// tomorrow's.
+func (o Observer) NextEventAt(after time.Time, kind EventKind, offset time.Duration) (time.Time, error) {
+	// event + offset > after exactly when event > after - offset.
+	ev, err := o.NextEvent(after.Add(-offset), kind)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return ev.Time.Add(offset), nil
+}
+
+// At returns a channel that receives the current time once, at the next
+// time offset from an occurrence of kind. Like a SunTicker it follows the
+// wall clock, which is checked at least once a minute.
+func (o Observer) At(kind EventKind, offset time.Duration) (<-chan time.Time, error) {
+	return o.at(kind, offset, time.Now, tickerPoll)
+}
+
+func (o Observer) at(kind EventKind, offset time.Duration, now func() time.Time, poll time.Duration) (<-chan time.Time, error) {
+	target, err := o.NextEventAt(now(), kind, offset)
+	if err != nil {
+		return nil, err
+	}
+	c := make(chan time.Time, 1)
+	go func() {
+		for {
+			n := now()
+			wait := target.Sub(n)
+			if wait <= 0 {
+				c <- n
+				return
+			}
+			if wait > poll {
+				wait = poll
+			}
+			time.Sleep(wait)
+		}
+	}()
+	return c, nil
+}
+
+// At returns a channel that receives the current time once, at the next time
+// offset from an occurrence of kind at the location specified in latitude
+// and longitude.
+func At(kind EventKind, offset time.Duration, latitude, longitude float64) (<-chan time.Time, error) {
+	return Observer{Lat: latitude, Lon: longitude}.At(kind, offset)
+}
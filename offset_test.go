@@ -0,0 +1,56 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextEventAt(t *testing.T) {
+	o := Observer{Lat: 14.5995, Lon: 120.9842}
+	d := places["manila"].times[0]
+	tests := []struct {
+		name   string
+		after  time.Time
+		offset time.Duration
+		want   time.Time
+	}{
+		{"before the offset", d.sunset.Add(-time.Hour), -30 * time.Minute, d.sunset.Add(-30 * time.Minute)},
+		{"between offset and event", d.sunset.Add(-15 * time.Minute), -30 * time.Minute, d.sunset.Add(24*time.Hour - 30*time.Minute)},
+		{"after the event", d.sunset.Add(15 * time.Minute), 30 * time.Minute, d.sunset.Add(30 * time.Minute)},
+		{"offset past the next day", d.sunset.Add(2 * time.Hour), 25 * time.Hour, d.sunset.Add(25 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := o.NextEventAt(tt.after, EventSunset, tt.offset)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.After(tt.after) || got.Sub(tt.want).Abs() > time.Minute {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAt(t *testing.T) {
+	d := places["manila"].times[0]
+	clock := &fakeClock{t: d.sunrise.Add(-time.Hour)}
+	c, err := Observer{Lat: 14.5995, Lon: 120.9842}.at(EventSunrise, -10*time.Minute, clock.now, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-c:
+		t.Fatal("fired before the clock reached the offset")
+	case <-time.After(20 * time.Millisecond):
+	}
+	clock.set(d.sunrise.Add(-5 * time.Minute))
+	select {
+	case got := <-c:
+		if got != clock.now() {
+			t.Errorf("got %s, want the current time %s", got, clock.now())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("did not fire after the clock passed the offset")
+	}
+}
@@ -0,0 +1,125 @@
+package astrotime
+
+import "time"
+
+// Option configures the calculations made by an Observer.
+type Option func(*Observer)
+
+// NewObserver returns an Observer at the location specified in latitude and
+// longitude, configured by opts.
+func NewObserver(latitude, longitude float64, opts ...Option) Observer {
+	o := Observer{Lat: latitude, Lon: longitude}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithZenith sets the zenith angle, in degrees, of the sun's centre at
+// sunrise and sunset, replacing the standard 90.833°. It overrides any
+// refraction setting.
+func WithZenith(zenith float64) Option {
+	return func(o *Observer) {
+		o.zenithAngle = zenith
+	}
+}
+
+// WithRefraction enables or disables the standard correction for
+// atmospheric refraction at the horizon. It is enabled by default.
+func WithRefraction(enabled bool) Option {
+	return func(o *Observer) {
+		o.noRefraction = !enabled
+	}
+}
+
+// WithElevation sets the observer's height above sea level in meters. Rise
+// and set times account for the dip of the horizon seen from that height.
+func WithElevation(meters float64) Option {
+	return func(o *Observer) {
+		o.Elevation = meters
+	}
+}
+
+// feetToMeters converts feet to meters.
+const feetToMeters = 0.3048
+
+// WithFlightLevel sets the observer's height to a flight level, in hundreds
+// of feet of pressure altitude: WithFlightLevel(350) for FL350. From there
+// the horizon dips by about 3°, so that the sun rises a dozen minutes
+// or more before it does on the ground below, and sets as much later. The
+// pressure altitude is taken as the height above the sea or terrain at the
+// horizon, as it is in the standard atmosphere. Refraction stays that of
+// the air at the horizon, set by WithAtmosphere, since the line of sight
+// to the sun at sunrise grazes the earth there rather than passing through
+// the thin air at the aircraft.
+func WithFlightLevel(level float64) Option {
+	return WithElevation(level * 100 * feetToMeters)
+}
+
+// WithPrecision sets the granularity results are truncated to, one second by
+// default. WithPrecision(time.Nanosecond) returns the full floating-point
+// solution, for comparison with almanac data or other scientific work.
+func WithPrecision(d time.Duration) Option {
+	return func(o *Observer) {
+		o.precision = d
+	}
+}
+
+// WithFastMode enables or disables the fast mode of AlgorithmNOAA, which
+// takes the sun's position at solar noon for sunrise, sunset and twilight
+// instead of refining it at the time the first pass finds. That saves
+// about a third of the work and moves results by up to a minute at middle
+// latitudes, more near the polar circles. Solar noon and AlgorithmVSOP87
+// are not affected. It is WithIterations(1, 0); disabling it restores the
+// default two passes.
+func WithFastMode(enabled bool) Option {
+	if enabled {
+		return WithIterations(1, 0)
+	}
+	return WithIterations(0, 0)
+}
+
+// WithIterations sets how AlgorithmNOAA refines sunrise, sunset and
+// twilight: in up to max passes, each taking the sun's position at the time
+// the last found, stopping early once two passes agree within tolerance.
+// The default, as in NOAA's calculator, is two passes. Near the polar
+// circles, where the sun grazes the horizon, that can stop tens of seconds
+// short of where further passes settle; three to five passes with a
+// tolerance of a second converge. More passes do not remove the error from
+// sampling the sun at the time of day of the query, which dominates there;
+// AlgorithmVSOP87 does. A max of zero or less restores the default.
+func WithIterations(max int, tolerance time.Duration) Option {
+	return func(o *Observer) {
+		if max <= 0 {
+			o.refine = refinement{}
+			return
+		}
+		o.refine = refinement{passes: max, tolerance: tolerance.Minutes()}
+	}
+}
+
+// refinement returns how AlgorithmNOAA refines sunrise and sunset.
+func (o Observer) refinement() refinement {
+	if o.refine.passes <= 0 {
+		return defaultRefinement
+	}
+	return o.refine
+}
+
+// zenith returns the zenith angle of the sun's centre at sunrise and sunset,
+// lowered by the dip of the horizon seen from the observer's elevation.
+func (o Observer) zenith() float64 {
+	dip := horizonDip(o.Elevation)
+	if o.zenithAngle != 0 {
+		return o.zenithAngle + dip
+	}
+	return zenithOfficial - standardRefraction + o.refraction() + dip
+}
+
+// round truncates t to the observer's precision.
+func (o Observer) round(t time.Time) time.Time {
+	if o.precision <= 0 {
+		return t.Truncate(time.Second)
+	}
+	return t.Truncate(o.precision)
+}
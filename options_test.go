@@ -0,0 +1,122 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+// sunriseOn returns the sunrise seen by o on day, failing the test on error.
+func sunriseOn(t *testing.T, o Observer, day time.Time) time.Time {
+	t.Helper()
+	s, err := o.Sunrise(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// sunsetOn returns the sunset seen by o on day, failing the test on error.
+func sunsetOn(t *testing.T, o Observer, day time.Time) time.Time {
+	t.Helper()
+	s, err := o.Sunset(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestNewObserverDefaults(t *testing.T) {
+	d := places["reykjavik"].times[0]
+	o := NewObserver(64.1265, -21.8174)
+	if got := sunriseOn(t, o, d.day); got != d.sunrise {
+		t.Errorf("got sunrise %s, want %s", got, d.sunrise)
+	}
+}
+
+func TestWithZenith(t *testing.T) {
+	day := p("2017-10-15T12:00:00Z")
+	official := sunriseOn(t, NewObserver(38.8895, -77.0352), day)
+	civil := sunriseOn(t, NewObserver(38.8895, -77.0352, WithZenith(96)), day)
+	if d := official.Sub(civil); d < 20*time.Minute || d > 40*time.Minute {
+		t.Errorf("got civil dawn %s before sunrise, want about 27m", d)
+	}
+}
+
+func TestWithRefraction(t *testing.T) {
+	day := p("2017-10-15T12:00:00Z")
+	with := sunriseOn(t, NewObserver(38.8895, -77.0352), day)
+	without := sunriseOn(t, NewObserver(38.8895, -77.0352, WithRefraction(false)), day)
+	if !without.After(with) {
+		t.Errorf("got sunrise without refraction %s, want after %s", without, with)
+	}
+}
+
+func TestWithPrecision(t *testing.T) {
+	day := p("2017-10-15T12:00:00Z")
+	got := sunriseOn(t, NewObserver(38.8895, -77.0352, WithPrecision(time.Minute)), day)
+	if got.Second() != 0 {
+		t.Errorf("got sunrise %s, want whole minutes", got)
+	}
+}
+
+func TestWithSubSecondPrecision(t *testing.T) {
+	d := places["manila"].times[1]
+	o := NewObserver(14.5995, 120.9842, WithPrecision(time.Nanosecond))
+	got := sunriseOn(t, o, d.day)
+	if got.Truncate(time.Second) != d.sunrise {
+		t.Errorf("got sunrise %s, want within the second of %s", got, d.sunrise)
+	}
+	if got.Nanosecond() == 0 {
+		t.Errorf("got sunrise %s, want sub-second precision", got)
+	}
+}
+
+func TestWithFastMode(t *testing.T) {
+	for d := 0; d < 366; d += 5 {
+		day := p("2024-01-01T12:00:00Z").AddDate(0, 0, d)
+		for _, o := range []Observer{NewObserver(45, 7.7), NewObserver(-33.9, 18.4, WithDeltaT(true))} {
+			precise := sunriseOn(t, o, day)
+			fast := o
+			WithFastMode(true)(&fast)
+			if diff := sunriseOn(t, fast, day).Sub(precise).Abs(); diff > time.Minute {
+				t.Errorf("%v on %s: fast sunrise off by %s, want within a minute", o.Lat, day, diff)
+			}
+		}
+	}
+	// AlgorithmVSOP87 always iterates.
+	day := p("2024-06-20T12:00:00Z")
+	v := sunriseOn(t, NewObserver(45, 7.7, WithAlgorithm(AlgorithmVSOP87)), day)
+	if got := sunriseOn(t, NewObserver(45, 7.7, WithAlgorithm(AlgorithmVSOP87), WithFastMode(true)), day); !got.Equal(v) {
+		t.Errorf("got fast VSOP87 sunrise %s, want %s", got, v)
+	}
+	if sunriseOn(t, NewObserver(45, 7.7, WithFastMode(true)), day).Equal(sunriseOn(t, NewObserver(45, 7.7), day)) {
+		t.Error("fast mode made no difference at the solstice")
+	}
+}
+
+func TestWithIterations(t *testing.T) {
+	// Just south of the Arctic Circle in early July the sun barely dips
+	// below the horizon, and two passes stop 18 seconds short.
+	day := p("2024-07-05T12:00:00Z")
+	two := sunriseOn(t, NewObserver(66.45, 10), day)
+	converged := sunriseOn(t, NewObserver(66.45, 10, WithIterations(50, 0)), day)
+	if want := p("2024-07-04T23:30:48Z"); !converged.Equal(want) {
+		t.Errorf("got converged sunrise %s, want %s", converged, want)
+	}
+	if d := two.Sub(converged); d != 18*time.Second {
+		t.Errorf("two passes off by %s, want 18s", d)
+	}
+	if got := sunriseOn(t, NewObserver(66.45, 10, WithIterations(8, time.Second)), day); !got.Equal(converged) {
+		t.Errorf("got sunrise %s to a second's tolerance, want %s", got, converged)
+	}
+	if got := sunriseOn(t, NewObserver(66.45, 10, WithIterations(2, 0)), day); !got.Equal(two) {
+		t.Errorf("got sunrise %s in two passes, want the default %s", got, two)
+	}
+	if got := sunriseOn(t, NewObserver(66.45, 10, WithIterations(5, 0), WithIterations(0, 0)), day); !got.Equal(two) {
+		t.Errorf("got sunrise %s after reset, want the default %s", got, two)
+	}
+	fast := sunriseOn(t, NewObserver(66.45, 10, WithFastMode(true)), day)
+	if got := sunriseOn(t, NewObserver(66.45, 10, WithIterations(1, 0)), day); !got.Equal(fast) {
+		t.Errorf("got sunrise %s in one pass, want the fast mode's %s", got, fast)
+	}
+}
@@ -0,0 +1,198 @@
+package astrotime
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// iso6709 matches ISO 6709 point strings such as "+38.8895-077.0352/" or
+// "+385323-0770032+100CRSWGS_84/", capturing latitude and longitude.
+var iso6709 = regexp.MustCompile(`^([+-]\d{2}(?:\d{2}){0,2}(?:\.\d+)?)([+-]\d{3}(?:\d{2}){0,2}(?:\.\d+)?)(?:[+-]\d+(?:\.\d+)?)?(?:CRS[A-Za-z0-9_]+)?/?$`)
+
+// ParseCoordinates parses a latitude and longitude written in decimal
+// degrees ("38.8895, -77.0352" or "38.8895N 77.0352W"), in degrees, minutes
+// and seconds (`38°53'23"N 77°00'32"W`), or as an ISO 6709 string
+// ("+38.8895-077.0352/"). Without hemisphere letters the latitude comes
+// first. The result is checked with ValidateCoordinates.
+func ParseCoordinates(s string) (latitude, longitude float64, err error) {
+	s = strings.TrimSpace(s)
+	if m := iso6709.FindStringSubmatch(s); m != nil {
+		latitude, err = parseISO6709(m[1], 2)
+		if err == nil {
+			longitude, err = parseISO6709(m[2], 3)
+		}
+	} else {
+		latitude, longitude, err = parseHuman(s)
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("astrotime: cannot parse coordinates %q: %v", s, err)
+	}
+	if err := ValidateCoordinates(latitude, longitude); err != nil {
+		return 0, 0, err
+	}
+	return latitude, longitude, nil
+}
+
+// parseISO6709 parses one signed ISO 6709 component whose whole degrees take
+// degDigits digits, optionally followed by whole minutes and seconds.
+func parseISO6709(s string, degDigits int) (float64, error) {
+	sign := 1.0
+	if s[0] == '-' {
+		sign = -1
+	}
+	s = s[1:]
+	whole := s
+	frac := ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, frac = s[:i], s[i:]
+	}
+	var parts []string
+	parts = append(parts, whole[:degDigits])
+	for i := degDigits; i < len(whole); i += 2 {
+		parts = append(parts, whole[i:i+2])
+	}
+	parts[len(parts)-1] += frac
+	v, err := sexagesimal(parts)
+	return sign * v, err
+}
+
+// coordinateReplacer turns degree, minute and second marks into spaces.
+var coordinateReplacer = strings.NewReplacer("°", " ", "º", " ", "'", " ", "′", " ", "’", " ", `"`, " ", "″", " ", "”", " ", ";", ",")
+
+// parseHuman parses decimal degrees or degrees, minutes and seconds, with
+// optional hemisphere letters.
+func parseHuman(s string) (latitude, longitude float64, err error) {
+	s = coordinateReplacer.Replace(strings.ToUpper(s))
+
+	var groups [][]string
+	if strings.Count(s, ",") == 1 {
+		for _, half := range strings.Split(s, ",") {
+			groups = append(groups, tokenize(half))
+		}
+	} else {
+		groups = splitTokens(tokenize(s))
+	}
+	if len(groups) != 2 {
+		return 0, 0, fmt.Errorf("want two coordinates")
+	}
+
+	var vals [2]float64
+	var axes [2]byte
+	for i, g := range groups {
+		if vals[i], axes[i], err = parseComponent(g); err != nil {
+			return 0, 0, err
+		}
+	}
+	switch {
+	case axes[0] == 'E' && axes[1] != 'E', axes[1] == 'N' && axes[0] != 'N':
+		vals[0], vals[1] = vals[1], vals[0]
+	case axes[0] != 0 && axes[0] == axes[1]:
+		return 0, 0, fmt.Errorf("both coordinates in the same axis")
+	}
+	return vals[0], vals[1], nil
+}
+
+// tokenize splits s into numbers and single hemisphere letters.
+func tokenize(s string) []string {
+	var tokens []string
+	for _, f := range strings.Fields(s) {
+		for f != "" {
+			i := strings.IndexAny(f, "NSEW")
+			switch {
+			case i < 0:
+				tokens = append(tokens, f)
+				f = ""
+			case i > 0:
+				tokens = append(tokens, f[:i])
+				f = f[i:]
+			default:
+				tokens = append(tokens, f[:1])
+				f = f[1:]
+			}
+		}
+	}
+	return tokens
+}
+
+// splitTokens divides the tokens of a pair written without a comma into its two
+// coordinates, using hemisphere letters where present.
+func splitTokens(tokens []string) [][]string {
+	if len(tokens) == 0 {
+		return nil
+	}
+	isLetter := func(tok string) bool { return strings.ContainsAny(tok, "NSEW") }
+	if isLetter(tokens[0]) {
+		for i := 1; i < len(tokens); i++ {
+			if isLetter(tokens[i]) {
+				return [][]string{tokens[:i], tokens[i:]}
+			}
+		}
+		return nil
+	}
+	for i, tok := range tokens {
+		if isLetter(tok) {
+			return [][]string{tokens[:i+1], tokens[i+1:]}
+		}
+	}
+	if len(tokens)%2 != 0 {
+		return nil
+	}
+	return [][]string{tokens[:len(tokens)/2], tokens[len(tokens)/2:]}
+}
+
+// parseComponent parses the degrees, minutes and seconds of one coordinate
+// with an optional leading or trailing hemisphere letter, returning its
+// signed value and axis, 'N' for latitude, 'E' for longitude or 0 if unknown.
+func parseComponent(tokens []string) (float64, byte, error) {
+	var hemi string
+	if n := len(tokens); n > 0 && strings.ContainsAny(tokens[n-1], "NSEW") {
+		hemi, tokens = tokens[n-1], tokens[:n-1]
+	} else if n > 0 && strings.ContainsAny(tokens[0], "NSEW") {
+		hemi, tokens = tokens[0], tokens[1:]
+	}
+	if len(tokens) == 0 || len(tokens) > 3 {
+		return 0, 0, fmt.Errorf("want degrees, minutes and seconds")
+	}
+
+	sign := 1.0
+	if strings.HasPrefix(tokens[0], "-") {
+		sign = -1
+	}
+	tokens[0] = strings.TrimLeft(tokens[0], "+-")
+	v, err := sexagesimal(tokens)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	switch hemi {
+	case "":
+		return sign * v, 0, nil
+	case "N":
+		return sign * v, 'N', nil
+	case "S":
+		return -sign * v, 'N', nil
+	case "E":
+		return sign * v, 'E', nil
+	}
+	return -sign * v, 'E', nil
+}
+
+// sexagesimal combines unsigned degrees, minutes and seconds.
+func sexagesimal(parts []string) (float64, error) {
+	v := 0.0
+	scale := 1.0
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(part, 64)
+		if err != nil || f < 0 {
+			return 0, fmt.Errorf("bad number %q", part)
+		}
+		if i > 0 && f >= 60 {
+			return 0, fmt.Errorf("%q is not below 60", part)
+		}
+		v += f / scale
+		scale *= 60
+	}
+	return v, nil
+}
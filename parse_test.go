@@ -0,0 +1,57 @@
+package astrotime
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseCoordinates(t *testing.T) {
+	tests := []struct {
+		in       string
+		lat, lon float64
+	}{
+		{"38.8895, -77.0352", 38.8895, -77.0352},
+		{"38.8895 -77.0352", 38.8895, -77.0352},
+		{"-37.8136;144.9631", -37.8136, 144.9631},
+		{"38.8895N 77.0352W", 38.8895, -77.0352},
+		{"38.8895° N, 77.0352° W", 38.8895, -77.0352},
+		{`38°53'23"N 77°00'32"W`, 38.889722, -77.008889},
+		{"38°53′23″N, 77°00′32″W", 38.889722, -77.008889},
+		{"N38 53 23 W77 00 32", 38.889722, -77.008889},
+		{`77°00'32"W 38°53'23"N`, 38.889722, -77.008889},
+		{"37 48.816 S 144 57.786 E", -37.8136, 144.9631},
+		{"+38.8895-077.0352/", 38.8895, -77.0352},
+		{"+385323-0770032/", 38.889722, -77.008889},
+		{"+3853.38-07700.53/", 38.889667, -77.008833},
+		{"-3748.816+14457.786+25CRSWGS_84/", -37.8136, 144.9631},
+	}
+	for _, tt := range tests {
+		lat, lon, err := ParseCoordinates(tt.in)
+		if err != nil {
+			t.Errorf("ParseCoordinates(%q): %v", tt.in, err)
+			continue
+		}
+		if math.Abs(lat-tt.lat) > 1e-6 || math.Abs(lon-tt.lon) > 1e-6 {
+			t.Errorf("ParseCoordinates(%q) = %v, %v, want %v, %v", tt.in, lat, lon, tt.lat, tt.lon)
+		}
+	}
+}
+
+func TestParseCoordinatesErrors(t *testing.T) {
+	for _, in := range []string{
+		"",
+		"38.8895",
+		"north, south",
+		"38 61 00 N 77 00 00 W",
+		"38N 77N",
+		"1 2 3",
+	} {
+		if _, _, err := ParseCoordinates(in); err == nil {
+			t.Errorf("ParseCoordinates(%q) succeeded, want error", in)
+		}
+	}
+	if _, _, err := ParseCoordinates("95, 10"); !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("got error %v for latitude 95, want ErrInvalidCoordinates", err)
+	}
+}
@@ -0,0 +1,67 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// SunDistance calculates the distance between the earth and the sun at t,
+// in astronomical units. It ranges from about 0.983 AU at perihelion in
+// early January to 1.017 AU at aphelion in early July.
+func SunDistance(t time.Time) float64 {
+	return earthRadiusVector(ttFromUT(julianDate(t.UTC())))
+}
+
+// earthApsis calculates the Julian ephemeris date of the earth's perihelion,
+// for integer k, or aphelion, for k half-way between integers, counted from
+// the perihelion of 2000 (Meeus, Astronomical Algorithms, chapter 38). The
+// periodic terms account for the moon's pull on the earth, leaving errors
+// of several hours.
+func earthApsis(k float64) float64 {
+	jde := 2451547.507 + k*(365.2596358+k*0.0000000156)
+	a1 := degToRad * (328.41 + 132.788585*k)
+	a2 := degToRad * (316.13 + 584.903153*k)
+	a3 := degToRad * (346.20 + 450.380738*k)
+	a4 := degToRad * (136.95 + 659.306737*k)
+	a5 := degToRad * (249.52 + 329.653368*k)
+	if k == math.Floor(k) {
+		return jde + 1.278*math.Sin(a1) - 0.055*math.Sin(a2) - 0.091*math.Sin(a3) - 0.056*math.Sin(a4) - 0.045*math.Sin(a5)
+	}
+	return jde - 1.352*math.Sin(a1) + 0.061*math.Sin(a2) + 0.062*math.Sin(a3) + 0.029*math.Sin(a4) + 0.031*math.Sin(a5)
+}
+
+// refineEarthApsis finds the extreme of the earth's distance from the sun
+// within three days of the estimate jde: the minimum for a perihelion, or
+// the maximum if aphelion is set.
+func refineEarthApsis(jde float64, aphelion bool) float64 {
+	sign := 1.0
+	if aphelion {
+		sign = -1
+	}
+	const phi = 0.6180339887498949
+	lo, hi := jde-3, jde+3
+	for hi-lo > 1e-4 {
+		a := hi - phi*(hi-lo)
+		b := lo + phi*(hi-lo)
+		if sign*earthRadiusVector(a) < sign*earthRadiusVector(b) {
+			hi = b
+		} else {
+			lo = a
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// EarthApsides calculates the instants of the earth's perihelion, when it is
+// closest to the sun, and aphelion, when it is farthest, in the year. The
+// times are good to within about an hour; the earth's distance changes so
+// slowly near them that the exact instant matters little.
+func EarthApsides(year int) (perihelion, aphelion time.Time, err error) {
+	if year < MinYear || year > MaxYear {
+		return time.Time{}, time.Time{}, ErrDateOutOfRange
+	}
+	k := math.Round(0.99997 * float64(year-2000))
+	perihelion = timeFromJulianDate(utFromTT(refineEarthApsis(earthApsis(k), false)))
+	aphelion = timeFromJulianDate(utFromTT(refineEarthApsis(earthApsis(k+0.5), true)))
+	return perihelion, aphelion, nil
+}
@@ -0,0 +1,39 @@
+package astrotime
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEarthApsides(t *testing.T) {
+	tests := []struct {
+		year                 int
+		perihelion, aphelion time.Time
+	}{
+		{2024, time.Date(2024, 1, 3, 0, 39, 0, 0, time.UTC), time.Date(2024, 7, 5, 5, 6, 0, 0, time.UTC)},
+		{2025, time.Date(2025, 1, 4, 13, 28, 0, 0, time.UTC), time.Date(2025, 7, 3, 19, 55, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		p, a, err := EarthApsides(tt.year)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := p.Sub(tt.perihelion); d.Abs() > time.Hour {
+			t.Errorf("%d: got perihelion %v, want %v", tt.year, p, tt.perihelion)
+		}
+		if d := a.Sub(tt.aphelion); d.Abs() > time.Hour {
+			t.Errorf("%d: got aphelion %v, want %v", tt.year, a, tt.aphelion)
+		}
+		if d := SunDistance(p); math.Abs(d-0.9833) > 0.0005 {
+			t.Errorf("%d: got %.4f AU at perihelion, want 0.9833", tt.year, d)
+		}
+		if d := SunDistance(a); math.Abs(d-1.0167) > 0.0005 {
+			t.Errorf("%d: got %.4f AU at aphelion, want 1.0167", tt.year, d)
+		}
+	}
+	if _, _, err := EarthApsides(4000); !errors.Is(err, ErrDateOutOfRange) {
+		t.Errorf("got %v, want ErrDateOutOfRange", err)
+	}
+}
@@ -0,0 +1,74 @@
+package astrotime
+
+import (
+	"strconv"
+	"time"
+)
+
+// TwilightPhase is the part of the day determined by the height of the sun.
+type TwilightPhase int
+
+// Twilight phases, in order of increasing light.
+const (
+	// Night is when the sun is more than 18° below the horizon.
+	Night TwilightPhase = iota
+	// AstronomicalTwilight is when the sun is between 12° and 18° below
+	// the horizon.
+	AstronomicalTwilight
+	// NauticalTwilight is when the sun is between 6° and 12° below the
+	// horizon.
+	NauticalTwilight
+	// CivilTwilight is when the sun is less than 6° below the horizon but
+	// has not risen.
+	CivilTwilight
+	// Day is between sunrise and sunset.
+	Day
+)
+
+const (
+	// Zenith angles of the sun's centre bounding each twilight.
+	zenithCivil        = 96
+	zenithNautical     = 102
+	zenithAstronomical = 108
+)
+
+var twilightPhaseNames = [...]string{
+	Night:                "Night",
+	AstronomicalTwilight: "AstronomicalTwilight",
+	NauticalTwilight:     "NauticalTwilight",
+	CivilTwilight:        "CivilTwilight",
+	Day:                  "Day",
+}
+
+func (p TwilightPhase) String() string {
+	if p < 0 || int(p) >= len(twilightPhaseNames) {
+		return "TwilightPhase(" + strconv.Itoa(int(p)) + ")"
+	}
+	return twilightPhaseNames[p]
+}
+
+// Phase reports the twilight phase at the instant t.
+func (o Observer) Phase(t time.Time) (TwilightPhase, error) {
+	if err := o.validate(t); err != nil {
+		return Night, err
+	}
+	_, alt := o.sunPosition(t)
+	z := 90 - alt
+	switch {
+	case z < o.zenith():
+		return Day, nil
+	case z < zenithCivil:
+		return CivilTwilight, nil
+	case z < zenithNautical:
+		return NauticalTwilight, nil
+	case z < zenithAstronomical:
+		return AstronomicalTwilight, nil
+	}
+	return Night, nil
+}
+
+// Phase reports the twilight phase at the instant t at the location
+// specified in latitude and longitude.
+func Phase(t time.Time, latitude, longitude float64) (TwilightPhase, error) {
+	return Observer{Lat: latitude, Lon: longitude}.Phase(t)
+}
@@ -0,0 +1,51 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPhase(t *testing.T) {
+	day := p("2017-10-15T12:00:00Z")
+	o := Observer{Lat: 38.8895, Lon: -77.0352}
+	sr := sunriseOn(t, o, day)
+	tests := []struct {
+		at   time.Time
+		want TwilightPhase
+	}{
+		{sr.Add(-3 * time.Hour), Night},
+		{sr.Add(-80 * time.Minute), AstronomicalTwilight},
+		{sr.Add(-45 * time.Minute), NauticalTwilight},
+		{sr.Add(-time.Minute), CivilTwilight},
+		{sr.Add(time.Minute), Day},
+		{p("2017-10-15T17:00:00Z"), Day},
+	}
+	for _, tt := range tests {
+		got, err := o.Phase(tt.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("got phase %v at %s, want %v", got, tt.at, tt.want)
+		}
+	}
+}
+
+func TestPhaseMidnightSun(t *testing.T) {
+	got, err := Phase(p("2017-06-21T23:00:00Z"), 78.2232, 15.6267)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != Day {
+		t.Errorf("got phase %v at midnight in Svalbard summer, want Day", got)
+	}
+}
+
+func TestTwilightPhaseString(t *testing.T) {
+	if got := NauticalTwilight.String(); got != "NauticalTwilight" {
+		t.Errorf("got %q, want %q", got, "NauticalTwilight")
+	}
+	if got := TwilightPhase(9).String(); got != "TwilightPhase(9)" {
+		t.Errorf("got %q, want %q", got, "TwilightPhase(9)")
+	}
+}
@@ -0,0 +1,79 @@
+package astrotime
+
+import "time"
+
+// Photoperiod calculates the photoperiod on the day t, the length of the
+// day as a plant measures it, for lighting control and crop models: the
+// time from sunrise to sunset or, with twilight, from civil dawn to civil
+// dusk, as many plants respond to the light of civil twilight. It is 24
+// hours when the sun stays up all day, above −6° with twilight, and zero
+// when it stays down.
+func (o Observer) Photoperiod(t time.Time, twilight bool) (time.Duration, error) {
+	if err := o.validate(t); err != nil {
+		return 0, err
+	}
+	return o.onDay(t).photoperiod(o.Lat, o.Lon, twilight), nil
+}
+
+// Photoperiod calculates the photoperiod on the day t at the location
+// specified in latitude and longitude.
+func Photoperiod(t time.Time, latitude, longitude float64, twilight bool) (time.Duration, error) {
+	return Observer{Lat: latitude, Lon: longitude}.Photoperiod(t, twilight)
+}
+
+// PhotoperiodRange calculates the photoperiod on each day from the day of
+// start to the day of end, inclusive, one value per day as SunTimesRange
+// gives SunTimes, and their total, the cumulative photoperiod over the
+// range.
+func (o Observer) PhotoperiodRange(start, end time.Time, twilight bool) (days []time.Duration, total time.Duration, err error) {
+	err = o.eachDay(start, end, func(d sunDay) {
+		p := d.photoperiod(o.Lat, o.Lon, twilight)
+		days = append(days, p)
+		total += p
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return days, total, nil
+}
+
+// photoperiod calculates the photoperiod at the latitude and longitude on
+// the day.
+func (d sunDay) photoperiod(latitude, longitude float64, twilight bool) time.Duration {
+	zenith := d.zenith
+	if twilight {
+		zenith = zenithCivil
+	}
+	n := d.noon(longitude)
+	rise, riseErr := d.crossing(n, latitude, longitude, zenith, true)
+	set, setErr := d.crossing(n, latitude, longitude, zenith, false)
+	switch {
+	case riseErr == ErrAlwaysAbove || setErr == ErrAlwaysAbove:
+		return 24 * time.Hour
+	case riseErr != nil || setErr != nil:
+		return 0
+	}
+	return set.Sub(rise)
+}
+
+// DayLengthDelta calculates how much longer the daylight, from sunrise to
+// sunset, is on the day t than on the day before, negative when the days
+// are shortening. Across the start or end of a polar day or night it
+// measures from the 24 hours or zero of Photoperiod.
+func (o Observer) DayLengthDelta(t time.Time) (time.Duration, error) {
+	t = o.local(t)
+	days, _, err := o.PhotoperiodRange(t.AddDate(0, 0, -1), t, false)
+	if err != nil {
+		return 0, err
+	}
+	return days[1] - days[0], nil
+}
+
+// DayLengthDelta calculates how much longer the daylight is on the day t
+// than on the day before at the location specified in latitude and
+// longitude. It returns zero if the day is out of range or the location
+// is invalid; use Observer.DayLengthDelta to find out why.
+func DayLengthDelta(t time.Time, latitude, longitude float64) time.Duration {
+	d, _ := Observer{Lat: latitude, Lon: longitude}.DayLengthDelta(t)
+	return d
+}
@@ -0,0 +1,110 @@
+package astrotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPhotoperiod(t *testing.T) {
+	for _, test := range []struct {
+		day      string
+		lat, lon float64
+		twilight bool
+		want     time.Duration
+	}{
+		{"2024-06-20T12:00:00Z", 89, 0, false, 24 * time.Hour},
+		{"2024-12-21T12:00:00Z", 89, 0, false, 0},
+		{"2024-12-21T12:00:00Z", 89, 0, true, 0},
+		{"2024-06-20T12:00:00Z", 65, 0, true, 24 * time.Hour},
+	} {
+		got, err := Photoperiod(p(test.day), test.lat, test.lon, test.twilight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%s at %v, twilight %v: got %s, want %s", test.day, test.lat, test.twilight, got, test.want)
+		}
+	}
+
+	o := NewObserver(51.4769, -0.0005)
+	day := p("2024-03-20T00:00:00Z")
+	rise, _ := o.Sunrise(day)
+	set, _ := o.Sunset(day)
+	if got, _ := o.Photoperiod(day, false); got != set.Sub(rise) {
+		t.Errorf("got %s, want %s", got, set.Sub(rise))
+	}
+	dawn, _ := o.EventTime(day, EventCivilDawn)
+	dusk, _ := o.EventTime(day, EventCivilDusk)
+	if got, _ := o.Photoperiod(day, true); got != dusk.Sub(dawn) {
+		t.Errorf("with twilight: got %s, want %s", got, dusk.Sub(dawn))
+	}
+	if _, err := Photoperiod(day, 91, 0, false); !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("got %v, want ErrInvalidCoordinates", err)
+	}
+}
+
+func TestPhotoperiodRange(t *testing.T) {
+	start, end := p("2024-01-01T06:30:00+01:00"), p("2024-12-31T23:00:00+01:00")
+	for _, o := range []Observer{
+		NewObserver(48.85, 2.35),
+		NewObserver(78.22, 15.65, WithDeltaT(true)),
+	} {
+		for _, twilight := range []bool{false, true} {
+			days, total, err := o.PhotoperiodRange(start, end, twilight)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(days) != 366 {
+				t.Fatalf("got %d days, want 366", len(days))
+			}
+			var sum time.Duration
+			for i, got := range days {
+				day := start.AddDate(0, 0, i)
+				want, _ := o.Photoperiod(day, twilight)
+				if got != want {
+					t.Errorf("%v on %s, twilight %v: got %s, want %s", o.Lat, day.Format("2006-01-02"), twilight, got, want)
+				}
+				sum += got
+			}
+			if total != sum {
+				t.Errorf("%v, twilight %v: got total %s, want %s", o.Lat, twilight, total, sum)
+			}
+		}
+	}
+	if _, _, err := NewObserver(91, 0).PhotoperiodRange(start, end, false); !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("got %v, want ErrInvalidCoordinates", err)
+	}
+}
+
+func TestDayLengthDelta(t *testing.T) {
+	for _, test := range []struct {
+		day      string
+		lat, lon float64
+		want     time.Duration
+	}{
+		{"2024-03-20T12:00:00Z", 51.4769, -0.0005, 3*time.Minute + 58*time.Second},
+		{"2024-09-22T12:00:00-04:00", 40.7128, -74.006, -2*time.Minute - 40*time.Second},
+		{"2024-06-20T12:00:00Z", 51.4769, -0.0005, 2 * time.Second},
+		// The polar night ends at Longyearbyen.
+		{"2024-02-16T12:00:00+01:00", 78.22, 15.65, time.Hour + 23*time.Minute + 9*time.Second},
+		{"2024-12-17T12:00:00+01:00", 78.22, 15.65, 0},
+		{"2024-03-20T12:00:00Z", 91, 0, 0},
+	} {
+		day := p(test.day)
+		if got := DayLengthDelta(day, test.lat, test.lon); got != test.want {
+			t.Errorf("%s at %v, %v: got %s, want %s", test.day, test.lat, test.lon, got, test.want)
+		}
+	}
+
+	o := NewObserver(-33.87, 151.21)
+	day := p("2024-11-01T12:00:00+11:00")
+	rise, _ := o.Sunrise(day)
+	set, _ := o.Sunset(day)
+	prevRise, _ := o.Sunrise(day.AddDate(0, 0, -1))
+	prevSet, _ := o.Sunset(day.AddDate(0, 0, -1))
+	want := set.Sub(rise) - prevSet.Sub(prevRise)
+	if got, err := o.DayLengthDelta(day); got != want || err != nil {
+		t.Errorf("got %s, %v, want %s", got, err, want)
+	}
+}
@@ -0,0 +1,60 @@
+package planets
+
+// element is an orbital element and its rate of change per Julian century.
+type element struct {
+	at2000, rate float64
+}
+
+// orbit holds the mean Keplerian elements of a planet, referred to the
+// mean ecliptic and equinox of J2000: semi-major axis a in AU,
+// eccentricity e, inclination i, mean longitude l, longitude of perihelion
+// w and longitude of the ascending node node, angles in degrees.
+type orbit struct {
+	a, e, i, l, w, node element
+}
+
+// orbits are from E. M. Standish, "Keplerian Elements for Approximate
+// Positions of the Major Planets" (JPL), table 1, fitted to DE405 over
+// 1800–2050. The earth's entry is the earth–moon barycentre.
+var orbits = [...]orbit{
+	Mercury: {
+		a: element{0.38709927, 0.00000037}, e: element{0.20563593, 0.00001906},
+		i: element{7.00497902, -0.00594749}, l: element{252.25032350, 149472.67411175},
+		w: element{77.45779628, 0.16047689}, node: element{48.33076593, -0.12534081},
+	},
+	Venus: {
+		a: element{0.72333566, 0.00000390}, e: element{0.00677672, -0.00004107},
+		i: element{3.39467605, -0.00078890}, l: element{181.97909950, 58517.81538729},
+		w: element{131.60246718, 0.00268329}, node: element{76.67984255, -0.27769418},
+	},
+	earth: {
+		a: element{1.00000261, 0.00000562}, e: element{0.01671123, -0.00004392},
+		i: element{-0.00001531, -0.01294668}, l: element{100.46457166, 35999.37244981},
+		w: element{102.93768193, 0.32327364}, node: element{0, 0},
+	},
+	Mars: {
+		a: element{1.52371034, 0.00001847}, e: element{0.09339410, 0.00007882},
+		i: element{1.84969142, -0.00813131}, l: element{-4.55343205, 19140.30268499},
+		w: element{-23.94362959, 0.44441088}, node: element{49.55953891, -0.29257343},
+	},
+	Jupiter: {
+		a: element{5.20288700, -0.00011607}, e: element{0.04838624, -0.00013253},
+		i: element{1.30439695, -0.00183714}, l: element{34.39644051, 3034.74612775},
+		w: element{14.72847983, 0.21252668}, node: element{100.47390909, 0.20469106},
+	},
+	Saturn: {
+		a: element{9.53667594, -0.00125060}, e: element{0.05386179, -0.00050991},
+		i: element{2.48599187, 0.00193609}, l: element{49.95424423, 1222.49362201},
+		w: element{92.59887831, -0.41897216}, node: element{113.66242448, -0.28867794},
+	},
+	Uranus: {
+		a: element{19.18916464, -0.00196176}, e: element{0.04725744, -0.00004397},
+		i: element{0.77263783, -0.00242939}, l: element{313.23810451, 428.48202785},
+		w: element{170.95427630, 0.40805281}, node: element{74.01692503, 0.04240589},
+	},
+	Neptune: {
+		a: element{30.06992276, 0.00026291}, e: element{0.00859048, 0.00005105},
+		i: element{1.77004347, 0.00035372}, l: element{-55.12002969, 218.45945325},
+		w: element{44.96476227, -0.32241464}, node: element{131.78422574, -0.00508664},
+	},
+}
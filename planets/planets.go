@@ -0,0 +1,217 @@
+// Package planets calculates the positions of the major planets as seen from
+// the earth, for use with the rise, set and transit calculations of
+// astrotime.Body.
+//
+//	pos, err := planets.Geocentric(planets.Jupiter, time.Now())
+//	rise, err := observer.BodyRise(pos.Body(), time.Now())
+//
+// Positions come from the mean Keplerian elements of E. M. Standish (JPL),
+// not from VSOP87: the package carries no VSOP87 series for the planets,
+// only the root package's for the earth. Over 1800–2050 the elements are
+// good to about an arcminute for the inner planets and ten arcminutes for
+// Saturn, ample for rise and set times and for finding a planet in the
+// sky, but not for a general ephemeris. Dates outside that span return
+// ErrDateOutOfRange.
+package planets
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/dntj/astrotime"
+	"github.com/dntj/astrotime/coords"
+	"github.com/dntj/astrotime/epoch"
+)
+
+const (
+	degToRad = math.Pi / 180
+	radToDeg = 180 / math.Pi
+
+	// j2000 is the Julian date of 2000 January 1.5 TT.
+	j2000 = 2451545.0
+
+	// unixEpochJD is the Julian date of 1970-01-01T00:00:00Z.
+	unixEpochJD = 2440587.5
+
+	// lightTime is the time light takes to cross one AU, in days.
+	lightTime = 0.0057755183
+
+	// solarParallax is the equatorial horizontal parallax of a body at
+	// one AU, in degrees.
+	solarParallax = 8.794 / 3600
+)
+
+// ErrDateOutOfRange is returned for dates outside 1800–2050, the span the
+// orbital elements are fitted to.
+var ErrDateOutOfRange = errors.New("planets: date outside 1800–2050")
+
+// ErrUnknownPlanet is returned for a Planet that is not one of the
+// constants of this package.
+var ErrUnknownPlanet = errors.New("planets: unknown planet")
+
+// Planet identifies a major planet.
+type Planet int
+
+// The major planets, in order from the sun.
+const (
+	Mercury Planet = iota
+	Venus
+	earth
+	Mars
+	Jupiter
+	Saturn
+	Uranus
+	Neptune
+)
+
+var planetNames = [...]string{
+	Mercury: "Mercury",
+	Venus:   "Venus",
+	earth:   "Earth",
+	Mars:    "Mars",
+	Jupiter: "Jupiter",
+	Saturn:  "Saturn",
+	Uranus:  "Uranus",
+	Neptune: "Neptune",
+}
+
+func (p Planet) String() string {
+	if p < 0 || int(p) >= len(planetNames) {
+		return "Planet(" + strconv.Itoa(int(p)) + ")"
+	}
+	return planetNames[p]
+}
+
+// Position is the geocentric position of a planet, referred to the mean
+// ecliptic and equinox of the date.
+type Position struct {
+	// Lon and Lat are the ecliptic longitude and latitude in degrees.
+	Lon, Lat float64
+
+	// RA and Dec are the right ascension and declination in degrees.
+	RA, Dec float64
+
+	// Distance is the distance from the earth in AU.
+	Distance float64
+}
+
+// String returns the equatorial position and distance for people, such as
+// "RA 11h36m07s, Dec +3°12′, 1.418 AU".
+func (p Position) String() string {
+	secs := int(math.Round(math.Mod(math.Mod(p.RA, 360)+360, 360)/15*3600)) % 86400
+	sign, dec := '+', p.Dec
+	if dec < 0 {
+		sign, dec = '-', -dec
+	}
+	arcmin := int(math.Round(dec * 60))
+	return fmt.Sprintf("RA %dh%02dm%02ds, Dec %c%d°%02d′, %.3f AU", secs/3600, secs/60%60, secs%60, sign, arcmin/60, arcmin%60, p.Distance)
+}
+
+// Body returns the position for the astrotime rise and set API. Planets
+// move against the stars, by up to a couple of degrees a day for Mercury,
+// so the position should be that of the day of interest.
+func (p Position) Body() astrotime.Body {
+	return astrotime.Body{RA: p.RA, Dec: p.Dec}
+}
+
+// heliocentric calculates the rectangular heliocentric coordinates of the
+// planet in AU, referred to the ecliptic and equinox of J2000, for t in
+// Julian centuries of TT since J2000.0. It is the only place the elements
+// are used, so truncated VSOP87 series, summed as the root package does
+// for the earth, could replace them without changing the API.
+func heliocentric(p Planet, t float64) (x, y, z float64) {
+	o := orbits[p]
+	at := func(e element) float64 { return e.at2000 + e.rate*t }
+	a, e := at(o.a), at(o.e)
+	i, node, w := degToRad*at(o.i), degToRad*at(o.node), degToRad*at(o.w)
+	m := math.Mod(at(o.l)-at(o.w), 360)
+
+	// Solve Kepler's equation M = E − e sin E by Newton's method.
+	mr := degToRad * m
+	ea := mr + e*math.Sin(mr)
+	for range 10 {
+		d := (ea - e*math.Sin(ea) - mr) / (1 - e*math.Cos(ea))
+		ea -= d
+		if math.Abs(d) < 1e-12 {
+			break
+		}
+	}
+
+	// Position in the orbital plane, then rotated onto the ecliptic.
+	xp := a * (math.Cos(ea) - e)
+	yp := a * math.Sqrt(1-e*e) * math.Sin(ea)
+	arg := w - node
+	cw, sw := math.Cos(arg), math.Sin(arg)
+	cn, sn := math.Cos(node), math.Sin(node)
+	ci, si := math.Cos(i), math.Sin(i)
+	x = (cw*cn-sw*sn*ci)*xp + (-sw*cn-cw*sn*ci)*yp
+	y = (cw*sn+sw*cn*ci)*xp + (-sw*sn+cw*cn*ci)*yp
+	z = sw*si*xp + cw*si*yp
+	return x, y, z
+}
+
+// Geocentric calculates the position of the planet at t as seen from the
+// centre of the earth, corrected for the time its light takes to reach us.
+func Geocentric(p Planet, t time.Time) (Position, error) {
+	if p < 0 || int(p) >= len(orbits) || p == earth {
+		return Position{}, ErrUnknownPlanet
+	}
+	if y := t.Year(); y < 1800 || y > 2050 {
+		return Position{}, ErrDateOutOfRange
+	}
+	return geocentric(p, ephemerisDate(t)), nil
+}
+
+// ephemerisDate converts t to a Julian ephemeris date (TT).
+func ephemerisDate(t time.Time) float64 {
+	return julianDate(t) + astrotime.DeltaT(t).Hours()/24
+}
+
+// geocentric calculates the apparent geocentric position of the planet, or
+// the sun for earth, at the Julian ephemeris date jde.
+func geocentric(p Planet, jde float64) Position {
+	tc := (jde - j2000) / 36525
+	ex, ey, ez := heliocentric(earth, tc)
+	var dx, dy, dz, dist float64
+	if p == earth {
+		dx, dy, dz = -ex, -ey, -ez
+		dist = math.Sqrt(dx*dx + dy*dy + dz*dz)
+	}
+	tau := 0.0
+	for i := 0; i < 3 && p != earth; i++ {
+		px, py, pz := heliocentric(p, tc-tau/36525)
+		dx, dy, dz = px-ex, py-ey, pz-ez
+		dist = math.Sqrt(dx*dx + dy*dy + dz*dz)
+		tau = lightTime * dist
+	}
+
+	// Referred to the equinox of date by the general precession in
+	// longitude.
+	lon := math.Mod(radToDeg*math.Atan2(dy, dx)+(5029.0966*tc+1.11113*tc*tc)/3600+720, 360)
+	lat := radToDeg * math.Asin(dz/dist)
+	eq := coords.Ecliptic{Lon: lon, Lat: lat}.Equatorial(coords.MeanObliquity(epoch.FromJulianDate(jde)))
+	return Position{Lon: lon, Lat: lat, RA: eq.RA, Dec: eq.Dec, Distance: dist}
+}
+
+// Topocentric calculates the azimuth of the planet at t, in degrees
+// clockwise from north, and its geometric altitude in degrees, as seen by
+// the observer, allowing for the planet's parallax.
+func Topocentric(p Planet, o astrotime.Observer, t time.Time) (azimuth, altitude float64, err error) {
+	pos, err := Geocentric(p, t)
+	if err != nil {
+		return math.NaN(), math.NaN(), err
+	}
+	azimuth, altitude, err = o.BodyPosition(pos.Body(), t)
+	if err != nil {
+		return math.NaN(), math.NaN(), err
+	}
+	return azimuth, altitude - solarParallax/pos.Distance*math.Cos(degToRad*altitude), nil
+}
+
+// julianDate converts t to a Julian date (UT).
+func julianDate(t time.Time) float64 {
+	return unixEpochJD + float64(t.UnixNano())/float64(24*time.Hour)
+}
@@ -0,0 +1,97 @@
+package planets
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestGeocentric(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 33.a: Venus on 1992
+	// December 20 at 0h TD, which was 59s earlier in UT.
+	at := time.Date(1992, 12, 19, 23, 59, 1, 0, time.UTC)
+	pos, err := Geocentric(Venus, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		got, want float64
+		tolerance float64
+	}{
+		{"longitude", pos.Lon, 313.08102, 0.01},
+		{"latitude", pos.Lat, -2.08474, 0.01},
+		{"right ascension", pos.RA, 316.17273, 0.01},
+		{"declination", pos.Dec, -18.88801, 0.01},
+		{"distance", pos.Distance, 0.910845, 0.001},
+	}
+	for _, tt := range tests {
+		if math.Abs(tt.got-tt.want) > tt.tolerance {
+			t.Errorf("%s: got %.5f, want %.5f", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestMercuryElongation(t *testing.T) {
+	// Mercury never strays more than about 28° from the sun.
+	for d := 0; d < 365; d += 5 {
+		at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, d)
+		pos, err := Geocentric(Mercury, at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ex, ey, _ := heliocentric(earth, (julianDate(at)-j2000)/36525)
+		sunLon := radToDeg * math.Atan2(-ey, -ex)
+		elong := math.Abs(math.Mod(pos.Lon-sunLon+540, 360) - 180)
+		if elong > 28.5 {
+			t.Errorf("%v: elongation %.1f°", at, elong)
+		}
+	}
+}
+
+func TestTopocentric(t *testing.T) {
+	o := astrotime.Observer{Lat: 51.4779, Lon: 0}
+	at := time.Date(2024, 12, 7, 22, 0, 0, 0, time.UTC)
+	// Jupiter was at opposition in Taurus, high in the south-east.
+	az, alt, err := Topocentric(Jupiter, o, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if az < 120 || az > 145 || alt < 48 || alt > 60 {
+		t.Errorf("got azimuth %.1f, altitude %.1f", az, alt)
+	}
+}
+
+func TestPositionString(t *testing.T) {
+	for _, tt := range []struct {
+		p    Position
+		want string
+	}{
+		{Position{RA: 174.03, Dec: 3.2, Distance: 1.41779}, "RA 11h36m07s, Dec +3°12′, 1.418 AU"},
+		{Position{RA: -0.001, Dec: -23.4999, Distance: 0.5}, "RA 0h00m00s, Dec -23°30′, 0.500 AU"},
+		{Position{RA: 359.99999, Dec: -0.01}, "RA 0h00m00s, Dec -0°01′, 0.000 AU"},
+	} {
+		if got := tt.p.String(); got != tt.want {
+			t.Errorf("RA %v, Dec %v: got %q, want %q", tt.p.RA, tt.p.Dec, got, tt.want)
+		}
+	}
+}
+
+func TestGeocentricErrors(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := Geocentric(earth, now); !errors.Is(err, ErrUnknownPlanet) {
+		t.Errorf("earth: got %v, want ErrUnknownPlanet", err)
+	}
+	if _, err := Geocentric(Planet(12), now); !errors.Is(err, ErrUnknownPlanet) {
+		t.Errorf("Planet(12): got %v, want ErrUnknownPlanet", err)
+	}
+	if _, err := Geocentric(Mars, time.Date(1700, 1, 1, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrDateOutOfRange) {
+		t.Errorf("1700: got %v, want ErrDateOutOfRange", err)
+	}
+	if got := Saturn.String(); got != "Saturn" {
+		t.Errorf("got %q, want Saturn", got)
+	}
+}
@@ -0,0 +1,135 @@
+package planets
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// ErrNotInferior is returned when greatest elongations are requested for a
+// planet other than Mercury or Venus.
+var ErrNotInferior = errors.New("planets: greatest elongation is only defined for Mercury and Venus")
+
+// Conjunction is the closest approach of two planets in the sky.
+type Conjunction struct {
+	Time time.Time
+
+	// Separation is the angle between the planets, in degrees.
+	Separation float64
+}
+
+// Elongation is a greatest elongation of Mercury or Venus, when it stands
+// farthest from the sun in the sky.
+type Elongation struct {
+	Time time.Time
+
+	// Angle is the separation from the sun, in degrees.
+	Angle float64
+
+	// East is set for an evening elongation, with the planet east of the
+	// sun, and clear for a morning one.
+	East bool
+}
+
+// Separation calculates the angle between two positions in the sky, in
+// degrees.
+func Separation(a, b Position) float64 {
+	d1, d2 := degToRad*a.Dec, degToRad*b.Dec
+	dra := degToRad * (a.RA - b.RA)
+	// The haversine form keeps its precision for small separations.
+	h := math.Pow(math.Sin((d2-d1)/2), 2) + math.Cos(d1)*math.Cos(d2)*math.Pow(math.Sin(dra/2), 2)
+	return 2 * radToDeg * math.Asin(math.Sqrt(math.Min(1, h)))
+}
+
+// Conjunctions finds the conjunctions of planets a and b in [start, end):
+// each time their separation in the sky passes through a minimum. Either
+// planet's close approaches to the sun are included.
+func Conjunctions(a, b Planet, start, end time.Time) ([]Conjunction, error) {
+	if err := checkSpan(start, end, a, b); err != nil {
+		return nil, err
+	}
+	sep := func(jde float64) float64 {
+		return Separation(geocentric(a, jde), geocentric(b, jde))
+	}
+	var cs []Conjunction
+	for _, m := range minima(sep, ephemerisDate(start), ephemerisDate(end)) {
+		cs = append(cs, Conjunction{Time: timeFromEphemerisDate(m).In(start.Location()), Separation: sep(m)})
+	}
+	return cs, nil
+}
+
+// GreatestElongations finds the greatest elongations of Mercury or Venus
+// from the sun in [start, end).
+func GreatestElongations(p Planet, start, end time.Time) ([]Elongation, error) {
+	if p != Mercury && p != Venus {
+		return nil, ErrNotInferior
+	}
+	if err := checkSpan(start, end, p); err != nil {
+		return nil, err
+	}
+	negElong := func(jde float64) float64 {
+		return -Separation(geocentric(p, jde), geocentric(earth, jde))
+	}
+	var es []Elongation
+	for _, m := range minima(negElong, ephemerisDate(start), ephemerisDate(end)) {
+		pos, sun := geocentric(p, m), geocentric(earth, m)
+		es = append(es, Elongation{
+			Time:  timeFromEphemerisDate(m).In(start.Location()),
+			Angle: -negElong(m),
+			East:  math.Mod(pos.Lon-sun.Lon+360, 360) < 180,
+		})
+	}
+	return es, nil
+}
+
+// checkSpan validates the planets and the dates of a search.
+func checkSpan(start, end time.Time, ps ...Planet) error {
+	for _, p := range ps {
+		if p < 0 || int(p) >= len(orbits) || p == earth {
+			return ErrUnknownPlanet
+		}
+	}
+	for _, t := range []time.Time{start, end} {
+		if y := t.Year(); y < 1800 || y > 2050 {
+			return ErrDateOutOfRange
+		}
+	}
+	return nil
+}
+
+// minima finds the local minima of f over [from, to), sampling it daily and
+// refining each by golden-section search to about a minute.
+func minima(f func(float64) float64, from, to float64) []float64 {
+	const step = 1.0
+	var ms []float64
+	prev, cur := f(from-step), f(from)
+	for x := from; x < to; x += step {
+		next := f(x + step)
+		if cur < prev && cur <= next {
+			lo, hi := x-step, x+step
+			const phi = 0.6180339887498949
+			for hi-lo > 1.0/1440 {
+				a := hi - phi*(hi-lo)
+				b := lo + phi*(hi-lo)
+				if f(a) < f(b) {
+					hi = b
+				} else {
+					lo = a
+				}
+			}
+			if m := (lo + hi) / 2; m >= from && m < to {
+				ms = append(ms, m)
+			}
+		}
+		prev, cur = cur, next
+	}
+	return ms
+}
+
+// timeFromEphemerisDate converts a Julian ephemeris date (TT) to a UTC Time.
+func timeFromEphemerisDate(jde float64) time.Time {
+	t := time.Unix(0, 0).UTC().Add(time.Duration((jde - unixEpochJD) * float64(24*time.Hour)))
+	return t.Add(-astrotime.DeltaT(t))
+}
@@ -0,0 +1,82 @@
+package planets
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func within(got, want time.Time, d time.Duration) bool {
+	return got.Sub(want).Abs() <= d
+}
+
+func TestConjunctions(t *testing.T) {
+	// The great conjunction of 2020 December 21, 6′ apart. The elements
+	// place the slow-moving pair within a day of it.
+	start := time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC)
+	cs, err := Conjunctions(Jupiter, Saturn, start, start.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != 1 {
+		t.Fatalf("got %v, want one conjunction", cs)
+	}
+	if want := time.Date(2020, 12, 21, 18, 0, 0, 0, time.UTC); !within(cs[0].Time, want, 24*time.Hour) {
+		t.Errorf("got %v, want %v", cs[0].Time, want)
+	}
+	if math.Abs(cs[0].Separation-0.1) > 0.05 {
+		t.Errorf("got separation %.3f°, want 0.1°", cs[0].Separation)
+	}
+}
+
+func TestGreatestElongations(t *testing.T) {
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	tests := []struct {
+		p    Planet
+		want []Elongation
+	}{
+		{Venus, []Elongation{
+			{time.Date(2020, 3, 24, 22, 0, 0, 0, time.UTC), 46.1, true},
+			{time.Date(2020, 8, 13, 0, 0, 0, 0, time.UTC), 45.8, false},
+		}},
+		{Mercury, []Elongation{
+			{time.Date(2020, 2, 10, 14, 0, 0, 0, time.UTC), 18.2, true},
+			{time.Date(2020, 3, 24, 2, 0, 0, 0, time.UTC), 27.8, false},
+			{time.Date(2020, 6, 4, 13, 0, 0, 0, time.UTC), 23.6, true},
+			{time.Date(2020, 7, 22, 15, 0, 0, 0, time.UTC), 20.1, false},
+			{time.Date(2020, 10, 1, 16, 0, 0, 0, time.UTC), 25.8, true},
+			{time.Date(2020, 11, 10, 17, 0, 0, 0, time.UTC), 19.1, false},
+		}},
+	}
+	for _, tt := range tests {
+		got, err := GreatestElongations(tt.p, start, end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%v: got %d elongations, want %d", tt.p, len(got), len(tt.want))
+			continue
+		}
+		for i, w := range tt.want {
+			g := got[i]
+			if !within(g.Time, w.Time, 12*time.Hour) || math.Abs(g.Angle-w.Angle) > 0.1 || g.East != w.East {
+				t.Errorf("%v: got %v %.1f° east %v, want %v %.1f° east %v", tt.p, g.Time, g.Angle, g.East, w.Time, w.Angle, w.East)
+			}
+		}
+	}
+
+	if _, err := GreatestElongations(Mars, start, end); !errors.Is(err, ErrNotInferior) {
+		t.Errorf("Mars: got %v, want ErrNotInferior", err)
+	}
+}
+
+func TestSeparation(t *testing.T) {
+	// Meeus example 17.a: Arcturus and Spica, 32.7930°.
+	a := Position{RA: 213.9154, Dec: 19.1825}
+	b := Position{RA: 201.2983, Dec: -11.1614}
+	if got := Separation(a, b); math.Abs(got-32.7930) > 1e-3 {
+		t.Errorf("got %.4f, want 32.7930", got)
+	}
+}
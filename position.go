@@ -0,0 +1,60 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// solarHourAngle calculates the hour angle of the sun, in degrees west of
+// the meridian, at the Julian date jd (UT) and longitude.
+func solarHourAngle(jd, longitude float64) float64 {
+	eqTime := equationOfTime(julianCentury(jd))
+	// Minutes since 0h UT, for the Julian day starting at noon.
+	utMinutes := math.Mod(jd+0.5, 1) * 1440
+	trueSolarTime := utMinutes + eqTime + 4*longitude
+	return math.Mod(trueSolarTime/4+720, 360) - 180
+}
+
+// SolarHourAngle calculates the hour angle of the sun at t as seen from the
+// longitude, in degrees from −180° to 180°: zero at solar noon, negative in
+// the morning and positive in the afternoon, increasing by 15° an hour. It
+// uses the same apparent solar time as the rise and set calculations.
+func SolarHourAngle(t time.Time, longitude float64) float64 {
+	return solarHourAngle(julianDate(t.UTC()), longitude)
+}
+
+// sunPosition calculates the azimuth, clockwise from north, and geometric
+// altitude of the sun in degrees at t for an observer at the latitude and
+// longitude.
+func sunPosition(t time.Time, latitude, longitude float64) (azimuth, altitude float64) {
+	jd := julianDate(t.UTC())
+	solarDec := solarDeclination(julianCentury(jd))
+	ha := degToRad * solarHourAngle(jd, longitude)
+
+	latRad := degToRad * latitude
+	sdRad := degToRad * solarDec
+	sinAlt := math.Sin(latRad)*math.Sin(sdRad) + math.Cos(latRad)*math.Cos(sdRad)*math.Cos(ha)
+	altitude = radToDeg * math.Asin(math.Max(-1, math.Min(1, sinAlt)))
+	return solarAzimuth(latitude, solarDec, ha), altitude
+}
+
+// SunEquatorial calculates the apparent geocentric right ascension and
+// declination of the sun at t, in degrees, referred to the true equator
+// and equinox of date and corrected for aberration, to about a second of
+// arc. Topocentric positions differ by the solar parallax, at most 8.8″.
+func SunEquatorial(t time.Time) (ra, dec float64) {
+	return sunApparent(ttFromUT(julianDate(t.UTC())))
+}
+
+// SubsolarPoint calculates the latitude and longitude, in degrees, of the
+// point on the earth where the sun stands at the zenith at t: the sun's
+// declination, and its Greenwich hour angle west turned into a longitude
+// east from −180° to 180°.
+func SubsolarPoint(t time.Time) (latitude, longitude float64) {
+	ra, dec := SunEquatorial(t)
+	lon := math.Mod(ra-GAST(t)+540, 360) - 180
+	if lon < -180 {
+		lon += 360
+	}
+	return dec, lon
+}
@@ -0,0 +1,73 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSolarHourAngle(t *testing.T) {
+	const lon = -77.0352
+	noon := solarNoon(time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), lon)
+	tests := []struct {
+		offset time.Duration
+		want   float64
+	}{
+		{0, 0},
+		{-2 * time.Hour, -30},
+		{3 * time.Hour, 45},
+		{12 * time.Hour, 180},
+	}
+	for _, tt := range tests {
+		got := SolarHourAngle(noon.Add(tt.offset), lon)
+		// ±180° are the same meridian.
+		if d := math.Mod(got-tt.want+540, 360) - 180; math.Abs(d) > 0.1 {
+			t.Errorf("%v from noon: got %.3f, want %.3f", tt.offset, got, tt.want)
+		}
+	}
+}
+
+func TestSunEquatorial(t *testing.T) {
+	// Meeus example 25.b at 1992 October 13.0 TD, less ΔT of about 59s.
+	ra, dec := SunEquatorial(time.Date(1992, 10, 12, 23, 59, 1, 0, time.UTC))
+	if math.Abs(ra-198.378178)*3600 > 0.1 || math.Abs(dec+7.783871)*3600 > 0.1 {
+		t.Errorf("got %.6f°, %.6f°, want 198.378178°, -7.783871°", ra, dec)
+	}
+	// The sun crosses the equator northward at the March equinox.
+	march, _, err := Equinoxes(2024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ra, dec := SunEquatorial(march); math.Abs(dec)*3600 > 30 || math.Min(ra, 360-ra)*3600 > 70 {
+		t.Errorf("got %.5f°, %.5f° at the equinox, want both near zero", ra, dec)
+	}
+}
+
+func TestSubsolarPoint(t *testing.T) {
+	tests := []struct {
+		t        time.Time
+		lat, lon float64
+	}{
+		// Near the June solstice the sun is overhead on the Tropic of
+		// Cancer, and at 12:00 UT close to Greenwich: with the equation of
+		// time at −1.7 minutes it has yet to cross, so it is east.
+		{p("2024-06-20T12:00:00Z"), 23.44, 0.43},
+		// Six hours later it is a quarter of the way round, to the west.
+		{p("2024-06-20T18:00:00Z"), 23.44, -89.56},
+		// At the September equinox, 12:44 UT, it crosses the equator, and
+		// the equation of time of +7.5 minutes puts it west of Greenwich.
+		{p("2024-09-22T12:00:00Z"), 0.01, -1.87},
+	}
+	for _, tt := range tests {
+		lat, lon := SubsolarPoint(tt.t)
+		if math.Abs(lat-tt.lat) > 0.01 || math.Abs(lon-tt.lon) > 0.01 {
+			t.Errorf("SubsolarPoint(%s) = %.2f, %.2f, want %.2f, %.2f", tt.t, lat, lon, tt.lat, tt.lon)
+		}
+	}
+	// The sun stands where its hour angle is zero.
+	at := p("2017-10-15T15:04:05Z")
+	_, lon := SubsolarPoint(at)
+	if ha := SolarHourAngle(at, lon); math.Abs(ha) > 0.1 {
+		t.Errorf("hour angle at the subsolar longitude %.2f is %.3f°, want 0", lon, ha)
+	}
+}
@@ -0,0 +1,140 @@
+// Package prayer calculates the times of the five daily Islamic prayers,
+// with the sunrise, by the conventions of the calculation authorities.
+//
+// Fajr begins when the sun's centre reaches the convention's Fajr angle
+// below the horizon before sunrise, Dhuhr at solar noon, Asr when the
+// shadow of an object is its length, or twice it for the Hanafi school,
+// beyond its shadow at noon, Maghrib at sunset and Isha when the sun
+// reaches the convention's Isha angle below the horizon after sunset, or a
+// fixed time after Maghrib for Umm al-Qura. Communities differ in all of
+// these, so a Method may be one of the presets or have any angles.
+//
+// Near and beyond the latitudes where twilight lasts all night in summer,
+// Fajr and Isha do not happen; the adjustments used there differ between
+// communities and are left to callers.
+package prayer
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// Method is a convention for calculating the prayer times.
+type Method struct {
+	Name string
+
+	// FajrAngle and IshaAngle are the depressions of the sun below the
+	// horizon, in degrees, at the beginning of Fajr and Isha.
+	FajrAngle, IshaAngle float64
+
+	// IshaInterval, if positive, sets Isha this long after Maghrib,
+	// instead of by IshaAngle.
+	IshaInterval time.Duration
+}
+
+// Preset conventions.
+var (
+	MWL       = Method{Name: "Muslim World League", FajrAngle: 18, IshaAngle: 17}
+	ISNA      = Method{Name: "Islamic Society of North America", FajrAngle: 15, IshaAngle: 15}
+	UmmAlQura = Method{Name: "Umm al-Qura University, Makkah", FajrAngle: 18.5, IshaInterval: 90 * time.Minute}
+	Egyptian  = Method{Name: "Egyptian General Authority of Survey", FajrAngle: 19.5, IshaAngle: 17.5}
+	Karachi   = Method{Name: "University of Islamic Sciences, Karachi", FajrAngle: 18, IshaAngle: 18}
+)
+
+// Methods are the preset conventions.
+var Methods = []Method{MWL, ISNA, UmmAlQura, Egyptian, Karachi}
+
+// Asr is the school of jurisprudence the time of Asr follows.
+type Asr int
+
+const (
+	// Shafii is the Shafi'i, Maliki and Hanbali reckoning of Asr, when the
+	// shadow of an object exceeds its noon shadow by its length.
+	Shafii Asr = iota
+	// Hanafi is the Hanafi reckoning, when the shadow exceeds the noon
+	// shadow by twice the length of the object.
+	Hanafi
+)
+
+var asrNames = [...]string{
+	Shafii: "Shafii",
+	Hanafi: "Hanafi",
+}
+
+func (a Asr) String() string {
+	if a < 0 || int(a) >= len(asrNames) {
+		return "Asr(" + strconv.Itoa(int(a)) + ")"
+	}
+	return asrNames[a]
+}
+
+// shadowFactor returns the length of the shadow beyond the noon shadow at
+// Asr, as a multiple of the object's height.
+func (a Asr) shadowFactor() float64 {
+	if a == Hanafi {
+		return 2
+	}
+	return 1
+}
+
+// Times are the prayer times of a day.
+type Times struct {
+	Fajr, Sunrise, Dhuhr, Asr, Maghrib, Isha time.Time
+}
+
+// Calculate calculates the prayer times seen by o on the day t by the method
+// and the school of Asr. Times which do not happen that day, as Fajr and
+// Isha in the summer at high latitudes, are zero, and the error is that of
+// the first of them, ErrAlwaysAbove or ErrAlwaysBelow; the others are
+// still calculated.
+func Calculate(o astrotime.Observer, t time.Time, m Method, asr Asr) (Times, error) {
+	var (
+		times Times
+		first error
+	)
+	keep := func(field *time.Time, s time.Time, err error) {
+		if err != nil {
+			if first == nil {
+				first = err
+			}
+			return
+		}
+		*field = s
+	}
+
+	noon, err := o.EventTime(t, astrotime.EventSolarNoon)
+	if err != nil {
+		return Times{}, err
+	}
+	times.Dhuhr = noon
+	s, err := o.AltitudeRising(t, -m.FajrAngle)
+	keep(&times.Fajr, s, err)
+	s, err = o.Sunrise(t)
+	keep(&times.Sunrise, s, err)
+	s, err = o.AltitudeSetting(t, asrAltitude(o.Lat, noon, asr))
+	keep(&times.Asr, s, err)
+	s, err = o.Sunset(t)
+	keep(&times.Maghrib, s, err)
+	if m.IshaInterval > 0 {
+		if !times.Maghrib.IsZero() {
+			times.Isha = times.Maghrib.Add(m.IshaInterval)
+		}
+	} else {
+		s, err = o.AltitudeSetting(t, -m.IshaAngle)
+		keep(&times.Isha, s, err)
+	}
+	return times, first
+}
+
+// asrAltitude returns the altitude of the sun, in degrees, at Asr on the
+// day of noon at the latitude.
+func asrAltitude(latitude float64, noon time.Time, asr Asr) float64 {
+	_, dec := astrotime.SunEquatorial(noon)
+	// At noon the sun's zenith distance is |φ − δ|, so the noon shadow of
+	// an object of unit height is tan |φ − δ|.
+	noonShadow := math.Tan(math.Abs(latitude-dec) * math.Pi / 180)
+	return math.Atan(1/(asr.shadowFactor()+noonShadow)) * 180 / math.Pi
+}
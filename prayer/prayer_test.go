@@ -0,0 +1,97 @@
+package prayer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestCalculate(t *testing.T) {
+	mecca := time.FixedZone("AST", 3*3600)
+	o := astrotime.Observer{Lat: 21.4225, Lon: 39.8262, Location: mecca}
+	day := time.Date(2024, 3, 20, 0, 0, 0, 0, mecca)
+	got, err := Calculate(o, day, UmmAlQura, Shafii)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Makkah on 20 March 2024 by Umm al-Qura, to the minute.
+	for _, tt := range []struct {
+		name string
+		got  time.Time
+		want string
+	}{
+		{"Fajr", got.Fajr, "05:08"},
+		{"Sunrise", got.Sunrise, "06:24"},
+		{"Dhuhr", got.Dhuhr, "12:28"},
+		{"Asr", got.Asr, "15:52"},
+		{"Maghrib", got.Maghrib, "18:31"},
+		{"Isha", got.Isha, "20:01"},
+	} {
+		if s := tt.got.Format("15:04"); s != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, s, tt.want)
+		}
+	}
+}
+
+func TestCalculateConventions(t *testing.T) {
+	o := astrotime.Observer{Lat: 51.5074, Lon: -0.1278}
+	day := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+	times := map[string]Times{}
+	for _, m := range Methods {
+		got, err := Calculate(o, day, m, Shafii)
+		if err != nil {
+			t.Fatalf("%s: %v", m.Name, err)
+		}
+		times[m.Name] = got
+	}
+	// A deeper Fajr angle is earlier; a deeper Isha angle is later.
+	if !times[Egyptian.Name].Fajr.Before(times[MWL.Name].Fajr) || !times[MWL.Name].Fajr.Before(times[ISNA.Name].Fajr) {
+		t.Error("Fajr is not ordered by angle")
+	}
+	if !times[ISNA.Name].Isha.Before(times[MWL.Name].Isha) || !times[MWL.Name].Isha.Before(times[Karachi.Name].Isha) {
+		t.Error("Isha is not ordered by angle")
+	}
+	if d := times[UmmAlQura.Name].Isha.Sub(times[UmmAlQura.Name].Maghrib); d != 90*time.Minute {
+		t.Errorf("Umm al-Qura Isha is %v after Maghrib, want 1h30m", d)
+	}
+
+	custom, err := Calculate(o, day, Method{FajrAngle: 12, IshaAngle: 12}, Shafii)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nautical, _ := o.EventTime(day, astrotime.EventNauticalDawn)
+	if !custom.Fajr.Equal(nautical) {
+		t.Errorf("got Fajr %v at 12°, want nautical dawn %v", custom.Fajr, nautical)
+	}
+
+	hanafi, err := Calculate(o, day, MWL, Hanafi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := hanafi.Asr.Sub(times[MWL.Name].Asr); d < 30*time.Minute || d > 90*time.Minute {
+		t.Errorf("Hanafi Asr is %v after Shafi'i, want about an hour", d)
+	}
+}
+
+func TestCalculateHighLatitude(t *testing.T) {
+	o := astrotime.Observer{Lat: 59.3293, Lon: 18.0686}
+	day := time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC)
+	got, err := Calculate(o, day, MWL, Shafii)
+	if !errors.Is(err, astrotime.ErrAlwaysAbove) {
+		t.Errorf("got %v, want ErrAlwaysAbove", err)
+	}
+	if !got.Fajr.IsZero() || !got.Isha.IsZero() {
+		t.Errorf("got Fajr %v, Isha %v, want none", got.Fajr, got.Isha)
+	}
+	if got.Sunrise.IsZero() || got.Asr.IsZero() || got.Maghrib.IsZero() {
+		t.Errorf("got %+v, want the other times", got)
+	}
+}
+
+func TestAsrString(t *testing.T) {
+	if Hanafi.String() != "Hanafi" || Asr(5).String() != "Asr(5)" {
+		t.Errorf("got %v, %v", Hanafi, Asr(5))
+	}
+}
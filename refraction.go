@@ -0,0 +1,37 @@
+package astrotime
+
+const (
+	// standardPressure and standardTemperature are the conditions, in
+	// millibars and degrees Celsius, for which standardRefraction holds.
+	standardPressure    = 1010.0
+	standardTemperature = 10.0
+)
+
+// horizonRefraction calculates the atmospheric refraction at the horizon, in
+// degrees, for the pressure in millibars and temperature in degrees Celsius.
+func horizonRefraction(pressure, temperature float64) float64 {
+	return standardRefraction * (pressure / standardPressure) * ((273 + standardTemperature) / (273 + temperature))
+}
+
+// WithAtmosphere scales the refraction at the horizon for the local
+// pressure, in millibars, and temperature, in degrees Celsius, instead of
+// assuming 1010 mb and 10 °C.
+func WithAtmosphere(pressure, temperature float64) Option {
+	return func(o *Observer) {
+		o.pressure = pressure
+		o.temperature = temperature
+		o.atmosphere = true
+	}
+}
+
+// refraction returns the atmospheric refraction at the horizon for the
+// observer, in degrees.
+func (o Observer) refraction() float64 {
+	switch {
+	case o.noRefraction:
+		return 0
+	case o.atmosphere:
+		return horizonRefraction(o.pressure, o.temperature)
+	}
+	return standardRefraction
+}
@@ -0,0 +1,36 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHorizonRefraction(t *testing.T) {
+	tests := []struct {
+		pressure, temperature, want float64
+	}{
+		{1010, 10, 34.0 / 60},
+		{700, -20, 0.4393},
+		{1030, 30, 0.5397},
+	}
+	for _, tt := range tests {
+		if got := horizonRefraction(tt.pressure, tt.temperature); math.Abs(got-tt.want) > 1e-4 {
+			t.Errorf("horizonRefraction(%v, %v) = %.4f, want %.4f", tt.pressure, tt.temperature, got, tt.want)
+		}
+	}
+}
+
+func TestWithAtmosphere(t *testing.T) {
+	d := places["ulanBator"].times[2]
+	o := NewObserver(47.8864, 106.9057, WithAtmosphere(1010, 10))
+	if got := sunriseOn(t, o, d.day); got != d.sunrise {
+		t.Errorf("got sunrise %s at standard conditions, want %s", got, d.sunrise)
+	}
+	thin := NewObserver(47.8864, 106.9057, WithAtmosphere(700, 10))
+	if got := sunriseOn(t, thin, d.day); !got.After(d.sunrise) {
+		t.Errorf("got sunrise %s in thin air, want after %s", got, d.sunrise)
+	}
+	if z := NewObserver(0, 0, WithAtmosphere(700, 10), WithRefraction(false)).zenith(); z != zenithOfficial-standardRefraction {
+		t.Errorf("got zenith %v with refraction disabled, want %v", z, zenithOfficial-standardRefraction)
+	}
+}
@@ -0,0 +1,135 @@
+package astrotime
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// A Scheduler runs callbacks at times offset from solar events, for any
+// number of observers. The zero value is ready to use. Like a SunTicker it
+// follows the wall clock, so that it copes with clock changes and with the
+// process being suspended.
+type Scheduler struct {
+	// Jitter, if positive, delays each callback by a random duration below
+	// it, to spread load when many schedulers share an event.
+	Jitter time.Duration
+
+	// MaxDelay is how late a callback may still be run, after its time
+	// was missed while the machine was asleep or the clock jumped
+	// forward. Occurrences missed by longer are skipped. Zero means a
+	// missed callback is always run once when the scheduler notices.
+	MaxDelay time.Duration
+
+	mu   sync.Mutex
+	jobs []*job
+	wake chan struct{}
+
+	// now and poll replace the wall clock and polling interval in tests.
+	now  func() time.Time
+	poll time.Duration
+}
+
+// job is a callback registered with a Scheduler.
+type job struct {
+	o      Observer
+	kind   EventKind
+	offset time.Duration
+	fn     func(Event)
+
+	next Event // the occurrence to run for, or zero to be found
+}
+
+// Add registers fn to be called at offset from each occurrence of kind seen
+// by o. The callback receives the occurrence of the event, not including
+// the offset. Add may be called while the scheduler is running.
+func (s *Scheduler) Add(o Observer, kind EventKind, offset time.Duration, fn func(Event)) error {
+	if _, err := o.EventTime(s.clock(), kind); err != nil && !isNoEvent(err) {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs = append(s.jobs, &job{o: o, kind: kind, offset: offset, fn: fn})
+	if s.wake != nil {
+		select {
+		case s.wake <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Run runs the registered callbacks, each in its own goroutine, until ctx is
+// done. It then waits for running callbacks to return and returns
+// ctx.Err(); callbacks still waiting out their jitter are abandoned.
+func (s *Scheduler) Run(ctx context.Context) error {
+	poll := s.poll
+	if poll <= 0 {
+		poll = tickerPoll
+	}
+	s.mu.Lock()
+	s.wake = make(chan struct{}, 1)
+	s.mu.Unlock()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		case <-s.wake:
+			timer.Stop()
+		}
+
+		now := s.clock()
+		wait := poll
+		s.mu.Lock()
+		for _, j := range s.jobs {
+			if !j.next.Time.IsZero() && !now.Before(j.next.Time.Add(j.offset)) {
+				if late := now.Sub(j.next.Time.Add(j.offset)); s.MaxDelay == 0 || late <= s.MaxDelay {
+					wg.Add(1)
+					go s.fire(ctx, &wg, j.fn, j.next)
+				}
+				j.next = Event{}
+			}
+			if j.next.Time.IsZero() {
+				t, err := j.o.NextEventAt(now, j.kind, j.offset)
+				if err != nil {
+					// Nothing within a year; look again later.
+					continue
+				}
+				j.next = Event{Kind: j.kind, Time: t.Add(-j.offset)}
+			}
+			if d := j.next.Time.Add(j.offset).Sub(now); d < wait {
+				wait = d
+			}
+		}
+		s.mu.Unlock()
+		timer.Reset(wait)
+	}
+}
+
+// fire calls fn for ev after any jitter.
+func (s *Scheduler) fire(ctx context.Context, wg *sync.WaitGroup, fn func(Event), ev Event) {
+	defer wg.Done()
+	if s.Jitter > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(rand.Int63n(int64(s.Jitter)))):
+		}
+	}
+	fn(ev)
+}
+
+func (s *Scheduler) clock() time.Time {
+	if s.now != nil {
+		return s.now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,75 @@
+package astrotime
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestScheduler(t *testing.T) {
+	d := places["manila"].times[0]
+	o := Observer{Lat: 14.5995, Lon: 120.9842}
+	clock := &fakeClock{t: d.sunset.Add(-time.Hour)}
+	s := &Scheduler{now: clock.now, poll: time.Millisecond}
+
+	var mu sync.Mutex
+	var got []Event
+	record := func(ev Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, ev)
+	}
+	if err := s.Add(o, EventSunset, -30*time.Minute, record); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Add(Observer{Lat: 95}, EventSunset, 0, record); err == nil {
+		t.Error("got no error adding an invalid observer")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- s.Run(ctx) }()
+
+	time.Sleep(20 * time.Millisecond)
+	clock.set(d.sunset.Add(-29 * time.Minute))
+	time.Sleep(20 * time.Millisecond)
+	// Skip ahead two days, as if the machine slept; one callback catches up.
+	clock.set(d.sunset.Add(48 * time.Hour))
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("got Run error %v, want context.Canceled", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(got) != 2 {
+		t.Fatalf("got %d callbacks, want 2", len(got))
+	}
+	if got[0].Kind != EventSunset || got[0].Time.Sub(d.sunset).Abs() > time.Minute {
+		t.Errorf("got callback for %v at %s, want sunset at %s", got[0].Kind, got[0].Time, d.sunset)
+	}
+}
+
+func TestSchedulerMaxDelay(t *testing.T) {
+	d := places["manila"].times[0]
+	clock := &fakeClock{t: d.sunset.Add(-time.Hour)}
+	s := &Scheduler{MaxDelay: time.Minute, now: clock.now, poll: time.Millisecond}
+	calls := make(chan Event, 10)
+	if err := s.Add(Observer{Lat: 14.5995, Lon: 120.9842}, EventSunset, 0, func(ev Event) { calls <- ev }); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Run(ctx)
+
+	time.Sleep(20 * time.Millisecond)
+	clock.set(d.sunset.Add(2 * time.Hour))
+	time.Sleep(20 * time.Millisecond)
+	select {
+	case ev := <-calls:
+		t.Errorf("got callback for %s missed by two hours, want it skipped", ev.Time)
+	default:
+	}
+}
@@ -0,0 +1,174 @@
+package astrotime
+
+import (
+	"math"
+	"strconv"
+	"time"
+)
+
+// Mean equinoxes and solstices as polynomials in Y, in Julian ephemeris
+// days (Meeus, Astronomical Algorithms, tables 27.A and 27.B), in the order
+// March, June, September, December.
+var (
+	// meanSeasonsBCE covers years −1000 to 1000, with Y = year/1000.
+	meanSeasonsBCE = [4][5]float64{
+		{1721139.29189, 365242.13740, 0.06134, 0.00111, -0.00071},
+		{1721233.25401, 365241.72562, -0.05323, 0.00907, 0.00025},
+		{1721325.70455, 365242.49558, -0.11677, -0.00297, 0.00074},
+		{1721414.39987, 365242.88257, -0.00769, -0.00933, -0.00006},
+	}
+	// meanSeasonsCE covers years 1000 to 3000, with Y = (year−2000)/1000.
+	meanSeasonsCE = [4][5]float64{
+		{2451623.80984, 365242.37404, 0.05169, -0.00411, -0.00057},
+		{2451716.56767, 365241.62603, 0.00325, 0.00888, -0.00030},
+		{2451810.21715, 365242.01767, -0.11575, 0.00337, 0.00078},
+		{2451900.05952, 365242.74049, -0.06223, -0.00823, 0.00032},
+	}
+)
+
+// Periodic terms correcting the mean equinoxes and solstices (Meeus, table
+// 27.C): the amplitude A, and B and C of the argument B + CT, in degrees.
+var seasonTerms = [...][3]float64{
+	{485, 324.96, 1934.136},
+	{203, 337.23, 32964.467},
+	{199, 342.08, 20.186},
+	{182, 27.85, 445267.112},
+	{156, 73.14, 45036.886},
+	{136, 171.52, 22518.443},
+	{77, 222.54, 65928.934},
+	{74, 296.72, 3034.906},
+	{70, 243.58, 9037.513},
+	{58, 119.81, 33718.147},
+	{52, 297.17, 150.678},
+	{50, 21.02, 2281.226},
+	{45, 247.54, 29929.562},
+	{44, 325.15, 31555.956},
+	{29, 60.93, 4443.417},
+	{18, 155.12, 67555.328},
+	{17, 288.79, 4562.452},
+	{16, 198.04, 62894.029},
+	{14, 199.76, 31436.921},
+	{12, 95.39, 14577.848},
+	{12, 287.11, 31931.756},
+	{12, 320.81, 34777.259},
+	{9, 227.73, 1222.114},
+	{8, 15.45, 16859.074},
+}
+
+// minSeasonYear is the first year the series of Meeus chapter 27 cover.
+const minSeasonYear = -1000
+
+// season calculates the Julian ephemeris date of an equinox or solstice:
+// i is 0 for March, 1 for June, 2 for September and 3 for December.
+func season(year, i int) float64 {
+	coeffs, y := meanSeasonsCE[i], float64(year-2000)/1000
+	if year < 1000 {
+		coeffs, y = meanSeasonsBCE[i], float64(year)/1000
+	}
+	jde0 := coeffs[0] + y*(coeffs[1]+y*(coeffs[2]+y*(coeffs[3]+y*coeffs[4])))
+
+	t := julianCentury(jde0)
+	w := degToRad * (35999.373*t - 2.47)
+	dl := 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+	var s float64
+	for _, term := range seasonTerms {
+		s += term[0] * math.Cos(degToRad*(term[1]+term[2]*t))
+	}
+	return jde0 + 0.00001*s/dl
+}
+
+// seasonTimes returns the UTC instants of two of the year's equinoxes and
+// solstices.
+func seasonTimes(year, i, j int) (time.Time, time.Time, error) {
+	if year < minSeasonYear || year > MaxYear {
+		return time.Time{}, time.Time{}, ErrDateOutOfRange
+	}
+	return timeFromJulianDate(utFromTT(season(year, i))), timeFromJulianDate(utFromTT(season(year, j))), nil
+}
+
+// Equinoxes calculates the instants of the March and September equinoxes of
+// the year, when the sun's apparent longitude is 0° and 180°, to within
+// about a minute. The years −1000 to 3000 are supported.
+func Equinoxes(year int) (march, september time.Time, err error) {
+	return seasonTimes(year, 0, 2)
+}
+
+// Solstices calculates the instants of the June and December solstices of
+// the year, when the sun's apparent longitude is 90° and 270°. The years
+// −1000 to 3000 are supported.
+func Solstices(year int) (june, december time.Time, err error) {
+	return seasonTimes(year, 1, 3)
+}
+
+// SeasonName names a season of the year.
+type SeasonName int
+
+// The seasons, in the order they occur.
+const (
+	Spring SeasonName = iota
+	Summer
+	Autumn
+	Winter
+)
+
+var seasonNames = [...]string{
+	Spring: "Spring",
+	Summer: "Summer",
+	Autumn: "Autumn",
+	Winter: "Winter",
+}
+
+func (s SeasonName) String() string {
+	if s < 0 || int(s) >= len(seasonNames) {
+		return "SeasonName(" + strconv.Itoa(int(s)) + ")"
+	}
+	return seasonNames[s]
+}
+
+// hemisphere turns a northern-hemisphere season into the season at the
+// latitude: south of the equator, the seasons are reversed.
+func hemisphere(s SeasonName, latitude float64) SeasonName {
+	if latitude < 0 {
+		return (s + 2) % 4
+	}
+	return s
+}
+
+// Season returns the astronomical season at t at the latitude. In the
+// northern hemisphere spring runs from the March equinox to the June
+// solstice, and so on through the year; south of the equator the seasons
+// are reversed. The equator counts as northern.
+func Season(t time.Time, latitude float64) (SeasonName, error) {
+	if err := ValidateCoordinates(latitude, 0); err != nil {
+		return Spring, err
+	}
+	march, september, err := Equinoxes(t.Year())
+	if err != nil {
+		return Spring, err
+	}
+	june, december, _ := Solstices(t.Year())
+	s := Winter
+	switch {
+	case !t.Before(december):
+	case !t.Before(september):
+		s = Autumn
+	case !t.Before(june):
+		s = Summer
+	case !t.Before(march):
+		s = Spring
+	}
+	return hemisphere(s, latitude), nil
+}
+
+// MeteorologicalSeason returns the meteorological season at t at the
+// latitude, which divides the year into whole months by the calendar of
+// t's location: in the northern hemisphere, spring is March to May, summer
+// June to August, autumn September to November and winter December to
+// February.
+func MeteorologicalSeason(t time.Time, latitude float64) (SeasonName, error) {
+	if err := ValidateCoordinates(latitude, 0); err != nil {
+		return Spring, err
+	}
+	s := SeasonName((int(t.Month())/3 + 3) % 4)
+	return hemisphere(s, latitude), nil
+}
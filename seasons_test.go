@@ -0,0 +1,106 @@
+package astrotime
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSeasonSeries(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 27.a: the June solstice of
+	// 1962.
+	if got := season(1962, 1); math.Abs(got-2437837.39245) > 1e-5 {
+		t.Errorf("got %.5f, want 2437837.39245", got)
+	}
+}
+
+func TestEquinoxesSolstices(t *testing.T) {
+	tests := []struct {
+		year                             int
+		march, june, september, december time.Time
+	}{
+		{2024,
+			time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC),
+			time.Date(2024, 6, 20, 20, 51, 0, 0, time.UTC),
+			time.Date(2024, 9, 22, 12, 44, 0, 0, time.UTC),
+			time.Date(2024, 12, 21, 9, 20, 0, 0, time.UTC)},
+		{2000,
+			time.Date(2000, 3, 20, 7, 35, 0, 0, time.UTC),
+			time.Date(2000, 6, 21, 1, 48, 0, 0, time.UTC),
+			time.Date(2000, 9, 22, 17, 27, 0, 0, time.UTC),
+			time.Date(2000, 12, 21, 13, 37, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		march, september, err := Equinoxes(tt.year)
+		if err != nil {
+			t.Fatal(err)
+		}
+		june, december, err := Solstices(tt.year)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range []struct{ got, want time.Time }{
+			{march, tt.march}, {june, tt.june}, {september, tt.september}, {december, tt.december},
+		} {
+			if d := c.got.Sub(c.want); d.Abs() > 2*time.Minute {
+				t.Errorf("got %v, want %v", c.got, c.want)
+			}
+		}
+	}
+
+	if _, _, err := Equinoxes(-1500); !errors.Is(err, ErrDateOutOfRange) {
+		t.Errorf("got %v, want ErrDateOutOfRange", err)
+	}
+	if _, _, err := Solstices(-500); err != nil {
+		t.Errorf("-500: %v", err)
+	}
+}
+
+func TestSeason(t *testing.T) {
+	tests := []struct {
+		t        time.Time
+		latitude float64
+		want     SeasonName
+	}{
+		{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), 51.5, Winter},
+		{time.Date(2024, 3, 20, 3, 0, 0, 0, time.UTC), 51.5, Winter},
+		{time.Date(2024, 3, 20, 3, 10, 0, 0, time.UTC), 51.5, Spring},
+		{time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), 51.5, Summer},
+		{time.Date(2024, 9, 22, 13, 0, 0, 0, time.UTC), 51.5, Autumn},
+		{time.Date(2024, 12, 21, 10, 0, 0, 0, time.UTC), 51.5, Winter},
+		{time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), -33.9, Summer},
+		{time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), -33.9, Winter},
+		{time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), 0, Spring},
+	}
+	for _, tt := range tests {
+		got, err := Season(tt.t, tt.latitude)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Season(%v, %v) = %v, want %v", tt.t, tt.latitude, got, tt.want)
+		}
+	}
+	if _, err := Season(time.Now(), 100); !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("got %v, want ErrInvalidCoordinates", err)
+	}
+}
+
+func TestMeteorologicalSeason(t *testing.T) {
+	want := []SeasonName{Winter, Winter, Spring, Spring, Spring, Summer, Summer, Summer, Autumn, Autumn, Autumn, Winter}
+	for m, w := range want {
+		at := time.Date(2024, time.Month(m+1), 10, 0, 0, 0, 0, time.UTC)
+		if got, err := MeteorologicalSeason(at, 40); err != nil || got != w {
+			t.Errorf("%v north: got %v, %v, want %v", at.Month(), got, err, w)
+		}
+		if got, err := MeteorologicalSeason(at, -40); err != nil || got != (w+2)%4 {
+			t.Errorf("%v south: got %v, %v, want %v", at.Month(), got, err, (w+2)%4)
+		}
+	}
+	for _, latitude := range []float64{math.NaN(), 200, -200} {
+		if _, err := MeteorologicalSeason(time.Now(), latitude); !errors.Is(err, ErrInvalidCoordinates) {
+			t.Errorf("latitude %v: got %v, want ErrInvalidCoordinates", latitude, err)
+		}
+	}
+}
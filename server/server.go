@@ -0,0 +1,190 @@
+// Package server serves the sun's daily events over HTTP in the format of
+// the sunrise-sunset.org API, so that clients written against it can use
+// the library instead:
+//
+//	http.Handle("/sunrise", server.NewHandler())
+//
+// A request such as
+//
+//	GET /sunrise?lat=51.4769&lng=-0.0005&date=2024-06-20&formatted=0&tzid=Europe/London
+//
+// takes the latitude and longitude in degrees, east positive, both
+// required; the date as 2006-01-02 or "today", the default; formatted=0 for
+// RFC 3339 times and a day length in seconds rather than 12-hour clock
+// times and a day length as 15:04:05; and tzid, the IANA time zone the date
+// is read and times are given in, UTC by default. The response is
+//
+//	{
+//	  "results": {
+//	    "sunrise": "2024-06-20T04:42:38+01:00",
+//	    "sunset": "2024-06-20T21:20:48+01:00",
+//	    "solar_noon": "2024-06-20T13:01:36+01:00",
+//	    "day_length": 59890,
+//	    "civil_twilight_begin": ...,
+//	    "civil_twilight_end": ...,
+//	    "nautical_twilight_begin": ...,
+//	    "nautical_twilight_end": ...,
+//	    "astronomical_twilight_begin": ...,
+//	    "astronomical_twilight_end": ...
+//	  },
+//	  "status": "OK",
+//	  "tzid": "Europe/London"
+//	}
+//
+// As with sunrise-sunset.org, an event which does not happen that day is
+// given as the first second of 1970 in UTC, and an invalid request is
+// answered with an empty results string and a status of INVALID_REQUEST,
+// INVALID_DATE or INVALID_TZID. JSONP callbacks are not supported.
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/dntj/astrotime"
+	"github.com/dntj/astrotime/table"
+)
+
+// Statuses of a response.
+const (
+	StatusOK             = "OK"
+	StatusInvalidRequest = "INVALID_REQUEST"
+	StatusInvalidDate    = "INVALID_DATE"
+	StatusInvalidTZID    = "INVALID_TZID"
+	StatusUnknownError   = "UNKNOWN_ERROR"
+)
+
+// noEvent stands for an event which does not happen that day.
+var noEvent = time.Date(1970, time.January, 1, 0, 0, 1, 0, time.UTC)
+
+// Handler answers requests for the sun's events of a day.
+type Handler struct {
+	opts []astrotime.Option
+	now  func() time.Time
+}
+
+// NewHandler returns a Handler calculating with observers configured by
+// opts, such as astrotime.WithAlgorithm.
+func NewHandler(opts ...astrotime.Option) *Handler {
+	return &Handler{opts: opts, now: time.Now}
+}
+
+// response is the body of a response. Results is a results, or the empty
+// string if the request failed.
+type response struct {
+	Results any    `json:"results"`
+	Status  string `json:"status"`
+	TZID    string `json:"tzid,omitempty"`
+}
+
+// results are the events of the day, as strings, and the day length, as a
+// string or a number of seconds.
+type results struct {
+	Sunrise                   string `json:"sunrise"`
+	Sunset                    string `json:"sunset"`
+	SolarNoon                 string `json:"solar_noon"`
+	DayLength                 any    `json:"day_length"`
+	CivilTwilightBegin        string `json:"civil_twilight_begin"`
+	CivilTwilightEnd          string `json:"civil_twilight_end"`
+	NauticalTwilightBegin     string `json:"nautical_twilight_begin"`
+	NauticalTwilightEnd       string `json:"nautical_twilight_end"`
+	AstronomicalTwilightBegin string `json:"astronomical_twilight_begin"`
+	AstronomicalTwilightEnd   string `json:"astronomical_twilight_end"`
+}
+
+// ServeHTTP answers GET and HEAD requests.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	resp, code := h.respond(r)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(resp)
+}
+
+// respond returns the response to r and its HTTP status code.
+func (h *Handler) respond(r *http.Request) (response, int) {
+	q := r.URL.Query()
+	fail := func(status string) (response, int) {
+		return response{Results: "", Status: status}, http.StatusBadRequest
+	}
+
+	lat, err := strconv.ParseFloat(q.Get("lat"), 64)
+	if err != nil {
+		return fail(StatusInvalidRequest)
+	}
+	lon, err := strconv.ParseFloat(q.Get("lng"), 64)
+	if err != nil || astrotime.ValidateCoordinates(lat, lon) != nil {
+		return fail(StatusInvalidRequest)
+	}
+	loc := time.UTC
+	if tzid := q.Get("tzid"); tzid != "" {
+		if loc, err = time.LoadLocation(tzid); err != nil {
+			return fail(StatusInvalidTZID)
+		}
+	}
+	var day time.Time
+	switch date := q.Get("date"); date {
+	case "", "today":
+		y, m, d := h.now().In(loc).Date()
+		day = time.Date(y, m, d, 0, 0, 0, 0, loc)
+	default:
+		if day, err = time.ParseInLocation("2006-01-02", date, loc); err != nil {
+			return fail(StatusInvalidDate)
+		}
+	}
+	formatted := q.Get("formatted") != "0"
+
+	o := astrotime.NewObserver(lat, lon, h.opts...)
+	o.Location = loc
+	var row table.Row
+	if err := table.Generate(o, day, day, func(r table.Row) error { row = r; return nil }); err != nil {
+		switch {
+		case errors.Is(err, astrotime.ErrDateOutOfRange):
+			return fail(StatusInvalidDate)
+		case errors.Is(err, astrotime.ErrInvalidCoordinates):
+			return fail(StatusInvalidRequest)
+		}
+		return response{Results: "", Status: StatusUnknownError}, http.StatusInternalServerError
+	}
+
+	event := func(kind astrotime.EventKind) string {
+		t, err := row.Time(kind)
+		if err != nil {
+			t = noEvent
+		} else {
+			t = t.In(loc)
+		}
+		if formatted {
+			return t.Format("3:04:05 PM")
+		}
+		return t.Format("2006-01-02T15:04:05-07:00")
+	}
+	res := results{
+		Sunrise:                   event(astrotime.EventSunrise),
+		Sunset:                    event(astrotime.EventSunset),
+		SolarNoon:                 event(astrotime.EventSolarNoon),
+		CivilTwilightBegin:        event(astrotime.EventCivilDawn),
+		CivilTwilightEnd:          event(astrotime.EventCivilDusk),
+		NauticalTwilightBegin:     event(astrotime.EventNauticalDawn),
+		NauticalTwilightEnd:       event(astrotime.EventNauticalDusk),
+		AstronomicalTwilightBegin: event(astrotime.EventAstronomicalDawn),
+		AstronomicalTwilightEnd:   event(astrotime.EventAstronomicalDusk),
+	}
+	d := row.DayLength()
+	if formatted {
+		s := int(d.Seconds())
+		res.DayLength = fmt.Sprintf("%d:%02d:%02d", s/3600, s/60%60, s%60)
+	} else {
+		res.DayLength = int(d.Seconds())
+	}
+	return response{Results: res, Status: StatusOK, TZID: loc.String()}, http.StatusOK
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// get requests the query from h and decodes the response.
+func get(t *testing.T, h http.Handler, query string) (int, map[string]any) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/sunrise?"+query, nil))
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("got Content-Type %q", ct)
+	}
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("%v in %s", err, rec.Body.String())
+	}
+	return rec.Code, body
+}
+
+func TestHandler(t *testing.T) {
+	h := NewHandler()
+	code, body := get(t, h, "lat=51.4769&lng=-0.0005&date=2024-06-20&formatted=0&tzid=Europe/London")
+	if code != http.StatusOK || body["status"] != "OK" || body["tzid"] != "Europe/London" {
+		t.Fatalf("got %d %v", code, body)
+	}
+	res := body["results"].(map[string]any)
+	for key, want := range map[string]any{
+		"sunrise":                     "2024-06-20T04:42:38+01:00",
+		"sunset":                      "2024-06-20T21:20:48+01:00",
+		"solar_noon":                  "2024-06-20T13:01:36+01:00",
+		"day_length":                  59890.0,
+		"civil_twilight_begin":        "2024-06-20T03:54:56+01:00",
+		"nautical_twilight_end":       "2024-06-20T23:22:57+01:00",
+		"astronomical_twilight_begin": "1970-01-01T00:00:01+00:00",
+	} {
+		if res[key] != want {
+			t.Errorf("%s: got %v, want %v", key, res[key], want)
+		}
+	}
+	if len(res) != 10 {
+		t.Errorf("got %d results, want 10", len(res))
+	}
+}
+
+func TestHandlerFormatted(t *testing.T) {
+	h := NewHandler()
+	h.now = func() time.Time { return time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC) }
+	_, body := get(t, h, "lat=51.4769&lng=-0.0005&tzid=Europe/London")
+	res := body["results"].(map[string]any)
+	for key, want := range map[string]string{
+		"sunrise":    "4:42:38 AM",
+		"sunset":     "9:20:48 PM",
+		"day_length": "16:38:10",
+	} {
+		if res[key] != want {
+			t.Errorf("%s: got %v, want %v", key, res[key], want)
+		}
+	}
+	if body["tzid"] != "Europe/London" {
+		t.Errorf("got tzid %v", body["tzid"])
+	}
+}
+
+func TestHandlerErrors(t *testing.T) {
+	h := NewHandler()
+	for _, tt := range []struct {
+		query, status string
+	}{
+		{"lng=0", StatusInvalidRequest},
+		{"lat=north&lng=0", StatusInvalidRequest},
+		{"lat=91&lng=0", StatusInvalidRequest},
+		{"lat=NaN&lng=0", StatusInvalidRequest},
+		{"lat=0&lng=0&date=20%20June", StatusInvalidDate},
+		{"lat=0&lng=0&date=5000-01-01", StatusInvalidDate},
+		{"lat=0&lng=0&tzid=Mars/Olympus", StatusInvalidTZID},
+	} {
+		code, body := get(t, h, tt.query)
+		if code != http.StatusBadRequest || body["status"] != tt.status || body["results"] != "" {
+			t.Errorf("%s: got %d %v, want 400 %s", tt.query, code, body, tt.status)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/sunrise?lat=0&lng=0", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST: got %d, want 405", rec.Code)
+	}
+}
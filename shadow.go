@@ -0,0 +1,31 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// Shadow calculates the shadow that a vertical object height units tall
+// casts on level ground at t: its length, in the same units, and its
+// bearing, the direction from the object to the tip of the shadow in
+// degrees clockwise from north, opposite the sun. It takes the sun's
+// geometric altitude, ignoring refraction, which lengthens shadows when the
+// sun is low, and returns ErrSunDown if the sun's centre is below the
+// horizon.
+func (o Observer) Shadow(t time.Time, height float64) (length, bearing float64, err error) {
+	if err := o.validate(t); err != nil {
+		return math.NaN(), math.NaN(), err
+	}
+	azimuth, altitude := o.sunPosition(t)
+	if altitude <= 0 {
+		return math.NaN(), math.NaN(), ErrSunDown
+	}
+	return height / math.Tan(degToRad*altitude), math.Mod(azimuth+180, 360), nil
+}
+
+// Shadow calculates the length and bearing of the shadow of a vertical
+// object height units tall at t at the location specified in latitude and
+// longitude.
+func Shadow(t time.Time, latitude, longitude, height float64) (length, bearing float64, err error) {
+	return Observer{Lat: latitude, Lon: longitude}.Shadow(t, height)
+}
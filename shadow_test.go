@@ -0,0 +1,40 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+)
+
+func TestShadow(t *testing.T) {
+	for _, test := range []struct {
+		t        string
+		lat, lon float64
+		height   float64
+		length   float64
+		bearing  float64
+	}{
+		// At solar noon on the solstices the sun stands 90° − 51.48° ±
+		// 23.44° high, due south.
+		{t: "2024-06-20T12:02:00Z", lat: 51.4769, lon: -0.0005, height: 1, length: 1 / math.Tan(degToRad*61.96), bearing: 0},
+		{t: "2024-12-21T11:58:00Z", lat: 51.4769, lon: -0.0005, height: 10, length: 10 / math.Tan(degToRad*15.08), bearing: 0},
+		// A morning shadow at New York points west-northwest.
+		{t: "2024-03-20T09:00:00-04:00", lat: 40.7128, lon: -74.006, height: 3, length: 7.5008, bearing: 289.884},
+	} {
+		length, bearing, err := Shadow(p(test.t), test.lat, test.lon, test.height)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(length-test.length) > 0.01*test.length {
+			t.Errorf("%s: got length %v, want %v", test.t, length, test.length)
+		}
+		if d := math.Abs(math.Mod(bearing-test.bearing+540, 360) - 180); d > 0.25 {
+			t.Errorf("%s: got bearing %v, want %v", test.t, bearing, test.bearing)
+		}
+	}
+	if _, _, err := Shadow(p("2024-03-20T23:00:00Z"), 51.4769, -0.0005, 1); err != ErrSunDown {
+		t.Errorf("got %v at night, want ErrSunDown", err)
+	}
+	if _, _, err := Shadow(p("2024-03-20T12:00:00Z"), 91, 0, 1); err == nil {
+		t.Error("got no error for an invalid latitude")
+	}
+}
@@ -0,0 +1,95 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// nutationApprox calculates the nutation in longitude and obliquity, in
+// degrees, to about 0.5″ and 0.1″, for t in Julian centuries since J2000.0.
+func nutationApprox(t float64) (dpsi, deps float64) {
+	omega := degToRad * (125.04452 - 1934.136261*t)
+	l := degToRad * (280.4665 + 36000.7698*t)
+	lp := degToRad * (218.3165 + 481267.8813*t)
+	dpsi = -17.20*math.Sin(omega) - 1.32*math.Sin(2*l) - 0.23*math.Sin(2*lp) + 0.21*math.Sin(2*omega)
+	deps = 9.20*math.Cos(omega) + 0.57*math.Cos(2*l) + 0.10*math.Cos(2*lp) - 0.09*math.Cos(2*omega)
+	return dpsi / 3600, deps / 3600
+}
+
+// gmst calculates the Greenwich mean sidereal time, in degrees, at the
+// Julian date jd (UT).
+func gmst(jd float64) float64 {
+	t := julianCentury(jd)
+	theta := 280.46061837 + 360.98564736629*(jd-2451545) + t*t*(0.000387933-t/38710000)
+	theta = math.Mod(theta, 360)
+	if theta < 0 {
+		theta += 360
+	}
+	return theta
+}
+
+// gast calculates the Greenwich apparent sidereal time, in degrees, at the
+// Julian date jd (UT).
+func gast(jd float64) float64 {
+	t := julianCentury(jd)
+	dpsi, deps := nutationApprox(t)
+	eps := eclipticMeanObliquity(t) + deps
+	return math.Mod(gmst(jd)+dpsi*math.Cos(degToRad*eps)+360, 360)
+}
+
+// eclipticToEquatorial converts ecliptic longitude and latitude to right
+// ascension and declination, all in degrees, for the obliquity eps.
+func eclipticToEquatorial(lon, lat, eps float64) (ra, dec float64) {
+	l, b, e := degToRad*lon, degToRad*lat, degToRad*eps
+	ra = radToDeg * math.Atan2(math.Sin(l)*math.Cos(e)-math.Tan(b)*math.Sin(e), math.Cos(l))
+	if ra < 0 {
+		ra += 360
+	}
+	dec = radToDeg * math.Asin(math.Sin(b)*math.Cos(e)+math.Cos(b)*math.Sin(e)*math.Sin(l))
+	return ra, dec
+}
+
+// equatorialToHorizontal converts the local hour angle and declination of
+// a body, in degrees, to its azimuth clockwise from north and altitude for
+// an observer at the latitude.
+func equatorialToHorizontal(latitude, ha, dec float64) (azimuth, altitude float64) {
+	h, d, phi := degToRad*ha, degToRad*dec, degToRad*latitude
+	azimuth = radToDeg*math.Atan2(math.Sin(h), math.Cos(h)*math.Sin(phi)-math.Tan(d)*math.Cos(phi)) + 180
+	altitude = radToDeg * math.Asin(math.Sin(phi)*math.Sin(d)+math.Cos(phi)*math.Cos(d)*math.Cos(h))
+	return math.Mod(azimuth, 360), altitude
+}
+
+// horizontalToEquatorial converts the azimuth, clockwise from north, and
+// altitude of a point on the sky seen from the latitude to its local hour
+// angle and declination, all in degrees.
+func horizontalToEquatorial(latitude, azimuth, altitude float64) (ha, dec float64) {
+	a, h, phi := degToRad*(azimuth-180), degToRad*altitude, degToRad*latitude
+	ha = radToDeg * math.Atan2(math.Sin(a), math.Cos(a)*math.Sin(phi)+math.Tan(h)*math.Cos(phi))
+	dec = radToDeg * math.Asin(math.Sin(phi)*math.Sin(h)-math.Cos(phi)*math.Cos(h)*math.Cos(a))
+	return ha, dec
+}
+
+// GMST calculates the Greenwich mean sidereal time at t, in degrees from 0°
+// to 360°; divide by 15 for hours.
+func GMST(t time.Time) float64 {
+	return gmst(julianDate(t.UTC()))
+}
+
+// GAST calculates the Greenwich apparent sidereal time at t, in degrees: the
+// mean sidereal time corrected for nutation by the equation of the
+// equinoxes, at most about a second of time.
+func GAST(t time.Time) float64 {
+	return gast(julianDate(t.UTC()))
+}
+
+// LMST calculates the local mean sidereal time at t at the longitude, in
+// degrees east of Greenwich.
+func LMST(t time.Time, longitude float64) float64 {
+	return math.Mod(math.Mod(GMST(t)+longitude, 360)+360, 360)
+}
+
+// LAST calculates the local apparent sidereal time at t at the longitude:
+// the right ascension on the observer's meridian.
+func LAST(t time.Time, longitude float64) float64 {
+	return math.Mod(math.Mod(GAST(t)+longitude, 360)+360, 360)
+}
@@ -0,0 +1,76 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGMST(t *testing.T) {
+	// Meeus, Astronomical Algorithms, examples 12.a and 12.b.
+	tests := []struct {
+		jd, want float64
+	}{
+		{2446895.5, 197.693195},
+		{2446896.30625, 128.7378734},
+	}
+	for _, tt := range tests {
+		if got := gmst(tt.jd); math.Abs(got-tt.want) > 1e-5 {
+			t.Errorf("gmst(%v) = %.7f, want %.7f", tt.jd, got, tt.want)
+		}
+	}
+	// Apparent sidereal time in example 12.a is 13h10m46.1351s.
+	if got := gast(2446895.5); math.Abs(got-197.692230) > 1e-4 {
+		t.Errorf("gast(2446895.5) = %.6f, want 197.692230", got)
+	}
+}
+
+func TestEclipticToEquatorial(t *testing.T) {
+	// Meeus example 13.a: Pollux.
+	ra, dec := eclipticToEquatorial(113.215630, 6.684170, 23.4392911)
+	if math.Abs(ra-116.328942) > 1e-5 || math.Abs(dec-28.026183) > 1e-5 {
+		t.Errorf("got %.6f, %.6f, want 116.328942, 28.026183", ra, dec)
+	}
+}
+
+func TestEquatorialToHorizontal(t *testing.T) {
+	// Meeus example 13.b: Venus seen from the US Naval Observatory.
+	az, alt := equatorialToHorizontal(38.921389, 64.352133, -6.719892)
+	if math.Abs(az-248.0337) > 1e-3 || math.Abs(alt-15.1249) > 1e-3 {
+		t.Errorf("got %.4f, %.4f, want 248.0337, 15.1249", az, alt)
+	}
+	ha, dec := horizontalToEquatorial(38.921389, az, alt)
+	if math.Abs(ha-64.352133) > 1e-6 || math.Abs(dec+6.719892) > 1e-6 {
+		t.Errorf("got %.6f, %.6f back, want 64.352133, -6.719892", ha, dec)
+	}
+}
+
+func TestSiderealTime(t *testing.T) {
+	// Meeus example 12.b: 1987 April 10, 19:21:00 UT.
+	at := time.Date(1987, 4, 10, 19, 21, 0, 0, time.UTC)
+	if got := GMST(at); math.Abs(got-128.7378734) > 1e-5 {
+		t.Errorf("GMST = %.7f, want 128.7378734", got)
+	}
+	if d := GMST(at) - GAST(at); math.Abs(d) > 0.005 {
+		t.Errorf("GMST − GAST = %.5f°, want within the equation of the equinoxes", d)
+	}
+	// West longitudes can take the local time below zero.
+	if got := LMST(at, -150); math.Abs(got-338.7378734) > 1e-5 {
+		t.Errorf("LMST = %.7f, want 338.7378734", got)
+	}
+	if got, want := LAST(at, 10), math.Mod(GAST(at)+10, 360); math.Abs(got-want) > 1e-9 {
+		t.Errorf("LAST = %.7f, want %.7f", got, want)
+	}
+}
+
+func TestEclipticMeanObliquity(t *testing.T) {
+	// Meeus example 22.a: 1987 April 10, 0h TD, ε0 = 23°26′27.407″.
+	got := eclipticMeanObliquity(-0.127296372348)
+	if want := 23 + 26.0/60 + 27.407/3600; math.Abs(got-want)*3600 > 0.001 {
+		t.Errorf("got %.7f, want %.7f", got, want)
+	}
+	// The obliquity was nearly half a degree larger in 2000 BC.
+	if got := eclipticMeanObliquity(-40); math.Abs(got-23.924) > 0.001 {
+		t.Errorf("obliquity in 2000 BC = %.4f, want about 23.924", got)
+	}
+}
@@ -0,0 +1,114 @@
+package astrotime
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// SolarTerm is one of the 24 solar terms (jiéqì) of the East Asian
+// calendars, each beginning when the sun's apparent longitude reaches a
+// multiple of 15°.
+type SolarTerm int
+
+// The solar terms, in traditional order from the beginning of spring.
+const (
+	Lichun      SolarTerm = iota // 315°, start of spring
+	Yushui                       // 330°, rain water
+	Jingzhe                      // 345°, awakening of insects
+	Chunfen                      // 0°, spring equinox
+	Qingming                     // 15°, pure brightness
+	Guyu                         // 30°, grain rain
+	Lixia                        // 45°, start of summer
+	Xiaoman                      // 60°, grain buds
+	Mangzhong                    // 75°, grain in ear
+	Xiazhi                       // 90°, summer solstice
+	Xiaoshu                      // 105°, minor heat
+	Dashu                        // 120°, major heat
+	Liqiu                        // 135°, start of autumn
+	Chushu                       // 150°, end of heat
+	Bailu                        // 165°, white dew
+	Qiufen                       // 180°, autumn equinox
+	Hanlu                        // 195°, cold dew
+	Shuangjiang                  // 210°, frost's descent
+	Lidong                       // 225°, start of winter
+	Xiaoxue                      // 240°, minor snow
+	Daxue                        // 255°, major snow
+	Dongzhi                      // 270°, winter solstice
+	Xiaohan                      // 285°, minor cold
+	Dahan                        // 300°, major cold
+)
+
+var solarTermNames = [...]struct{ pinyin, hanzi string }{
+	Lichun:      {"Lichun", "立春"},
+	Yushui:      {"Yushui", "雨水"},
+	Jingzhe:     {"Jingzhe", "惊蛰"},
+	Chunfen:     {"Chunfen", "春分"},
+	Qingming:    {"Qingming", "清明"},
+	Guyu:        {"Guyu", "谷雨"},
+	Lixia:       {"Lixia", "立夏"},
+	Xiaoman:     {"Xiaoman", "小满"},
+	Mangzhong:   {"Mangzhong", "芒种"},
+	Xiazhi:      {"Xiazhi", "夏至"},
+	Xiaoshu:     {"Xiaoshu", "小暑"},
+	Dashu:       {"Dashu", "大暑"},
+	Liqiu:       {"Liqiu", "立秋"},
+	Chushu:      {"Chushu", "处暑"},
+	Bailu:       {"Bailu", "白露"},
+	Qiufen:      {"Qiufen", "秋分"},
+	Hanlu:       {"Hanlu", "寒露"},
+	Shuangjiang: {"Shuangjiang", "霜降"},
+	Lidong:      {"Lidong", "立冬"},
+	Xiaoxue:     {"Xiaoxue", "小雪"},
+	Daxue:       {"Daxue", "大雪"},
+	Dongzhi:     {"Dongzhi", "冬至"},
+	Xiaohan:     {"Xiaohan", "小寒"},
+	Dahan:       {"Dahan", "大寒"},
+}
+
+// String returns the pinyin name of the term.
+func (s SolarTerm) String() string {
+	if s < 0 || int(s) >= len(solarTermNames) {
+		return "SolarTerm(" + strconv.Itoa(int(s)) + ")"
+	}
+	return solarTermNames[s].pinyin
+}
+
+// Hanzi returns the name of the term in simplified Chinese characters.
+func (s SolarTerm) Hanzi() string {
+	if s < 0 || int(s) >= len(solarTermNames) {
+		return s.String()
+	}
+	return solarTermNames[s].hanzi
+}
+
+// Longitude returns the apparent solar longitude, in degrees, at which the
+// term begins.
+func (s SolarTerm) Longitude() float64 {
+	return math.Mod(315+15*float64(s), 360)
+}
+
+// SolarTermEvent is the beginning of a solar term.
+type SolarTermEvent struct {
+	Term SolarTerm
+	Time time.Time
+}
+
+// SolarTerms calculates the instants, in UTC, at which each of the 24 solar
+// terms begins in the year, in chronological order from Xiaohan in early
+// January to Dongzhi in late December. Calendars reckon the day of a term
+// in their own time zone, such as UTC+8 for the Chinese calendar.
+func SolarTerms(year int) ([]SolarTermEvent, error) {
+	start := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := make([]SolarTermEvent, 0, len(solarTermNames))
+	for s := range SolarTerm(len(solarTermNames)) {
+		t, err := NextSolarLongitude(start, s.Longitude())
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, SolarTermEvent{Term: s, Time: t})
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Time.Before(events[j].Time) })
+	return events, nil
+}
@@ -0,0 +1,59 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSolarTerms(t *testing.T) {
+	events, err := SolarTerms(2024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 24 {
+		t.Fatalf("got %d terms, want 24", len(events))
+	}
+	if events[0].Term != Xiaohan || events[23].Term != Dongzhi {
+		t.Errorf("got %v to %v, want Xiaohan to Dongzhi", events[0].Term, events[23].Term)
+	}
+	for i, ev := range events {
+		if ev.Time.Year() != 2024 {
+			t.Errorf("%v at %v is outside 2024", ev.Term, ev.Time)
+		}
+		if i > 0 && ev.Term != (events[i-1].Term+1)%24 {
+			t.Errorf("%v follows %v", ev.Term, events[i-1].Term)
+		}
+	}
+
+	// Dates in China Standard Time, as published for 2024.
+	cst := time.FixedZone("CST", 8*60*60)
+	want := map[SolarTerm]string{
+		Lichun:   "2024-02-04",
+		Qingming: "2024-04-04",
+		Xiazhi:   "2024-06-21",
+		Qiufen:   "2024-09-22",
+		Dongzhi:  "2024-12-21",
+	}
+	for _, ev := range events {
+		if w, ok := want[ev.Term]; ok {
+			if got := ev.Time.In(cst).Format(time.DateOnly); got != w {
+				t.Errorf("%v: got %s, want %s", ev.Term, got, w)
+			}
+		}
+	}
+}
+
+func TestSolarTermNames(t *testing.T) {
+	if got := Chunfen.String(); got != "Chunfen" {
+		t.Errorf("got %q, want Chunfen", got)
+	}
+	if got := Dongzhi.Hanzi(); got != "冬至" {
+		t.Errorf("got %q, want 冬至", got)
+	}
+	if got := Chunfen.Longitude(); got != 0 {
+		t.Errorf("got %v, want 0", got)
+	}
+	if got := Lichun.Longitude(); got != 315 {
+		t.Errorf("got %v, want 315", got)
+	}
+}
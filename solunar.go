@@ -0,0 +1,77 @@
+package astrotime
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// solunarMajor is the length of a major period, centred on the moon's
+	// transit or anti-transit.
+	solunarMajor = 2 * time.Hour
+
+	// solunarMinor is the length of a minor period, centred on moonrise
+	// or moonset.
+	solunarMinor = time.Hour
+)
+
+// SolunarPeriod is a window of expected fish and game activity in solunar
+// theory.
+type SolunarPeriod struct {
+	Interval
+
+	// Major is set for the two-hour periods around the moon's transit and
+	// anti-transit, and clear for the one-hour minor periods around
+	// moonrise and moonset.
+	Major bool
+
+	// Event is the lunar event the period is centred on: MoonEventTransit,
+	// MoonEventAntiTransit, MoonEventMoonrise or MoonEventMoonset.
+	Event MoonEventKind
+}
+
+// SolunarBetween returns the solunar periods centred in [start, end), in
+// order of their start.
+func (o Observer) SolunarBetween(start, end time.Time) ([]SolunarPeriod, error) {
+	if err := o.validate(start); err != nil {
+		return nil, err
+	}
+	start, end = o.local(start), o.local(end)
+
+	var periods []SolunarPeriod
+	add := func(kind MoonEventKind, times []time.Time) {
+		length, major := solunarMinor, false
+		if kind == MoonEventTransit || kind == MoonEventAntiTransit {
+			length, major = solunarMajor, true
+		}
+		for _, t := range times {
+			t = o.round(t.In(start.Location()))
+			periods = append(periods, SolunarPeriod{
+				Interval: Interval{Start: t.Add(-length / 2), End: t.Add(length / 2)},
+				Major:    major,
+				Event:    kind,
+			})
+		}
+	}
+	transits, antiTransits := o.moonMeridianCrossings(start, end)
+	rises, sets := o.moonCrossings(start, end)
+	add(MoonEventTransit, transits)
+	add(MoonEventAntiTransit, antiTransits)
+	add(MoonEventMoonrise, rises)
+	add(MoonEventMoonset, sets)
+	sort.Slice(periods, func(i, j int) bool { return periods[i].Start.Before(periods[j].Start) })
+	return periods, nil
+}
+
+// Solunar returns the solunar periods centred on the calendar day of t.
+// A day usually has two major and two minor periods, but one of each can
+// be missing as the moon's schedule slips about 50 minutes a day.
+func (o Observer) Solunar(t time.Time) ([]SolunarPeriod, error) {
+	return o.SolunarBetween(o.dayBounds(t))
+}
+
+// Solunar returns the solunar periods on the day t for an observer at the
+// latitude and longitude.
+func Solunar(t time.Time, latitude, longitude float64) ([]SolunarPeriod, error) {
+	return Observer{Lat: latitude, Lon: longitude}.Solunar(t)
+}
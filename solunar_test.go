@@ -0,0 +1,53 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSolunar(t *testing.T) {
+	o := Observer{Lat: 40.7128, Lon: -74.0060}
+	day := time.Date(2024, 10, 2, 0, 0, 0, 0, time.UTC)
+	periods, err := o.Solunar(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var major, minor int
+	for i, p := range periods {
+		if i > 0 && p.Start.Before(periods[i-1].Start) {
+			t.Errorf("period %d starts before the one before it", i)
+		}
+		centre := p.Start.Add(p.Duration() / 2)
+		if centre.Day() != 2 {
+			t.Errorf("%v period centred at %v, outside the day", p.Event, centre)
+		}
+		want := time.Hour
+		if p.Major {
+			major++
+			want = 2 * time.Hour
+		} else {
+			minor++
+		}
+		if p.Duration() != want {
+			t.Errorf("%v period lasts %v, want %v", p.Event, p.Duration(), want)
+		}
+	}
+	if major < 1 || major > 2 || minor < 1 || minor > 2 {
+		t.Errorf("got %d major and %d minor periods, want one or two of each", major, minor)
+	}
+
+	// The transit period is centred on the moon's transit.
+	tr, err := o.MoonTransit(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, p := range periods {
+		if p.Event == MoonEventTransit {
+			found = p.Start.Add(time.Hour).Equal(tr)
+		}
+	}
+	if !found {
+		t.Errorf("no major period centred on transit at %v", tr)
+	}
+}
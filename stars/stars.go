@@ -0,0 +1,137 @@
+// Package stars provides a catalog of named bright stars for use with the
+// rise, set and transit calculations of astrotime.Body.
+//
+//	sirius, _ := stars.Lookup("Sirius")
+//	rise, err := observer.BodyRise(sirius.Body(), time.Now())
+//
+// The built-in catalog holds the brightest stars and a few of navigational
+// or historical interest, with J2000 positions. Precession moves stars by
+// about a third of a degree over 25 years, shifting their rise and set
+// times by a minute or two; BodyOfDate corrects for it. Other catalogs can
+// be read with Load.
+package stars
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dntj/astrotime"
+	"github.com/dntj/astrotime/coords"
+	"github.com/dntj/astrotime/epoch"
+)
+
+//go:embed bright.csv
+var brightCSV string
+
+// Star is a named star in a catalog.
+type Star struct {
+	Name string
+
+	// RA and Dec are the right ascension and declination of the star in
+	// degrees.
+	RA, Dec float64
+
+	// Mag is the star's apparent visual magnitude.
+	Mag float64
+}
+
+// Body returns the star's position for the astrotime rise and set API.
+func (s Star) Body() astrotime.Body {
+	return astrotime.Body{RA: s.RA, Dec: s.Dec}
+}
+
+// BodyOfDate returns the star's J2000 position precessed to the mean
+// equinox of t, for the rise and set API on that date.
+func (s Star) BodyOfDate(t time.Time) astrotime.Body {
+	eq := coords.Equatorial{RA: s.RA, Dec: s.Dec}.Precess(epoch.J2000, epoch.JulianDate(t))
+	return astrotime.Body{RA: eq.RA, Dec: eq.Dec}
+}
+
+// Catalog is a set of stars that can be looked up by name.
+type Catalog struct {
+	stars  []Star
+	byName map[string]int
+}
+
+// Load reads a catalog in CSV form: a header line naming the columns name,
+// ra, dec and mag, in any order, followed by one star per line with
+// positions in degrees. Lines starting with # are ignored.
+func Load(r io.Reader) (*Catalog, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.TrimLeadingSpace = true
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("stars: reading header: %w", err)
+	}
+	col := map[string]int{"name": -1, "ra": -1, "dec": -1, "mag": -1}
+	for i, h := range header {
+		if _, ok := col[strings.ToLower(h)]; ok {
+			col[strings.ToLower(h)] = i
+		}
+	}
+	for name, i := range col {
+		if i < 0 {
+			return nil, fmt.Errorf("stars: missing %s column", name)
+		}
+	}
+
+	c := &Catalog{byName: make(map[string]int)}
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("stars: %w", err)
+		}
+		s := Star{Name: rec[col["name"]]}
+		for _, f := range []struct {
+			col string
+			v   *float64
+		}{{"ra", &s.RA}, {"dec", &s.Dec}, {"mag", &s.Mag}} {
+			if *f.v, err = strconv.ParseFloat(rec[col[f.col]], 64); err != nil {
+				line, _ := cr.FieldPos(col[f.col])
+				return nil, fmt.Errorf("stars: line %d: bad %s %q", line, f.col, rec[col[f.col]])
+			}
+		}
+		c.byName[strings.ToLower(s.Name)] = len(c.stars)
+		c.stars = append(c.stars, s)
+	}
+	return c, nil
+}
+
+// Lookup returns the star with the name, ignoring case.
+func (c *Catalog) Lookup(name string) (Star, bool) {
+	i, ok := c.byName[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return Star{}, false
+	}
+	return c.stars[i], true
+}
+
+// Stars returns the stars of the catalog in the order they were loaded.
+func (c *Catalog) Stars() []Star {
+	return append([]Star(nil), c.stars...)
+}
+
+// Bright returns the built-in catalog of bright stars.
+var Bright = sync.OnceValue(func() *Catalog {
+	c, err := Load(strings.NewReader(brightCSV))
+	if err != nil {
+		panic(err)
+	}
+	return c
+})
+
+// Lookup returns the star with the name from the built-in catalog.
+func Lookup(name string) (Star, bool) {
+	return Bright().Lookup(name)
+}
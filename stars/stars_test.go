@@ -0,0 +1,83 @@
+package stars
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name     string
+		ra, dec  float64
+		wantFind bool
+	}{
+		{"Sirius", 101.28708, -16.71611, true},
+		{"polaris", 37.95458, 89.26417, true},
+		{" Rigil Kentaurus ", 219.90208, -60.83389, true},
+		{"Vulcan", 0, 0, false},
+	}
+	for _, tt := range tests {
+		s, ok := Lookup(tt.name)
+		if ok != tt.wantFind {
+			t.Errorf("Lookup(%q): got found %v, want %v", tt.name, ok, tt.wantFind)
+			continue
+		}
+		if ok && (s.RA != tt.ra || s.Dec != tt.dec) {
+			t.Errorf("Lookup(%q) = %v, %v, want %v, %v", tt.name, s.RA, s.Dec, tt.ra, tt.dec)
+		}
+	}
+	if n := len(Bright().Stars()); n < 40 {
+		t.Errorf("got %d bright stars, want at least 40", n)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	c, err := Load(strings.NewReader("# test\nmag,name,dec,ra\n2.5,Test Star,10,20\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, ok := c.Lookup("test star")
+	if !ok || s != (Star{Name: "Test Star", RA: 20, Dec: 10, Mag: 2.5}) {
+		t.Errorf("got %+v, %v", s, ok)
+	}
+
+	for _, in := range []string{
+		"",
+		"name,ra,dec\nX,1,2\n",
+		"name,ra,dec,mag\nX,one,2,3\n",
+	} {
+		if _, err := Load(strings.NewReader(in)); err == nil {
+			t.Errorf("Load(%q): got nil error", in)
+		}
+	}
+}
+
+func TestSiriusRise(t *testing.T) {
+	sirius, _ := Lookup("Sirius")
+	o := astrotime.Observer{Lat: 51.4779, Lon: 0}
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rise, err := o.BodyRise(sirius.Body(), day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Sirius rises in the early evening in January.
+	if h := rise.Hour(); h < 17 || h > 19 {
+		t.Errorf("got rise %v, want early evening", rise)
+	}
+}
+
+func TestBodyOfDate(t *testing.T) {
+	vega, _ := Lookup("Vega")
+	if got := vega.BodyOfDate(time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)); math.Abs(got.RA-vega.RA) > 1e-9 || math.Abs(got.Dec-vega.Dec) > 1e-9 {
+		t.Errorf("got %+v at J2000.0, want the catalog position %.4f, %.4f", got, vega.RA, vega.Dec)
+	}
+	// Vega's right ascension grows by about 2.0 seconds of time a year.
+	got := vega.BodyOfDate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	if d := (got.RA - vega.RA) * 240 / 25; math.Abs(d-2.03) > 0.05 {
+		t.Errorf("got %.3fs a year of precession in RA, want about 2.03", d)
+	}
+}
@@ -0,0 +1,95 @@
+// Package suncron parses schedule expressions tied to solar events, such as
+// "@sunset-45m" or "@civil_dusk+1h", into schedules compatible with
+// cron-style schedulers.
+//
+// An expression is "@" followed by an event name and an optional signed
+// duration in the form accepted by time.ParseDuration. The event names are
+// sunrise, sunset, solar_noon (or noon), civil_dawn (or dawn), civil_dusk
+// (or dusk), nautical_dawn, nautical_dusk, astronomical_dawn and
+// astronomical_dusk.
+package suncron
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+var events = map[string]astrotime.EventKind{
+	"sunrise":           astrotime.EventSunrise,
+	"sunset":            astrotime.EventSunset,
+	"solar_noon":        astrotime.EventSolarNoon,
+	"noon":              astrotime.EventSolarNoon,
+	"civil_dawn":        astrotime.EventCivilDawn,
+	"dawn":              astrotime.EventCivilDawn,
+	"civil_dusk":        astrotime.EventCivilDusk,
+	"dusk":              astrotime.EventCivilDusk,
+	"nautical_dawn":     astrotime.EventNauticalDawn,
+	"nautical_dusk":     astrotime.EventNauticalDusk,
+	"astronomical_dawn": astrotime.EventAstronomicalDawn,
+	"astronomical_dusk": astrotime.EventAstronomicalDusk,
+}
+
+// Schedule is a solar event, offset by a fixed duration, seen by an
+// observer. It satisfies the Schedule interface of github.com/robfig/cron.
+type Schedule struct {
+	Observer astrotime.Observer
+	Kind     astrotime.EventKind
+	Offset   time.Duration
+}
+
+// Parse parses the expression spec for the observer o.
+func Parse(spec string, o astrotime.Observer) (Schedule, error) {
+	s := strings.TrimSpace(spec)
+	if !strings.HasPrefix(s, "@") {
+		return Schedule{}, fmt.Errorf("suncron: %q does not start with @", spec)
+	}
+	s = strings.ToLower(s[1:])
+
+	name, offset := s, ""
+	if i := strings.IndexAny(s, "+-"); i >= 0 {
+		name, offset = s[:i], s[i:]
+	}
+	kind, ok := events[name]
+	if !ok {
+		return Schedule{}, fmt.Errorf("suncron: unknown event %q in %q", name, spec)
+	}
+	sched := Schedule{Observer: o, Kind: kind}
+	if offset != "" {
+		d, err := time.ParseDuration(offset)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("suncron: bad offset in %q: %v", spec, err)
+		}
+		sched.Offset = d
+	}
+	return sched, nil
+}
+
+// Next returns the next activation time after t, or the zero Time if there
+// is none within a year.
+func (s Schedule) Next(t time.Time) time.Time {
+	next, err := s.Observer.NextEventAt(t, s.Kind, s.Offset)
+	if err != nil {
+		return time.Time{}
+	}
+	return next
+}
+
+// String returns the schedule as an expression accepted by Parse.
+func (s Schedule) String() string {
+	name := ""
+	for n, k := range events {
+		if k == s.Kind && (name == "" || len(n) > len(name)) {
+			name = n
+		}
+	}
+	switch {
+	case s.Offset > 0:
+		return "@" + name + "+" + s.Offset.String()
+	case s.Offset < 0:
+		return "@" + name + s.Offset.String()
+	}
+	return "@" + name
+}
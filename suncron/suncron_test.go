@@ -0,0 +1,62 @@
+package suncron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+var washington = astrotime.Observer{Lat: 38.8895, Lon: -77.0352}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		spec   string
+		kind   astrotime.EventKind
+		offset time.Duration
+		str    string
+	}{
+		{"@sunrise", astrotime.EventSunrise, 0, "@sunrise"},
+		{"@sunset-45m", astrotime.EventSunset, -45 * time.Minute, "@sunset-45m0s"},
+		{" @Civil_Dusk+1h ", astrotime.EventCivilDusk, time.Hour, "@civil_dusk+1h0m0s"},
+		{"@dawn+1h30m", astrotime.EventCivilDawn, 90 * time.Minute, "@civil_dawn+1h30m0s"},
+		{"@noon", astrotime.EventSolarNoon, 0, "@solar_noon"},
+	}
+	for _, tt := range tests {
+		s, err := Parse(tt.spec, washington)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.spec, err)
+			continue
+		}
+		if s.Kind != tt.kind || s.Offset != tt.offset {
+			t.Errorf("Parse(%q) = %v%+v, want %v%+v", tt.spec, s.Kind, s.Offset, tt.kind, tt.offset)
+		}
+		if got := s.String(); got != tt.str {
+			t.Errorf("Parse(%q).String() = %q, want %q", tt.spec, got, tt.str)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, spec := range []string{"", "sunset", "@moonrise", "@sunset-45x", "@sunset+"} {
+		if _, err := Parse(spec, washington); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", spec)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	s, err := Parse("@sunset-45m", washington)
+	if err != nil {
+		t.Fatal(err)
+	}
+	after := time.Date(2017, 10, 15, 12, 0, 0, 0, time.UTC)
+	got := s.Next(after)
+	sunset := astrotime.Sunset(after, washington.Lat, washington.Lon)
+	if want := sunset.Add(-45 * time.Minute); got.Sub(want).Abs() > time.Minute {
+		t.Errorf("got next activation %s, want %s", got, want)
+	}
+	if next := s.Next(got); !next.After(got.Add(23 * time.Hour)) {
+		t.Errorf("got activation %s after %s, want the next day", next, got)
+	}
+}
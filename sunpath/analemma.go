@@ -0,0 +1,31 @@
+package sunpath
+
+import (
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// Analemma calculates the sun's position at the same time of day on the
+// clock, clock after midnight, on every day of the year in the observer's
+// time zone, or UTC if it has none. Over the year the points trace the
+// analemma, the figure eight swept out by the sun's declination and the
+// equation of time. Daylight saving time moves the clock an hour, breaking
+// the figure in two; photographers capturing a real analemma keep to
+// standard time, as an observer with a fixed zone such as
+// time.FixedZone("EST", -5*3600) does. Points are included whether or not
+// the sun is up.
+func Analemma(o astrotime.Observer, year int, clock time.Duration) ([]Point, error) {
+	loc := zone(o)
+	h, m := int(clock/time.Hour), int(clock%time.Hour/time.Minute)
+	s, ns := int(clock%time.Minute/time.Second), int(clock%time.Second)
+	var points []Point
+	for day := time.Date(year, time.January, 1, 0, 0, 0, 0, loc); day.Year() == year; day = day.AddDate(0, 0, 1) {
+		p, err := Position(o, time.Date(year, day.Month(), day.Day(), h, m, s, ns, loc))
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	}
+	return points, nil
+}
@@ -0,0 +1,63 @@
+package sunpath
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestAnalemma(t *testing.T) {
+	o := astrotime.NewObserver(51.4769, -0.0005)
+	points, err := Analemma(o, 2024, 12*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(points) != 366 {
+		t.Fatalf("got %d points, want 366", len(points))
+	}
+	low, high := points[0], points[0]
+	east, west := points[0], points[0]
+	for i, p := range points {
+		if want := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC).AddDate(0, 0, i); !p.Time.Equal(want) {
+			t.Fatalf("point %d at %s, want %s", i, p.Time, want)
+		}
+		if p.Altitude < low.Altitude {
+			low = p
+		}
+		if p.Altitude > high.Altitude {
+			high = p
+		}
+		if p.Azimuth < east.Azimuth {
+			east = p
+		}
+		if p.Azimuth > west.Azimuth {
+			west = p
+		}
+	}
+	// The figure spans the sun's declinations at the solstices, and the
+	// equation of time, from the sun furthest east of the meridian at
+	// noon in February to furthest west in late October.
+	for _, test := range []struct {
+		name      string
+		got       Point
+		month     time.Month
+		altitude  float64
+		azimuth   float64
+		tolerance float64
+	}{
+		{"lowest", low, time.December, 15.1, 179, 2},
+		{"highest", high, time.June, 62, 178, 2},
+		{"furthest east", east, time.February, 26.0, 176.2, 0.5},
+		{"furthest west", west, time.October, 25.1, 184.4, 0.5},
+	} {
+		if test.got.Time.Month() != test.month || math.Abs(test.got.Altitude-test.altitude) > test.tolerance ||
+			math.Abs(test.got.Azimuth-test.azimuth) > test.tolerance {
+			t.Errorf("%s: got %+v", test.name, test.got)
+		}
+	}
+	if _, err := Analemma(astrotime.NewObserver(91, 0), 2024, 0); err == nil {
+		t.Error("got no error for an invalid latitude")
+	}
+}
@@ -0,0 +1,228 @@
+// Package sunpath generates the data of sun-path diagrams, which architects
+// and shading designers use to see where the sun stands at each hour
+// through the year: the sun's course across the sky on a set of dates,
+// usually the solstices, the equinoxes and the 21st of the other months,
+// crossed by hour lines joining its positions at each hour on those dates.
+//
+//	d, err := sunpath.Generate(observer, 2024, sunpath.Config{})
+//	for _, path := range d.Paths {
+//		for _, p := range path.Points {
+//			plot(p.Azimuth, p.Altitude)
+//		}
+//	}
+//
+// Analemma gives the sun's position at one time of day through a year,
+// and a Chart draws diagrams and analemmas as SVG.
+//
+// Positions are the sun's geometric azimuth and altitude, in degrees,
+// without refraction.
+package sunpath
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// DefaultStep is the interval at which the date paths are sampled by
+// default.
+const DefaultStep = 10 * time.Minute
+
+// Point is the position of the sun at a time: its azimuth, in degrees
+// clockwise from north, and its altitude above the horizon in degrees.
+type Point struct {
+	Time              time.Time
+	Azimuth, Altitude float64
+}
+
+// Path is the sun's course across the sky on one date, from sunrise to
+// sunset, or through the whole day while it stays up. It has no points
+// while the sun stays down. Sunrise and sunset allow for refraction, so
+// the ends of the path lie a little below the geometric horizon.
+type Path struct {
+	// Date is the start of the day, at midnight.
+	Date   time.Time
+	Points []Point
+}
+
+// HourLine joins the positions of the sun at one hour on each of the
+// dates of a diagram, in date order, where the sun is up then.
+type HourLine struct {
+	// Hour is the hour of the day, from 0 to 23.
+	Hour   int
+	Points []Point
+}
+
+// Diagram is the data of a sun-path diagram.
+type Diagram struct {
+	Paths []Path
+	Hours []HourLine
+}
+
+// Config sets what Generate includes in a diagram.
+type Config struct {
+	// Dates are the dates to draw the sun's course on, or KeyDates of the
+	// year if empty. Only their calendar days, in the observer's time zone,
+	// matter.
+	Dates []time.Time
+
+	// Step is the interval at which each course is sampled, or DefaultStep
+	// if zero.
+	Step time.Duration
+
+	// SolarTime draws the hour lines at the hours of apparent solar time,
+	// counted from solar noon, rather than of the clock. Solar hour lines
+	// are nearly straight; clock hour lines wander east and west with the
+	// equation of time and, across daylight saving time, jump an hour.
+	SolarTime bool
+}
+
+// zone returns the time zone of the observer's results, defaulting to UTC.
+func zone(o astrotime.Observer) *time.Location {
+	if o.Location == nil {
+		return time.UTC
+	}
+	return o.Location
+}
+
+// KeyDates returns the dates usually drawn on a sun-path diagram of the
+// year, at midnight in loc: the days of the equinoxes and solstices, in
+// loc, and the 21st of each of the other eight months, in order.
+func KeyDates(year int, loc *time.Location) ([]time.Time, error) {
+	march, september, err := astrotime.Equinoxes(year)
+	if err != nil {
+		return nil, err
+	}
+	june, december, err := astrotime.Solstices(year)
+	if err != nil {
+		return nil, err
+	}
+	seasons := map[time.Month]time.Time{
+		time.March:     march,
+		time.June:      june,
+		time.September: september,
+		time.December:  december,
+	}
+	var dates []time.Time
+	for m := time.January; m <= time.December; m++ {
+		day := 21
+		if t, ok := seasons[m]; ok {
+			day = t.In(loc).Day()
+		}
+		dates = append(dates, time.Date(year, m, day, 0, 0, 0, 0, loc))
+	}
+	return dates, nil
+}
+
+// Position calculates the sun's geometric azimuth and altitude at t for
+// the observer.
+func Position(o astrotime.Observer, t time.Time) (Point, error) {
+	ra, dec := astrotime.SunEquatorial(t)
+	az, alt, err := o.BodyPosition(astrotime.Body{RA: ra, Dec: dec}, t)
+	if err != nil {
+		return Point{}, err
+	}
+	return Point{Time: t, Azimuth: az, Altitude: alt}, nil
+}
+
+// Generate calculates a sun-path diagram of the year for the observer: a
+// Path on each of the configured dates, and an HourLine for each hour at
+// which the sun is up on any of them.
+func Generate(o astrotime.Observer, year int, c Config) (Diagram, error) {
+	loc := zone(o)
+	dates := c.Dates
+	if len(dates) == 0 {
+		var err error
+		if dates, err = KeyDates(year, loc); err != nil {
+			return Diagram{}, err
+		}
+	}
+	step := c.Step
+	if step <= 0 {
+		step = DefaultStep
+	}
+	days := make([]time.Time, len(dates))
+	for i, d := range dates {
+		d = d.In(loc)
+		days[i] = time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, loc)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	var diagram Diagram
+	for _, day := range days {
+		p, err := path(o, day, step)
+		if err != nil {
+			return Diagram{}, err
+		}
+		diagram.Paths = append(diagram.Paths, p)
+	}
+	for hour := 0; hour < 24; hour++ {
+		line := HourLine{Hour: hour}
+		for _, day := range days {
+			t := time.Date(day.Year(), day.Month(), day.Day(), hour, 0, 0, 0, loc)
+			if c.SolarTime {
+				noon, err := o.EventTime(day.Add(12*time.Hour), astrotime.EventSolarNoon)
+				if err != nil {
+					return Diagram{}, err
+				}
+				t = noon.Add(time.Duration(hour-12) * time.Hour)
+			}
+			p, err := Position(o, t)
+			if err != nil {
+				return Diagram{}, err
+			}
+			if p.Altitude >= 0 {
+				line.Points = append(line.Points, p)
+			}
+		}
+		if len(line.Points) > 0 {
+			diagram.Hours = append(diagram.Hours, line)
+		}
+	}
+	return diagram, nil
+}
+
+// path samples the sun's course on the day starting at midnight, every
+// step of the clock between sunrise and sunset.
+func path(o astrotime.Observer, day time.Time, step time.Duration) (Path, error) {
+	p := Path{Date: day}
+	next := day.AddDate(0, 0, 1)
+	start, end := day, next
+	rise, riseErr := o.Sunrise(day.Add(12 * time.Hour))
+	set, setErr := o.Sunset(day.Add(12 * time.Hour))
+	switch {
+	case riseErr == astrotime.ErrAlwaysBelow || setErr == astrotime.ErrAlwaysBelow:
+		return p, nil
+	case riseErr == astrotime.ErrAlwaysAbove || setErr == astrotime.ErrAlwaysAbove:
+	case riseErr != nil:
+		return Path{}, riseErr
+	case setErr != nil:
+		return Path{}, setErr
+	default:
+		start, end = rise, set
+	}
+	add := func(t time.Time) error {
+		pt, err := Position(o, t)
+		if err == nil {
+			p.Points = append(p.Points, pt)
+		}
+		return err
+	}
+	if err := add(start); err != nil {
+		return Path{}, err
+	}
+	for t := day.Add(step); t.Before(end); t = t.Add(step) {
+		if t.After(start) {
+			if err := add(t); err != nil {
+				return Path{}, err
+			}
+		}
+	}
+	if end.Before(next) {
+		if err := add(end); err != nil {
+			return Path{}, err
+		}
+	}
+	return p, nil
+}
@@ -0,0 +1,120 @@
+package sunpath
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestKeyDates(t *testing.T) {
+	dates, err := KeyDates(2024, time.UTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"2024-01-21", "2024-02-21", "2024-03-20", "2024-04-21", "2024-05-21", "2024-06-20",
+		"2024-07-21", "2024-08-21", "2024-09-22", "2024-10-21", "2024-11-21", "2024-12-21",
+	}
+	if len(dates) != len(want) {
+		t.Fatalf("got %d dates, want %d", len(dates), len(want))
+	}
+	for i, d := range dates {
+		if got := d.Format("2006-01-02"); got != want[i] || d.Hour() != 0 {
+			t.Errorf("date %d: got %s, want %s", i, d, want[i])
+		}
+	}
+	// The March equinox falls on 19 March in New York.
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	dates, _ = KeyDates(2024, ny)
+	if got := dates[2]; got.Day() != 19 || got.Location() != ny {
+		t.Errorf("got %s, want 19 March in New York", got)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	o := astrotime.Observer{Lat: 40.7128, Lon: -74.006, Location: ny}
+	d, err := Generate(o, 2024, Config{Step: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Paths) != 12 {
+		t.Fatalf("got %d paths, want 12", len(d.Paths))
+	}
+	june := d.Paths[5]
+	if got := june.Date.Format("2006-01-02"); got != "2024-06-20" {
+		t.Errorf("got the June path on %s", got)
+	}
+	// Sunrise, the 15 whole hours from 06:00 to 20:00, and sunset.
+	if len(june.Points) != 17 {
+		t.Errorf("got %d points in June, want 17", len(june.Points))
+	}
+	rise, _ := o.Sunrise(june.Date.Add(12 * time.Hour))
+	if first := june.Points[0]; !first.Time.Equal(rise) || math.Abs(first.Azimuth-57.5) > 0.1 || math.Abs(first.Altitude+0.83) > 0.1 {
+		t.Errorf("got the June path starting at %+v, want sunrise at %s", first, rise)
+	}
+	for _, p := range d.Paths {
+		for i, pt := range p.Points {
+			if pt.Altitude < -1 || i > 0 && !pt.Time.After(p.Points[i-1].Time) {
+				t.Errorf("%s: point %d at %+v", p.Date.Format("2006-01-02"), i, pt)
+			}
+		}
+	}
+
+	// Hour lines run from 06:00, in summer, to 20:00.
+	if len(d.Hours) != 15 || d.Hours[0].Hour != 6 || d.Hours[14].Hour != 20 {
+		t.Errorf("got %d hour lines from %d to %d", len(d.Hours), d.Hours[0].Hour, d.Hours[len(d.Hours)-1].Hour)
+	}
+	for _, line := range d.Hours {
+		if line.Hour != 12 {
+			continue
+		}
+		if len(line.Points) != 12 {
+			t.Errorf("got %d points on the noon line, want 12", len(line.Points))
+		}
+		for _, pt := range line.Points {
+			// Noon on the clock is within half an hour of solar noon,
+			// or an hour before it in summer time.
+			if pt.Azimuth < 135 || pt.Azimuth > 190 {
+				t.Errorf("noon line at %+v", pt)
+			}
+		}
+	}
+}
+
+func TestGenerateSolarTime(t *testing.T) {
+	// At Longyearbyen the sun stays down in winter and up in summer.
+	o := astrotime.NewObserver(78.22, 15.65)
+	d, err := Generate(o, 2024, Config{SolarTime: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []int{0, 30, 78, 144, 144, 144, 144, 144, 77, 25, 0, 0} {
+		if got := len(d.Paths[i].Points); got != want {
+			t.Errorf("%s: got %d points, want %d", d.Paths[i].Date.Format("2006-01-02"), got, want)
+		}
+	}
+	if len(d.Hours) != 24 {
+		t.Errorf("got %d hour lines, want 24", len(d.Hours))
+	}
+	for _, line := range d.Hours {
+		for _, pt := range line.Points {
+			// Near the pole solar hours are about 15° of azimuth apart.
+			want := float64(line.Hour) * 15
+			if d := math.Abs(math.Mod(pt.Azimuth-want+540, 360) - 180); d > 6 {
+				t.Errorf("hour %d at %+v, want azimuth %v", line.Hour, pt, want)
+			}
+		}
+	}
+	if _, err := Generate(astrotime.NewObserver(91, 0), 2024, Config{}); err == nil {
+		t.Error("got no error for an invalid latitude")
+	}
+}
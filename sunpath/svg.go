@@ -0,0 +1,230 @@
+package sunpath
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const degToRad = math.Pi / 180
+
+// Projection is how a Chart maps the sky onto the page.
+type Projection int
+
+const (
+	// Stereographic draws the sky as a plan, the usual sun-path chart: the
+	// zenith at the centre, the horizon as the rim, north at the top and
+	// east to the right, with altitudes spaced by the stereographic
+	// projection.
+	Stereographic Projection = iota
+	// Cartesian draws azimuth across the page, from north through east,
+	// south and west, and altitude up it.
+	Cartesian
+)
+
+var projectionNames = [...]string{
+	Stereographic: "Stereographic",
+	Cartesian:     "Cartesian",
+}
+
+func (p Projection) String() string {
+	if p < 0 || int(p) >= len(projectionNames) {
+		return "Projection(" + strconv.Itoa(int(p)) + ")"
+	}
+	return projectionNames[p]
+}
+
+// DefaultSize is the width of a Chart in pixels by default.
+const DefaultSize = 600
+
+// Chart renders a sun-path diagram, analemmas or both as an SVG image.
+// Only what lies above the horizon is drawn. The elements carry classes,
+// "path", "hour" and "analemma", for restyling with CSS.
+type Chart struct {
+	Diagram    Diagram
+	Analemmas  [][]Point
+	Projection Projection
+
+	// Size is the width of the image in pixels, or DefaultSize if zero. A
+	// stereographic chart is square; a cartesian one half as high as it
+	// is wide.
+	Size int
+
+	// Title, if set, is written above the chart.
+	Title string
+}
+
+// WriteSVG writes the chart to w as a standalone SVG document.
+func (c Chart) WriteSVG(w io.Writer) error {
+	if c.Projection != Stereographic && c.Projection != Cartesian {
+		return fmt.Errorf("sunpath: unknown projection %v", c.Projection)
+	}
+	size := c.Size
+	if size <= 0 {
+		size = DefaultSize
+	}
+	pl := plotter{projection: c.Projection, width: float64(size), height: float64(size)}
+	if c.Projection == Cartesian {
+		pl.height = float64(size) / 2
+	}
+	top := 0
+	if c.Title != "" {
+		top = 30
+	}
+	height := int(pl.height) + top
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="11">`+"\n",
+		size, height, size, height)
+	bw.WriteString(`<rect width="100%" height="100%" fill="white"/>` + "\n")
+	if c.Title != "" {
+		fmt.Fprintf(bw, `<text x="%s" y="20" text-anchor="middle" font-size="15">%s</text>`+"\n", num(pl.width/2), escape(c.Title))
+	}
+	fmt.Fprintf(bw, `<g transform="translate(0 %d)">`+"\n", top)
+	pl.grid(bw)
+	for _, p := range c.Diagram.Paths {
+		pl.line(bw, "path", `stroke="#d95f02" stroke-width="1.5"`, p.Points)
+		if label, ok := pl.pathLabel(p); ok {
+			bw.WriteString(label)
+		}
+	}
+	for _, h := range c.Diagram.Hours {
+		pl.line(bw, "hour", `stroke="#1b9e77" stroke-dasharray="4 3"`, h.Points)
+		if len(h.Points) > 0 {
+			x, y := pl.xy(highest(h.Points))
+			fmt.Fprintf(bw, `<text class="hour" x="%s" y="%s" text-anchor="middle" fill="#1b9e77">%d</text>`+"\n", num(x), num(y-4), h.Hour)
+		}
+	}
+	for _, a := range c.Analemmas {
+		pl.line(bw, "analemma", `stroke="#7570b3" stroke-width="1.5"`, a)
+	}
+	bw.WriteString("</g>\n</svg>\n")
+	return bw.Flush()
+}
+
+// plotter places points on a chart.
+type plotter struct {
+	projection    Projection
+	width, height float64
+}
+
+// margin is the space left around the plotting area, in pixels.
+const margin = 30
+
+// xy returns the position on the chart of the sun at the point.
+func (pl plotter) xy(p Point) (x, y float64) {
+	if pl.projection == Cartesian {
+		return margin + p.Azimuth/360*(pl.width-2*margin), pl.height - margin - p.Altitude/90*(pl.height-2*margin)
+	}
+	radius := (pl.width - 2*margin) / 2
+	r := radius * math.Tan(degToRad*(90-p.Altitude)/2)
+	return pl.width/2 + r*math.Sin(degToRad*p.Azimuth), pl.width/2 - r*math.Cos(degToRad*p.Azimuth)
+}
+
+// grid draws the horizon, altitude circles or lines every 10°, and the
+// compass directions.
+func (pl plotter) grid(w *bufio.Writer) {
+	w.WriteString(`<g class="grid" stroke="#bbb" fill="none">` + "\n")
+	compass := []string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+	if pl.projection == Cartesian {
+		for alt := 0.0; alt <= 90; alt += 10 {
+			x0, y := pl.xy(Point{Azimuth: 0, Altitude: alt})
+			x1, _ := pl.xy(Point{Azimuth: 360, Altitude: alt})
+			fmt.Fprintf(w, `<line x1="%s" y1="%s" x2="%s" y2="%s"/>`+"\n", num(x0), num(y), num(x1), num(y))
+		}
+		for az := 0.0; az <= 360; az += 45 {
+			x, y0 := pl.xy(Point{Azimuth: az})
+			_, y1 := pl.xy(Point{Azimuth: az, Altitude: 90})
+			fmt.Fprintf(w, `<line x1="%s" y1="%s" x2="%s" y2="%s"/>`+"\n", num(x), num(y0), num(x), num(y1))
+		}
+		w.WriteString("</g>\n")
+		for i := 0; i <= 8; i++ {
+			x, y := pl.xy(Point{Azimuth: float64(i) * 45})
+			fmt.Fprintf(w, `<text x="%s" y="%s" text-anchor="middle">%s</text>`+"\n", num(x), num(y+15), compass[i%8])
+		}
+		for alt := 10; alt <= 90; alt += 10 {
+			x, y := pl.xy(Point{Altitude: float64(alt)})
+			fmt.Fprintf(w, `<text x="%s" y="%s" text-anchor="end">%d°</text>`+"\n", num(x-4), num(y+4), alt)
+		}
+		return
+	}
+	cx := pl.width / 2
+	for alt := 0.0; alt < 90; alt += 10 {
+		_, y := pl.xy(Point{Altitude: alt})
+		fmt.Fprintf(w, `<circle cx="%s" cy="%s" r="%s"/>`+"\n", num(cx), num(cx), num(cx-y))
+	}
+	for az := 0.0; az < 360; az += 45 {
+		x, y := pl.xy(Point{Azimuth: az})
+		fmt.Fprintf(w, `<line x1="%s" y1="%s" x2="%s" y2="%s"/>`+"\n", num(cx), num(cx), num(x), num(y))
+	}
+	w.WriteString("</g>\n")
+	for i, name := range compass {
+		x, y := pl.xy(Point{Azimuth: float64(i) * 45, Altitude: -4})
+		fmt.Fprintf(w, `<text x="%s" y="%s" text-anchor="middle">%s</text>`+"\n", num(x), num(y+4), name)
+	}
+	for alt := 10; alt < 90; alt += 10 {
+		x, y := pl.xy(Point{Azimuth: 0, Altitude: float64(alt)})
+		fmt.Fprintf(w, `<text x="%s" y="%s" fill="#888">%d°</text>`+"\n", num(x+3), num(y-2), alt)
+	}
+}
+
+// line draws the points above the horizon as polylines of the class,
+// breaking them where the sun goes below the horizon or, in a cartesian
+// chart, crosses north.
+func (pl plotter) line(w *bufio.Writer, class, style string, points []Point) {
+	var run []string
+	flush := func() {
+		if len(run) > 1 {
+			fmt.Fprintf(w, `<polyline class="%s" fill="none" %s points="%s"/>`+"\n", class, style, strings.Join(run, " "))
+		}
+		run = run[:0]
+	}
+	for i, p := range points {
+		if p.Altitude < 0 {
+			flush()
+			continue
+		}
+		if pl.projection == Cartesian && i > 0 && math.Abs(p.Azimuth-points[i-1].Azimuth) > 180 {
+			flush()
+		}
+		x, y := pl.xy(p)
+		run = append(run, num(x)+","+num(y))
+	}
+	flush()
+}
+
+// pathLabel labels a date path with its date beside its highest point.
+func (pl plotter) pathLabel(p Path) (string, bool) {
+	if len(p.Points) == 0 {
+		return "", false
+	}
+	x, y := pl.xy(highest(p.Points))
+	return fmt.Sprintf(`<text class="path" x="%s" y="%s" fill="#d95f02">%s</text>`+"\n", num(x+4), num(y-4), p.Date.Format("Jan 2")), true
+}
+
+// highest returns the point at which the sun is highest.
+func highest(points []Point) Point {
+	best := points[0]
+	for _, p := range points[1:] {
+		if p.Altitude > best.Altitude {
+			best = p
+		}
+	}
+	return best
+}
+
+// num formats a coordinate to a tenth of a pixel.
+func num(v float64) string {
+	return strconv.FormatFloat(v, 'f', 1, 64)
+}
+
+// escape escapes text for XML.
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
@@ -0,0 +1,102 @@
+package sunpath
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestChartWriteSVG(t *testing.T) {
+	o := astrotime.NewObserver(51.4769, -0.0005)
+	d, err := Generate(o, 2024, Config{SolarTime: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := Analemma(o, 2024, 9*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		projection Projection
+		height     string
+	}{
+		{Stereographic, "630"},
+		{Cartesian, "330"},
+	} {
+		var buf bytes.Buffer
+		c := Chart{Diagram: d, Analemmas: [][]Point{a}, Projection: test.projection, Title: "Greenwich <2024>"}
+		if err := c.WriteSVG(&buf); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(buf.String(), "Greenwich &lt;2024&gt;") {
+			t.Errorf("%v: title not escaped", test.projection)
+		}
+		classes := map[string]int{}
+		dec := xml.NewDecoder(&buf)
+		for {
+			tok, err := dec.Token()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("%v: %v", test.projection, err)
+			}
+			el, ok := tok.(xml.StartElement)
+			if !ok {
+				continue
+			}
+			for _, attr := range el.Attr {
+				switch {
+				case el.Name.Local == "svg" && attr.Name.Local == "height" && attr.Value != test.height:
+					t.Errorf("%v: got height %s, want %s", test.projection, attr.Value, test.height)
+				case el.Name.Local == "polyline" && attr.Name.Local == "class":
+					classes[attr.Value]++
+				}
+			}
+		}
+		// Twelve date paths, a line for each solar hour from 5 to 19, and
+		// the analemma.
+		want := map[string]int{"path": 12, "hour": 15, "analemma": 1}
+		for class, n := range want {
+			if classes[class] != n {
+				t.Errorf("%v: got %d %s lines, want %d", test.projection, classes[class], class, n)
+			}
+		}
+	}
+	if err := (Chart{Projection: 2}).WriteSVG(io.Discard); err == nil {
+		t.Error("got no error for an unknown projection")
+	}
+}
+
+func TestPlotter(t *testing.T) {
+	for _, test := range []struct {
+		projection Projection
+		p          Point
+		x, y       float64
+	}{
+		{Stereographic, Point{Altitude: 90}, 300, 300},
+		{Stereographic, Point{Azimuth: 0}, 300, 30},
+		{Stereographic, Point{Azimuth: 90}, 570, 300},
+		// Stereographically 45° up lies at tan 22.5° of the radius.
+		{Stereographic, Point{Azimuth: 180, Altitude: 45}, 300, 300 + 270*math.Tan(math.Pi/8)},
+		{Cartesian, Point{Azimuth: 0}, 30, 270},
+		{Cartesian, Point{Azimuth: 180, Altitude: 90}, 300, 30},
+	} {
+		pl := plotter{projection: test.projection, width: 600, height: 600}
+		if test.projection == Cartesian {
+			pl.height = 300
+		}
+		if x, y := pl.xy(test.p); math.Abs(x-test.x) > 1e-9 || math.Abs(y-test.y) > 1e-9 {
+			t.Errorf("%v %+v: got %v, %v, want %v, %v", test.projection, test.p, x, y, test.x, test.y)
+		}
+	}
+	if got := Projection(5).String(); got != "Projection(5)" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,179 @@
+package astrotime
+
+import (
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SunTimes is the sunrise and sunset at a place on one day.
+type SunTimes struct {
+	Sunrise, Sunset time.Time
+
+	// Err is the error for the sunrise, or failing that the sunset, which
+	// is then the zero Time: ErrAlwaysAbove or ErrAlwaysBelow on a polar
+	// day or night, or a *CoordinateError for an invalid place.
+	Err error
+}
+
+// batchChunk is how many places a worker of BatchSunTimes takes at a time.
+const batchChunk = 64
+
+// BatchSunTimes calculates the sunrise and sunset on the day t at each of
+// places, configured by opts as for NewObserver, spreading the work over a
+// worker per CPU. The results are in the order of places and are the same
+// as Observer.Sunrise and Observer.Sunset give; the settings, the day and
+// the sun's position at noon are worked out once for each place rather
+// than for each event. An error is only returned for a date out of range.
+func BatchSunTimes(t time.Time, places []LatLon, opts ...Option) ([]SunTimes, error) {
+	if err := ValidateDate(t); err != nil {
+		return nil, err
+	}
+	d := NewObserver(0, 0, opts...).onDay(t)
+	results := make([]SunTimes, len(places))
+	var next atomic.Int64
+	work := func() {
+		for {
+			start := int(next.Add(batchChunk)) - batchChunk
+			if start >= len(places) {
+				return
+			}
+			for k := start; k < min(start+batchChunk, len(places)); k++ {
+				results[k] = d.sunTimes(places[k])
+			}
+		}
+	}
+	workers := min(runtime.GOMAXPROCS(0), (len(places)+batchChunk-1)/batchChunk)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			work()
+		}()
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// SunTimesRange calculates the sunrise and sunset on each day from the day
+// of start to the day of end, inclusive, one SunTimes per day. Each day's
+// sunrise and sunset start from the same position of the sun at noon, so
+// the result matches calling Sunrise and Sunset for each day with a third
+// fewer evaluations of the solar series.
+func (o Observer) SunTimesRange(start, end time.Time) ([]SunTimes, error) {
+	var days []SunTimes
+	err := o.eachDay(start, end, func(d sunDay) {
+		days = append(days, d.sunTimes(LatLon{o.Lat, o.Lon}))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return days, nil
+}
+
+// eachDay calls fn with the sunDay of each day from the day of start to
+// the day of end, inclusive, in the observer's time zone.
+func (o Observer) eachDay(start, end time.Time, fn func(sunDay)) error {
+	if err := o.validate(start); err != nil {
+		return err
+	}
+	if err := o.validate(end); err != nil {
+		return err
+	}
+	start, end = o.local(start), o.local(end)
+	last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	for i := 0; ; i++ {
+		t := start.AddDate(0, 0, i)
+		if time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).After(last) {
+			return nil
+		}
+		fn(o.onDay(t))
+	}
+}
+
+// SunTimesRange calculates the sunrise and sunset on each day from the day
+// of start to the day of end at the location specified in latitude and
+// longitude.
+func SunTimesRange(start, end time.Time, latitude, longitude float64) ([]SunTimes, error) {
+	return Observer{Lat: latitude, Lon: longitude}.SunTimesRange(start, end)
+}
+
+// sunTimes calculates the SunTimes at the place on the day.
+func (d sunDay) sunTimes(place LatLon) SunTimes {
+	if err := ValidateCoordinates(place.Lat, place.Lon); err != nil {
+		return SunTimes{Err: err}
+	}
+	rise, set, riseErr, setErr := d.riseSet(d.noon(place.Lon), place.Lat, place.Lon)
+	if riseErr == nil {
+		riseErr = setErr
+	}
+	return SunTimes{Sunrise: rise, Sunset: set, Err: riseErr}
+}
+
+// sunDay holds what the sunrise and sunset calculations for an observer's
+// settings share between places on the day t.
+type sunDay struct {
+	o          Observer
+	t, day     time.Time
+	jd, zenith float64
+}
+
+// onDay returns the sunDay for the day t, in the observer's time zone.
+func (o Observer) onDay(t time.Time) sunDay {
+	t = o.local(t)
+	return sunDay{
+		o:      o,
+		t:      t,
+		day:    time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC),
+		jd:     o.julianDate(t),
+		zenith: o.zenith(),
+	}
+}
+
+// noon returns the noon terms at the longitude, shared by the sunrise and
+// sunset at every latitude. They are only used by AlgorithmNOAA.
+func (d sunDay) noon(longitude float64) noonTerms {
+	if d.o.algorithm != AlgorithmNOAA {
+		return noonTerms{}
+	}
+	return solarNoonTerms(d.jd, longitude)
+}
+
+// riseSet calculates the sunrise and sunset at the latitude and longitude,
+// whose noon terms are n, rounded to the observer's precision. They are as
+// Observer.Sunrise and Observer.Sunset calculate, without validation.
+func (d sunDay) riseSet(n noonTerms, latitude, longitude float64) (rise, set time.Time, riseErr, setErr error) {
+	rise, riseErr = d.crossing(n, latitude, longitude, d.zenith, true)
+	set, setErr = d.crossing(n, latitude, longitude, d.zenith, false)
+	return rise, set, riseErr, setErr
+}
+
+// crossing calculates when the rising or setting sun's centre crosses the
+// zenith angle at the latitude and longitude, whose noon terms are n,
+// rounded to the observer's precision.
+func (d sunDay) crossing(n noonTerms, latitude, longitude, zenith float64, rising bool) (time.Time, error) {
+	if d.o.algorithm != AlgorithmNOAA {
+		calc := d.o.sunset
+		if rising {
+			calc = d.o.sunrise
+		}
+		s, err := calc(d.t, latitude, longitude, zenith)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return d.o.round(s), nil
+	}
+	var m float64
+	if rising {
+		m = sunriseFromNoon(n, latitude, longitude, zenith, d.o.refinement())
+	} else {
+		m = sunsetFromNoon(n, latitude, longitude, zenith, d.o.refinement())
+	}
+	if math.IsNaN(m) {
+		return time.Time{}, noEventFromNoon(n, latitude, zenith)
+	}
+	return d.o.round(d.day.Add(time.Duration(m * float64(time.Minute))).In(d.t.Location())), nil
+}
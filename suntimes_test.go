@@ -0,0 +1,78 @@
+package astrotime
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestBatchSunTimes(t *testing.T) {
+	var places []LatLon
+	for lat := -90.0; lat <= 90; lat += 7.5 {
+		for lon := -180.0; lon <= 180; lon += 11.25 {
+			places = append(places, LatLon{lat, lon})
+		}
+	}
+	places = append(places, LatLon{95, 0})
+	day := p("2024-03-20T12:00:00+01:00")
+	for _, opts := range [][]Option{nil, {WithAlgorithm(AlgorithmVSOP87), WithPrecision(1)}} {
+		got, err := BatchSunTimes(day, places, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(places) {
+			t.Fatalf("got %d results, want %d", len(got), len(places))
+		}
+		for k, pl := range places {
+			o := NewObserver(pl.Lat, pl.Lon, opts...)
+			rise, riseErr := o.Sunrise(day)
+			set, setErr := o.Sunset(day)
+			if riseErr == nil {
+				riseErr = setErr
+			}
+			r := got[k]
+			if !r.Sunrise.Equal(rise) || !r.Sunset.Equal(set) || fmt.Sprint(r.Err) != fmt.Sprint(riseErr) {
+				t.Errorf("%+v: got %+v, want %s, %s, %v", pl, r, rise, set, riseErr)
+			}
+		}
+		if !errors.Is(got[len(got)-1].Err, ErrInvalidCoordinates) {
+			t.Error("latitude 95 accepted")
+		}
+	}
+	if _, err := BatchSunTimes(p("3500-01-01T00:00:00Z"), places); !errors.Is(err, ErrDateOutOfRange) {
+		t.Errorf("got %v, want ErrDateOutOfRange", err)
+	}
+}
+
+func TestSunTimesRange(t *testing.T) {
+	start, end := p("2024-01-01T06:30:00+01:00"), p("2024-12-31T23:00:00+01:00")
+	for _, o := range []Observer{
+		NewObserver(48.85, 2.35),
+		NewObserver(78.22, 15.65, WithDeltaT(true)),
+	} {
+		days, err := o.SunTimesRange(start, end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(days) != 366 {
+			t.Fatalf("got %d days, want 366", len(days))
+		}
+		for i, d := range days {
+			day := start.AddDate(0, 0, i)
+			rise, riseErr := o.Sunrise(day)
+			set, setErr := o.Sunset(day)
+			if riseErr == nil {
+				riseErr = setErr
+			}
+			if !d.Sunrise.Equal(rise) || !d.Sunset.Equal(set) || d.Err != riseErr {
+				t.Errorf("%v on %s: got %+v, want %s, %s, %v", o.Lat, day.Format("2006-01-02"), d, rise, set, riseErr)
+			}
+		}
+	}
+	if days, _ := SunTimesRange(end, start, 0, 0); len(days) != 0 {
+		t.Errorf("got %d days for a reversed span, want 0", len(days))
+	}
+	if _, err := SunTimesRange(start, end, 91, 0); !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("got %v, want ErrInvalidCoordinates", err)
+	}
+}
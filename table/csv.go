@@ -0,0 +1,87 @@
+package table
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+)
+
+// CSVWriter writes the rows of a table as CSV, for spreadsheets and data
+// pipelines: a header of the column names, then a record for each row.
+// Dates are written as 2006-01-02, times in RFC 3339 form with the offset
+// of the row's time zone, and day lengths as hours, minutes and seconds,
+// such as 16:38:10. Events which do not happen that day are left empty.
+//
+// Its Write method has the signature Generate calls, so that a table can be
+// written out a row at a time as it is made:
+//
+//	w := table.NewCSVWriter(os.Stdout, table.AllColumns)
+//	if err := table.Generate(o, start, end, w.Write); err != nil {
+//		return err
+//	}
+//	return w.Flush()
+type CSVWriter struct {
+	w      *csv.Writer
+	cols   []Column
+	header bool
+	record []string
+}
+
+// NewCSVWriter returns a CSVWriter writing the columns to w.
+func NewCSVWriter(w io.Writer, cols []Column) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w), cols: cols, record: make([]string, len(cols))}
+}
+
+// Write writes the row, after the header if it is the first.
+func (w *CSVWriter) Write(r Row) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	for i, c := range w.cols {
+		w.record[i] = csvCell(r, c)
+	}
+	return w.w.Write(w.record)
+}
+
+// Flush writes the header if no row was written, then any buffered data to
+// the underlying io.Writer, returning any error from writing.
+func (w *CSVWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// writeHeader writes the header unless it has been written.
+func (w *CSVWriter) writeHeader() error {
+	if w.header {
+		return nil
+	}
+	w.header = true
+	for i, c := range w.cols {
+		w.record[i] = c.String()
+	}
+	return w.w.Write(w.record)
+}
+
+// csvCell formats the column of the row for CSV.
+func csvCell(r Row, c Column) string {
+	switch c {
+	case Date:
+		return r.Date.Format("2006-01-02")
+	case DayLength:
+		d := r.DayLength().Round(time.Second)
+		return fmt.Sprintf("%d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+	}
+	kind, ok := c.Event()
+	if !ok {
+		return ""
+	}
+	t, err := r.Time(kind)
+	if err != nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,67 @@
+package table
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestCSVWriter(t *testing.T) {
+	var b strings.Builder
+	w := NewCSVWriter(&b, []Column{Date, Sunrise, Sunset, CivilDusk, DayLength})
+	o := astrotime.Observer{Lat: 69.65, Lon: 18.96, Location: time.UTC}
+	if err := Generate(o, time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC), time.Date(2024, 5, 18, 0, 0, 0, 0, time.UTC), w.Write); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(b.String(), "\n")
+	if len(lines) != 5 || lines[4] != "" {
+		t.Fatalf("got %d lines, want a header, 3 rows and a newline:\n%s", len(lines), b.String())
+	}
+	if want := "date,sunrise,sunset,civil_dusk,day_length"; lines[0] != want {
+		t.Errorf("got header %q, want %q", lines[0], want)
+	}
+	if want := "2024-05-16,2024-05-15T23:25:16Z,2024-05-16T22:12:20Z,,22:47:04"; lines[1] != want {
+		t.Errorf("got %q, want %q", lines[1], want)
+	}
+	if want := "2024-05-18,,,,24:00:00"; lines[3] != want {
+		t.Errorf("got %q, want %q", lines[3], want)
+	}
+}
+
+func TestCSVWriterEmpty(t *testing.T) {
+	var b strings.Builder
+	w := NewCSVWriter(&b, DefaultColumns)
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if want := "date,sunrise,solar_noon,sunset,day_length\n"; b.String() != want {
+		t.Errorf("got %q, want %q", b.String(), want)
+	}
+}
+
+func TestCSVCell(t *testing.T) {
+	loc := time.FixedZone("", 2*3600)
+	o := astrotime.Observer{Lat: 51.4769, Lon: -0.0005, Location: loc}
+	rows, err := collect(o, time.Date(2024, 6, 20, 0, 0, 0, 0, loc), time.Date(2024, 6, 20, 0, 0, 0, 0, loc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		c    Column
+		want string
+	}{
+		{Date, "2024-06-20"},
+		{Sunrise, "2024-06-20T05:42:39+02:00"},
+		{AstronomicalDawn, ""},
+		{DayLength, "16:38:09"},
+	} {
+		if got := csvCell(rows[0], tt.c); got != tt.want {
+			t.Errorf("%v: got %q, want %q", tt.c, got, tt.want)
+		}
+	}
+}
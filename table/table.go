@@ -0,0 +1,187 @@
+// Package table generates tables of the sun's daily events at a place, one
+// row per day, such as the sunrise and sunset tables printed for a month or
+// a year.
+package table
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// Column is a column of a table.
+type Column int
+
+// Columns, in the order of DefaultColumns and of the events of a day.
+const (
+	Date Column = iota
+	AstronomicalDawn
+	NauticalDawn
+	CivilDawn
+	Sunrise
+	SolarNoon
+	Sunset
+	CivilDusk
+	NauticalDusk
+	AstronomicalDusk
+	DayLength
+)
+
+var columnNames = [...]string{
+	Date:             "date",
+	AstronomicalDawn: "astronomical_dawn",
+	NauticalDawn:     "nautical_dawn",
+	CivilDawn:        "civil_dawn",
+	Sunrise:          "sunrise",
+	SolarNoon:        "solar_noon",
+	Sunset:           "sunset",
+	CivilDusk:        "civil_dusk",
+	NauticalDusk:     "nautical_dusk",
+	AstronomicalDusk: "astronomical_dusk",
+	DayLength:        "day_length",
+}
+
+// String returns the name of the column, as accepted by ParseColumns.
+func (c Column) String() string {
+	if c < 0 || int(c) >= len(columnNames) {
+		return "Column(" + strconv.Itoa(int(c)) + ")"
+	}
+	return columnNames[c]
+}
+
+// Event returns the event the column shows, and false for Date and
+// DayLength.
+func (c Column) Event() (astrotime.EventKind, bool) {
+	if c <= Date || c >= DayLength {
+		return 0, false
+	}
+	return astrotime.EventKind(c - AstronomicalDawn), true
+}
+
+// DefaultColumns are the columns of a table unless others are chosen.
+var DefaultColumns = []Column{Date, Sunrise, SolarNoon, Sunset, DayLength}
+
+// AllColumns are every column, in order.
+var AllColumns = []Column{Date, AstronomicalDawn, NauticalDawn, CivilDawn, Sunrise, SolarNoon, Sunset, CivilDusk, NauticalDusk, AstronomicalDusk, DayLength}
+
+// ParseColumns parses a comma-separated list of column names, such as
+// "date,sunrise,sunset".
+func ParseColumns(s string) ([]Column, error) {
+	var cols []Column
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		c := Column(-1)
+		for i, n := range columnNames {
+			if n == name {
+				c = Column(i)
+			}
+		}
+		if c < 0 {
+			return nil, fmt.Errorf("table: unknown column %q", name)
+		}
+		cols = append(cols, c)
+	}
+	return cols, nil
+}
+
+// Row is a day of a table.
+type Row struct {
+	// Date is midnight at the start of the day, in the observer's time
+	// zone.
+	Date time.Time
+
+	times [DayLength - AstronomicalDawn]time.Time
+	errs  [DayLength - AstronomicalDawn]error
+}
+
+// Time returns the time of the event kind on the day, or ErrAlwaysAbove or
+// ErrAlwaysBelow if it does not happen. It returns an error for a kind that
+// is not one of the events of a day.
+func (r Row) Time(kind astrotime.EventKind) (time.Time, error) {
+	if kind < 0 || int(kind) >= len(r.times) {
+		return time.Time{}, fmt.Errorf("table: unknown event kind %v", kind)
+	}
+	return r.times[kind], r.errs[kind]
+}
+
+// DayLength returns the time from sunrise to sunset: 24 hours if the sun
+// does not rise or set that day because it stays up, and zero if it stays
+// down.
+func (r Row) DayLength() time.Duration {
+	rise, err := r.Time(astrotime.EventSunrise)
+	set, serr := r.Time(astrotime.EventSunset)
+	switch {
+	case errors.Is(err, astrotime.ErrAlwaysAbove), errors.Is(serr, astrotime.ErrAlwaysAbove):
+		return 24 * time.Hour
+	case err != nil || serr != nil:
+		return 0
+	}
+	return set.Sub(rise)
+}
+
+// Generate calculates the rows of the table for o from the day of start to
+// the day of end, inclusive, calling fn with each in turn and stopping at
+// the first error fn returns, so that rows can be written out as they are
+// made.
+func Generate(o astrotime.Observer, start, end time.Time, fn func(Row) error) error {
+	if o.Location != nil {
+		start, end = start.In(o.Location), end.In(o.Location)
+	}
+	y, m, d := start.Date()
+	last := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+	for i := 0; ; i++ {
+		day := time.Date(y, m, d+i, 0, 0, 0, 0, start.Location())
+		if time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC).After(last) {
+			return nil
+		}
+		r := Row{Date: day}
+		for k := range r.times {
+			r.times[k], r.errs[k] = o.EventTime(day, astrotime.EventKind(k))
+			if r.errs[k] != nil && !errors.Is(r.errs[k], astrotime.ErrAlwaysAbove) && !errors.Is(r.errs[k], astrotime.ErrAlwaysBelow) {
+				return r.errs[k]
+			}
+		}
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+}
+
+// Month returns the rows of the table for o for the month of the year, in
+// the observer's time zone or, if it has none, in UTC.
+func Month(o astrotime.Observer, year int, month time.Month) ([]Row, error) {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, location(o))
+	return collect(o, start, start.AddDate(0, 1, -1))
+}
+
+// Year returns the rows of the table for o for the year, in the observer's
+// time zone or, if it has none, in UTC.
+func Year(o astrotime.Observer, year int) ([]Row, error) {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, location(o))
+	return collect(o, start, start.AddDate(1, 0, -1))
+}
+
+// location returns the observer's time zone, or UTC.
+func location(o astrotime.Observer) *time.Location {
+	if o.Location == nil {
+		return time.UTC
+	}
+	return o.Location
+}
+
+// collect returns the rows from the day of start to the day of end.
+func collect(o astrotime.Observer, start, end time.Time) ([]Row, error) {
+	var rows []Row
+	err := Generate(o, start, end, func(r Row) error {
+		rows = append(rows, r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,107 @@
+package table
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestParseColumns(t *testing.T) {
+	cols, err := ParseColumns("date, Sunrise,day_length")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cols) != 3 || cols[0] != Date || cols[1] != Sunrise || cols[2] != DayLength {
+		t.Errorf("got %v", cols)
+	}
+	if _, err := ParseColumns("date,moonrise"); err == nil {
+		t.Error("moonrise accepted")
+	}
+	for _, c := range AllColumns {
+		if got, err := ParseColumns(c.String()); err != nil || got[0] != c {
+			t.Errorf("ParseColumns(%q) = %v, %v", c, got, err)
+		}
+	}
+}
+
+func TestColumnEvent(t *testing.T) {
+	if k, ok := Sunrise.Event(); !ok || k != astrotime.EventSunrise {
+		t.Errorf("Sunrise.Event() = %v, %v", k, ok)
+	}
+	if k, ok := AstronomicalDusk.Event(); !ok || k != astrotime.EventAstronomicalDusk {
+		t.Errorf("AstronomicalDusk.Event() = %v, %v", k, ok)
+	}
+	if _, ok := DayLength.Event(); ok {
+		t.Error("DayLength has an event")
+	}
+}
+
+func TestMonth(t *testing.T) {
+	oslo, err := time.LoadLocation("Europe/Oslo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := astrotime.NewObserver(69.65, 18.96)
+	o.Location = oslo
+	rows, err := Month(o, 2024, time.May)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 31 {
+		t.Fatalf("got %d rows, want 31", len(rows))
+	}
+	for i, r := range rows {
+		want := time.Date(2024, time.May, 1+i, 0, 0, 0, 0, oslo)
+		if !r.Date.Equal(want) {
+			t.Errorf("row %d is for %s, want %s", i, r.Date, want)
+		}
+		rise, err := r.Time(astrotime.EventSunrise)
+		wantRise, wantErr := o.Sunrise(r.Date)
+		if !rise.Equal(wantRise) || err != wantErr {
+			t.Errorf("%s: sunrise %s, %v, want %s, %v", r.Date, rise, err, wantRise, wantErr)
+		}
+	}
+	// Tromsø's midnight sun begins in mid-May: on the 17th the sun
+	// rises but does not set.
+	if _, err := rows[16].Time(astrotime.EventSunset); !errors.Is(err, astrotime.ErrAlwaysAbove) {
+		t.Errorf("sunset on 17 May: %v, want ErrAlwaysAbove", err)
+	}
+	if d := rows[16].DayLength(); d != 24*time.Hour {
+		t.Errorf("day length on 17 May %s, want 24h", d)
+	}
+	if d := rows[0].DayLength(); d < 18*time.Hour || d > 19*time.Hour {
+		t.Errorf("day length on 1 May %s, want about 18h52m", d)
+	}
+	for _, kind := range []astrotime.EventKind{-1, astrotime.EventAstronomicalDusk + 1, 42} {
+		if tm, err := rows[0].Time(kind); err == nil || !tm.IsZero() {
+			t.Errorf("Time(%v) = %v, %v, want an error", kind, tm, err)
+		}
+	}
+}
+
+func TestYear(t *testing.T) {
+	rows, err := Year(astrotime.NewObserver(-33.87, 151.21), 2023)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 365 || rows[0].Date.Location() != time.UTC {
+		t.Errorf("got %d rows in %v, want 365 in UTC", len(rows), rows[0].Date.Location())
+	}
+	var n int
+	stop := errors.New("stop")
+	err = Generate(astrotime.NewObserver(0, 0), rows[0].Date, rows[364].Date, func(Row) error {
+		n++
+		if n == 10 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || n != 10 {
+		t.Errorf("Generate stopped after %d rows with %v, want 10 and the callback's error", n, err)
+	}
+	if _, err := Year(astrotime.NewObserver(91, 0), 2023); err == nil {
+		t.Error("latitude 91 accepted")
+	}
+}
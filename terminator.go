@@ -0,0 +1,43 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// LatLon is a place on the earth, by latitude and longitude in degrees,
+// north and east positive.
+type LatLon struct {
+	Lat, Lon float64
+}
+
+// Terminator calculates the line on the earth at t along which the sun's
+// centre stands depression degrees below the horizon, as points places
+// evenly spaced round it: 0 for the geometric day/night terminator, 0.833
+// for sunrise and sunset with standard refraction, 6, 12 or 18 for the
+// edges of civil, nautical and astronomical twilight. The line is a circle
+// 90° plus the depression from the subsolar point, and the points follow it
+// in order of bearing from the subsolar point, clockwise from north, the
+// night side lying beyond them. Longitudes run from −180° to 180°, so a map
+// must split the line where it crosses the antimeridian. Terminator returns
+// nil if points is less than 3.
+func Terminator(t time.Time, points int, depression float64) []LatLon {
+	if points < 3 {
+		return nil
+	}
+	lat, lon := SubsolarPoint(t)
+	phi, lambda := degToRad*lat, degToRad*lon
+	delta := degToRad * (90 + depression)
+	line := make([]LatLon, points)
+	for i := range line {
+		theta := 2 * math.Pi * float64(i) / float64(points)
+		sinPhi := math.Sin(phi)*math.Cos(delta) + math.Cos(phi)*math.Sin(delta)*math.Cos(theta)
+		p := math.Asin(math.Max(-1, math.Min(1, sinPhi)))
+		l := lambda + math.Atan2(math.Sin(theta)*math.Sin(delta)*math.Cos(phi), math.Cos(delta)-math.Sin(phi)*sinPhi)
+		line[i] = LatLon{
+			Lat: radToDeg * p,
+			Lon: math.Mod(math.Mod(radToDeg*l+180, 360)+360, 360) - 180,
+		}
+	}
+	return line
+}
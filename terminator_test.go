@@ -0,0 +1,38 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTerminator(t *testing.T) {
+	at := p("2024-06-20T12:00:00Z")
+	for _, depression := range []float64{0, 0.833, 6, 18} {
+		line := Terminator(at, 72, depression)
+		if len(line) != 72 {
+			t.Fatalf("Terminator(%v) gave %d points, want 72", depression, len(line))
+		}
+		for _, pt := range line {
+			if pt.Lat < -90 || pt.Lat > 90 || pt.Lon < -180 || pt.Lon >= 180 {
+				t.Errorf("depression %v: point %+v out of range", depression, pt)
+			}
+			_, alt := sunPosition(at, pt.Lat, pt.Lon)
+			if math.Abs(alt+depression) > 0.02 {
+				t.Errorf("depression %v: sun at %.3f° at %+v, want %v", depression, alt, pt, -depression)
+			}
+		}
+	}
+	// Near the June solstice the line runs round the Arctic and Antarctic
+	// circles: the first point is on the far side of the pole from the sun,
+	// the opposite one short of the South Pole.
+	line := Terminator(at, 4, 0)
+	if math.Abs(line[0].Lat-66.56) > 0.01 || math.Abs(line[0].Lon-(-179.57)) > 0.01 {
+		t.Errorf("northernmost point %+v, want 66.56, -179.57", line[0])
+	}
+	if math.Abs(line[2].Lat-(-66.56)) > 0.01 || math.Abs(line[2].Lon-0.43) > 0.01 {
+		t.Errorf("southernmost point %+v, want -66.56, 0.43", line[2])
+	}
+	if Terminator(at, 2, 0) != nil {
+		t.Error("Terminator with 2 points is not nil")
+	}
+}
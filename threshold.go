@@ -0,0 +1,98 @@
+package astrotime
+
+import (
+	"fmt"
+	"time"
+)
+
+// FirstSunsetAfter finds the first day of a calendar year in the
+// observer's time zone, or UTC if it has none, on which the sun sets after
+// the clock time, written "15:04" or "15:04:05", having set at or before
+// it the day before, and returns that day's sunset. It is the day the
+// evenings first stay light past the hour, daylight saving time included;
+// where the sunset is after the hour all year, or never, it returns
+// ErrNoSuchDay. Days without a sunset do not meet the condition.
+func (o Observer) FirstSunsetAfter(year int, clock string) (time.Time, error) {
+	return o.firstDay(year, clock, false, true)
+}
+
+// FirstSunsetBefore finds the first day of a year on which the sun sets
+// before the clock time, as FirstSunsetAfter.
+func (o Observer) FirstSunsetBefore(year int, clock string) (time.Time, error) {
+	return o.firstDay(year, clock, false, false)
+}
+
+// FirstSunriseAfter finds the first day of a year on which the sun rises
+// after the clock time, as FirstSunsetAfter.
+func (o Observer) FirstSunriseAfter(year int, clock string) (time.Time, error) {
+	return o.firstDay(year, clock, true, true)
+}
+
+// FirstSunriseBefore finds the first day of a year on which the sun rises
+// before the clock time, as FirstSunsetAfter.
+func (o Observer) FirstSunriseBefore(year int, clock string) (time.Time, error) {
+	return o.firstDay(year, clock, true, false)
+}
+
+// FirstSunsetAfter finds the first day of a year on which the sun sets
+// after the clock time at the location specified in latitude and
+// longitude, in UTC. It returns the zero Time if there is none or the
+// arguments are invalid; use Observer.FirstSunsetAfter to find out why.
+func FirstSunsetAfter(year int, clock string, latitude, longitude float64) time.Time {
+	s, _ := Observer{Lat: latitude, Lon: longitude}.FirstSunsetAfter(year, clock)
+	return s
+}
+
+// FirstSunriseBefore finds the first day of a year on which the sun rises
+// before the clock time at the location specified in latitude and
+// longitude, as FirstSunsetAfter.
+func FirstSunriseBefore(year int, clock string, latitude, longitude float64) time.Time {
+	s, _ := Observer{Lat: latitude, Lon: longitude}.FirstSunriseBefore(year, clock)
+	return s
+}
+
+// firstDay finds the first day of the year whose sunrise, or sunset,
+// falls after, or before, the clock time when the previous day's did not.
+func (o Observer) firstDay(year int, s string, rising, after bool) (time.Time, error) {
+	threshold, err := parseClock(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, o.zone())
+	days, err := o.SunTimesRange(start.AddDate(0, 0, -1), start.AddDate(1, 0, -1))
+	if err != nil {
+		return time.Time{}, err
+	}
+	holds := func(d SunTimes) bool {
+		t := d.Sunset
+		if rising {
+			t = d.Sunrise
+		}
+		if t.IsZero() {
+			return false
+		}
+		if after {
+			return clock(t) > threshold
+		}
+		return clock(t) < threshold
+	}
+	for i := 1; i < len(days); i++ {
+		if holds(days[i]) && !holds(days[i-1]) {
+			if rising {
+				return days[i].Sunrise, nil
+			}
+			return days[i].Sunset, nil
+		}
+	}
+	return time.Time{}, ErrNoSuchDay
+}
+
+// parseClock parses a time of day written "15:04" or "15:04:05".
+func parseClock(s string) (time.Duration, error) {
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return clock(t), nil
+		}
+	}
+	return 0, fmt.Errorf("astrotime: cannot parse clock time %q", s)
+}
@@ -0,0 +1,45 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFirstSunsetAfter(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skip(err)
+	}
+	o := Observer{Lat: 51.4769, Lon: -0.0005, Location: london}
+	for _, test := range []struct {
+		name  string
+		find  func(int, string) (time.Time, error)
+		clock string
+		want  time.Time
+		err   error
+	}{
+		{"FirstSunsetAfter", o.FirstSunsetAfter, "18:00", time.Date(2024, time.March, 12, 18, 0, 12, 0, london), nil},
+		// The sunset is after 16:00 on 1 January, but not in mid December.
+		{"FirstSunsetAfter", o.FirstSunsetAfter, "16:00", time.Date(2024, time.December, 30, 16, 0, 2, 0, london), nil},
+		{"FirstSunsetAfter", o.FirstSunsetAfter, "23:00", time.Time{}, ErrNoSuchDay},
+		{"FirstSunsetBefore", o.FirstSunsetBefore, "17:00", time.Date(2024, time.October, 27, 16, 41, 23, 0, london), nil},
+		{"FirstSunriseBefore", o.FirstSunriseBefore, "07:00", time.Date(2024, time.February, 23, 6, 59, 0, 0, london), nil},
+		// Summer time pushes the sunrise back past 07:00 in October.
+		{"FirstSunriseAfter", o.FirstSunriseAfter, "07:00", time.Date(2024, time.October, 1, 7, 1, 22, 0, london), nil},
+	} {
+		got, err := test.find(2024, test.clock)
+		if !got.Equal(test.want) || err != test.err {
+			t.Errorf("%s(%s): got %s, %v, want %s, %v", test.name, test.clock, got, err, test.want, test.err)
+		}
+	}
+	if _, err := o.FirstSunsetAfter(2024, "6pm"); err == nil {
+		t.Error("got no error for an unparsable clock time")
+	}
+	want := time.Date(2024, time.March, 13, 18, 1, 55, 0, time.UTC)
+	if got := FirstSunsetAfter(2024, "18:00:30", 51.4769, -0.0005); !got.Equal(want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if got := FirstSunriseBefore(2024, "07:00", 91, 0); !got.IsZero() {
+		t.Errorf("got %s for an invalid latitude, want the zero Time", got)
+	}
+}
@@ -0,0 +1,91 @@
+package astrotime
+
+import (
+	"sync"
+	"time"
+)
+
+// tickerPoll is the longest a SunTicker sleeps before checking the wall
+// clock again, so that clock changes are noticed promptly.
+const tickerPoll = time.Minute
+
+// A SunTicker holds a channel that delivers each occurrence of a solar event
+// for an observer, in the manner of a time.Ticker.
+type SunTicker struct {
+	C <-chan Event // The channel on which the events are delivered.
+
+	stop chan struct{}
+	once sync.Once
+}
+
+// NewSunTicker returns a SunTicker delivering each occurrence of kind seen by
+// o. Events are timed against the wall clock, which is checked at least once
+// a minute, so changes to the system clock or to daylight saving time do not
+// delay them. As with a time.Ticker, an event is dropped if the previous one
+// has not been received; after the clock jumps forward past several events
+// only the latest is delivered. Stop the ticker to release its resources.
+func NewSunTicker(o Observer, kind EventKind) (*SunTicker, error) {
+	return newSunTicker(o, kind, time.Now, tickerPoll)
+}
+
+func newSunTicker(o Observer, kind EventKind, now func() time.Time, poll time.Duration) (*SunTicker, error) {
+	if _, err := o.EventTime(now(), kind); err != nil && !isNoEvent(err) {
+		return nil, err
+	}
+	c := make(chan Event, 1)
+	t := &SunTicker{C: c, stop: make(chan struct{})}
+	go t.run(o, kind, c, now, poll)
+	return t, nil
+}
+
+// Stop turns off the ticker. Stop does not close C, to prevent a concurrent
+// receive from seeing an erroneous event.
+func (t *SunTicker) Stop() {
+	t.once.Do(func() { close(t.stop) })
+}
+
+func (t *SunTicker) run(o Observer, kind EventKind, c chan<- Event, now func() time.Time, poll time.Duration) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	var next Event
+	for {
+		select {
+		case <-t.stop:
+			return
+		case <-timer.C:
+		}
+
+		n := now()
+		if !next.Time.IsZero() && !n.Before(next.Time) {
+			// The clock may have jumped past several events.
+			for {
+				later, err := o.NextEvent(next.Time, kind)
+				if err != nil || later.Time.After(n) {
+					break
+				}
+				next = later
+			}
+			select {
+			case c <- next:
+			default:
+			}
+			next = Event{}
+		}
+		if next.Time.IsZero() {
+			var err error
+			if next, err = o.NextEvent(n, kind); err != nil {
+				// Nothing for a year: try again a day later.
+				next = Event{}
+				timer.Reset(24 * time.Hour)
+				continue
+			}
+		}
+
+		wait := next.Time.Sub(n)
+		if wait > poll {
+			wait = poll
+		}
+		timer.Reset(wait)
+	}
+}
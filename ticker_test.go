@@ -0,0 +1,102 @@
+package astrotime
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a settable wall clock.
+type fakeClock struct {
+	mu sync.Mutex
+	t  time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.t
+}
+
+func (c *fakeClock) set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.t = t
+}
+
+func TestSunTicker(t *testing.T) {
+	d := places["manila"].times[0]
+	o := Observer{Lat: 14.5995, Lon: 120.9842}
+	clock := &fakeClock{t: d.sunset.Add(-time.Hour)}
+	ticker, err := newSunTicker(o, EventSunset, clock.now, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ticker.Stop()
+
+	select {
+	case ev := <-ticker.C:
+		t.Fatalf("got event %v at %s before the clock reached it", ev.Kind, ev.Time)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	// Jump the clock past sunset, as after a clock change.
+	clock.set(d.sunset.Add(time.Minute))
+	select {
+	case ev := <-ticker.C:
+		if ev.Kind != EventSunset || ev.Time.Sub(d.sunset).Abs() > time.Minute {
+			t.Errorf("got event %v at %s, want sunset at %s", ev.Kind, ev.Time, d.sunset)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("got no event after the clock passed sunset")
+	}
+}
+
+func TestSunTickerJump(t *testing.T) {
+	d := places["manila"].times[0]
+	o := Observer{Lat: 14.5995, Lon: 120.9842}
+	clock := &fakeClock{t: d.sunset.Add(-time.Hour)}
+	ticker, err := newSunTicker(o, EventSunset, clock.now, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ticker.Stop()
+	time.Sleep(20 * time.Millisecond)
+
+	// Jump the clock past two sunsets: only the second is delivered.
+	want := d.sunset.AddDate(0, 0, 1)
+	clock.set(want.Add(time.Minute))
+	select {
+	case ev := <-ticker.C:
+		if ev.Time.Sub(want).Abs() > time.Minute {
+			t.Errorf("got sunset at %s, want %s", ev.Time, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("got no event after the clock passed two sunsets")
+	}
+	select {
+	case ev := <-ticker.C:
+		t.Errorf("got a second event at %s", ev.Time)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestSunTickerStop(t *testing.T) {
+	clock := &fakeClock{t: p("2017-07-10T00:00:00Z")}
+	ticker, err := newSunTicker(Observer{Lat: 14.5995, Lon: 120.9842}, EventSunrise, clock.now, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ticker.Stop()
+	ticker.Stop()
+	clock.set(p("2017-07-12T00:00:00Z"))
+	select {
+	case ev := <-ticker.C:
+		t.Errorf("got event %v after Stop", ev.Kind)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	if _, err := NewSunTicker(Observer{Lat: 100}, EventSunrise); err == nil {
+		t.Error("got no error for an invalid observer")
+	}
+}
@@ -0,0 +1,84 @@
+package astrotime
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// TimezoneResolver finds the time zone of a place, so that results can be
+// given in the place's own time rather than that of the times passed in.
+// The package has no boundaries of its own; adapt a time zone database
+// with TimezoneFunc or TimezoneNameFunc, or use NauticalTimezone at sea.
+type TimezoneResolver interface {
+	// Timezone returns the time zone in use at the latitude and longitude,
+	// or an error wrapping ErrNoTimezone if it does not know one.
+	Timezone(latitude, longitude float64) (*time.Location, error)
+}
+
+// TimezoneFunc adapts a function to a TimezoneResolver.
+type TimezoneFunc func(latitude, longitude float64) (*time.Location, error)
+
+// Timezone calls f.
+func (f TimezoneFunc) Timezone(latitude, longitude float64) (*time.Location, error) {
+	return f(latitude, longitude)
+}
+
+// TimezoneNameFunc adapts a function returning the IANA name of the time
+// zone at a place, such as "Europe/Oslo", or "" if there is none, to a
+// TimezoneResolver, as lookup libraries built on the timezone-boundary
+// data provide. Zones are loaded with time.LoadLocation.
+type TimezoneNameFunc func(latitude, longitude float64) string
+
+// Timezone loads the zone f names for the place.
+func (f TimezoneNameFunc) Timezone(latitude, longitude float64) (*time.Location, error) {
+	name := f(latitude, longitude)
+	if name == "" {
+		return nil, fmt.Errorf("%w at %v", ErrNoTimezone, LatLon{latitude, longitude})
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w at %v: %v", ErrNoTimezone, LatLon{latitude, longitude}, err)
+	}
+	return loc, nil
+}
+
+// NauticalTimezone returns a resolver of the nautical time zone of a
+// place: 15° of longitude wide and centred on a multiple of 15°, an hour
+// from UTC for each, with the date line dividing the zone at 180° into
+// UTC+12 to its west and UTC−12 to its east. It is what ships at sea keep,
+// and approximates civil time ashore where no database is at hand.
+func NauticalTimezone() TimezoneResolver {
+	return nauticalResolver{}
+}
+
+// nauticalResolver resolves nautical time zones.
+type nauticalResolver struct{}
+
+// Timezone returns the nautical time zone at the longitude.
+func (nauticalResolver) Timezone(latitude, longitude float64) (*time.Location, error) {
+	if err := ValidateCoordinates(latitude, longitude); err != nil {
+		return nil, err
+	}
+	hours := int(math.Round(longitude / 15))
+	name := "UTC"
+	if hours != 0 {
+		name = fmt.Sprintf("UTC%+d", hours)
+	}
+	return time.FixedZone(name, hours*3600), nil
+}
+
+// Resolve returns a copy of the observer whose Location is the time zone r
+// finds for its latitude and longitude, so that every result is given in
+// the place's own time.
+func (o Observer) Resolve(r TimezoneResolver) (Observer, error) {
+	if err := ValidateCoordinates(o.Lat, o.Lon); err != nil {
+		return o, err
+	}
+	loc, err := r.Timezone(o.Lat, o.Lon)
+	if err != nil {
+		return o, err
+	}
+	o.Location = loc
+	return o, nil
+}
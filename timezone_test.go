@@ -0,0 +1,92 @@
+package astrotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNauticalTimezone(t *testing.T) {
+	tests := []struct {
+		lon    float64
+		name   string
+		offset int
+	}{
+		{0, "UTC", 0},
+		{-7.4, "UTC", 0},
+		{-74.0, "UTC-5", -5 * 3600},
+		{139.7, "UTC+9", 9 * 3600},
+		{179.9, "UTC+12", 12 * 3600},
+		{-179.9, "UTC-12", -12 * 3600},
+		{-172.6, "UTC-12", -12 * 3600},
+	}
+	for _, tt := range tests {
+		loc, err := NauticalTimezone().Timezone(10, tt.lon)
+		if err != nil {
+			t.Fatal(err)
+		}
+		name, offset := time.Date(2024, 1, 1, 0, 0, 0, 0, loc).Zone()
+		if name != tt.name || offset != tt.offset {
+			t.Errorf("%v: got %s %d, want %s %d", tt.lon, name, offset, tt.name, tt.offset)
+		}
+	}
+	if _, err := NauticalTimezone().Timezone(91, 0); !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("got %v, want ErrInvalidCoordinates", err)
+	}
+}
+
+func TestTimezoneNameFunc(t *testing.T) {
+	r := TimezoneNameFunc(func(lat, lon float64) string {
+		switch {
+		case lat > 0:
+			return "Europe/Oslo"
+		case lat < 0:
+			return "Nowhere/Special"
+		}
+		return ""
+	})
+	loc, err := r.Timezone(59.9, 10.7)
+	if err != nil {
+		t.Skip(err)
+	}
+	if loc.String() != "Europe/Oslo" {
+		t.Errorf("got %v, want Europe/Oslo", loc)
+	}
+	for _, lat := range []float64{0, -10} {
+		if _, err := r.Timezone(lat, 0); !errors.Is(err, ErrNoTimezone) {
+			t.Errorf("%v: got %v, want ErrNoTimezone", lat, err)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip(err)
+	}
+	r := TimezoneFunc(func(lat, lon float64) (*time.Location, error) {
+		return tokyo, nil
+	})
+	o, err := NewObserver(35.68, 139.69).Resolve(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A time given in UTC still finds the sunrise of the day in Tokyo.
+	rise, err := o.Sunrise(time.Date(2024, 6, 20, 20, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := rise.Format("2006-01-02 15:04 MST"), "2024-06-21 04:25 JST"; got != want {
+		t.Errorf("got sunrise %s, want %s", got, want)
+	}
+
+	fail := TimezoneFunc(func(lat, lon float64) (*time.Location, error) {
+		return nil, ErrNoTimezone
+	})
+	if got, err := o.Resolve(fail); !errors.Is(err, ErrNoTimezone) || got.Location != tokyo {
+		t.Errorf("got %v, %v, want the observer unchanged and ErrNoTimezone", got.Location, err)
+	}
+	if _, err := (Observer{Lat: 100}).Resolve(r); !errors.Is(err, ErrInvalidCoordinates) {
+		t.Errorf("got %v, want ErrInvalidCoordinates", err)
+	}
+}
@@ -0,0 +1,131 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// positionError is the error, in degrees, of the sun's position by each
+// algorithm near J2000.
+var positionError = [...]float64{
+	AlgorithmNOAA:   0.01,
+	AlgorithmVSOP87: 1.0 / 3600,
+}
+
+// maxUncertainty bounds Uncertainty where the sun only grazes the altitude
+// of an event.
+const maxUncertainty = 12 * time.Hour
+
+// Uncertainty estimates how far the time EventTime calculates for the event
+// kind on the day t may be from the true time for the observer's model of
+// the horizon. It combines
+//
+//   - the error of the observer's Algorithm in the sun's position, growing
+//     with the square of the distance from J2000;
+//   - for AlgorithmNOAA, the error from sampling the sun's position at the
+//     time of day of t rather than at the event, or at solar noon in the
+//     fast mode, and from ignoring ΔT unless WithDeltaT is set;
+//   - beyond the years 1600 to 2030, the uncertainty of ΔT itself;
+//   - the precision results are truncated to;
+//
+// and divides errors in altitude by how fast the sun climbs or sinks at the
+// event, so that the estimate grows near the polar circles, where the sun
+// meets the horizon at a grazing angle, up to half a day. It covers the
+// calculation, not the atmosphere: the refraction near a real horizon
+// varies enough to move sunrise and sunset by a minute or more.
+func (o Observer) Uncertainty(t time.Time, kind EventKind) (time.Duration, error) {
+	s, err := o.EventTime(t, kind)
+	if err != nil {
+		return 0, err
+	}
+	local := o.local(t)
+	jd := julianDate(s.UTC())
+	tc := julianCentury(jd)
+	dec := solarDeclination(tc)
+
+	// Error in the sun's position, in degrees.
+	pos := positionError[AlgorithmNOAA]
+	if o.algorithm == AlgorithmVSOP87 {
+		pos = positionError[AlgorithmVSOP87]
+	}
+	pos *= 1 + tc*tc/100
+	pos += deltaTError(decimalYear(jd)) / 86400 * 360 / 365.25
+
+	// Errors in the equation of time, in seconds, and in declination, in
+	// degrees, from sampling the sun at the wrong instant.
+	var eqTime, declination float64
+	if o.algorithm == AlgorithmNOAA {
+		day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+		offset := jd - julianDate(day)
+		switch {
+		case kind == EventSolarNoon:
+			offset -= 0.5
+		case o.refinement().passes == 1:
+			// The fast mode stops at the position at solar noon.
+			offset = solNoonUTC(julianCentury(o.julianDate(local)), o.Lon) / 1440
+		}
+		sampled := julianCentury(o.julianDate(local) + offset)
+		truth := julianCentury(o.ttFromUT(jd))
+		eqTime = 60 * (equationOfTime(sampled) - equationOfTime(truth))
+		declination = solarDeclination(sampled) - solarDeclination(truth)
+	}
+
+	// The sun's hour angle runs at 240 seconds a degree. The sampling
+	// errors are biases rather than random, so they add in full.
+	secs := 240 * pos
+	bias := math.Abs(eqTime)
+	if kind != EventSolarNoon {
+		rate := o.altitudeRate(dec, o.eventZenith(kind))
+		if rate == 0 {
+			return maxUncertainty, nil
+		}
+		secs = math.Hypot(secs, pos/rate)
+		bias += math.Abs(declination) / rate
+	}
+	secs += bias
+	precision := o.precision
+	if precision <= 0 {
+		precision = time.Second
+	}
+	u := time.Duration(secs*float64(time.Second)) + precision
+	return min(u, maxUncertainty), nil
+}
+
+// eventZenith returns the zenith angle of the sun's centre at the event
+// kind, which is not solar noon.
+func (o Observer) eventZenith(kind EventKind) float64 {
+	switch kind {
+	case EventAstronomicalDawn, EventAstronomicalDusk:
+		return zenithAstronomical
+	case EventNauticalDawn, EventNauticalDusk:
+		return zenithNautical
+	case EventCivilDawn, EventCivilDusk:
+		return zenithCivil
+	}
+	return o.zenith()
+}
+
+// altitudeRate returns how fast, in degrees a second, the altitude of the
+// sun at the declination changes as its centre crosses the zenith angle.
+func (o Observer) altitudeRate(dec, zenith float64) float64 {
+	phi, d := degToRad*o.Lat, degToRad*dec
+	cosH := (math.Cos(degToRad*zenith) - math.Sin(phi)*math.Sin(d)) / (math.Cos(phi) * math.Cos(d))
+	if math.Abs(cosH) >= 1 {
+		return 0
+	}
+	sinH := math.Sqrt(1 - cosH*cosH)
+	// dh/dH = cos φ cos δ sin H / cos h, with H turning 15° an hour.
+	return 15.0 / 3600 * math.Cos(phi) * math.Cos(d) * sinH / math.Sin(degToRad*zenith)
+}
+
+// deltaTError estimates the uncertainty of ΔT, in seconds, for the decimal
+// year y, after the parabola fitted by Morrison and Stephenson (2004) to
+// the spread of the historical record; within 1600 to 2030 it is taken as
+// negligible.
+func deltaTError(y float64) float64 {
+	if y >= 1600 && y <= 2030 {
+		return 0
+	}
+	u := (y - 1820) / 100
+	return 0.8 * u * u
+}
@@ -0,0 +1,74 @@
+package astrotime
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUncertaintyCoversNOAA(t *testing.T) {
+	for n, place := range places {
+		v := NewObserver(place.lat, place.lon, WithAlgorithm(AlgorithmVSOP87))
+		for _, fast := range []bool{false, true} {
+			o := NewObserver(place.lat, place.lon, WithFastMode(fast))
+			for _, d := range place.times {
+				for _, kind := range []EventKind{EventSunrise, EventSolarNoon, EventSunset} {
+					got, err := o.EventTime(d.day, kind)
+					if err != nil {
+						t.Fatal(err)
+					}
+					want, err := v.EventTime(d.day, kind)
+					if err != nil {
+						t.Fatal(err)
+					}
+					u, err := o.Uncertainty(d.day, kind)
+					if err != nil {
+						t.Fatal(err)
+					}
+					// Allow for the truncation of both results to the second.
+					if diff := got.Sub(want).Abs(); diff > u+time.Second {
+						t.Errorf("%s %v on %v, fast %v: off by %v, estimated ±%v", n, kind, d.day, fast, diff, u)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestUncertainty(t *testing.T) {
+	day := p("2017-06-10T12:00:00Z")
+	v, err := NewObserver(14.6, 121, WithAlgorithm(AlgorithmVSOP87)).Uncertainty(day, EventSunrise)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v > 3*time.Second {
+		t.Errorf("got ±%v for VSOP87 in the tropics, want a couple of seconds", v)
+	}
+	o, err := NewObserver(14.6, 121).Uncertainty(day, EventSunrise)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o <= v {
+		t.Errorf("got ±%v for NOAA, want more than ±%v for VSOP87", o, v)
+	}
+	// Near the Arctic Circle the sun skims the horizon at sunrise.
+	arctic, err := NewObserver(66, 0).Uncertainty(day, EventSunrise)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if arctic < 3*o {
+		t.Errorf("got ±%v at 66°N, want well over ±%v at 14.6°N", arctic, o)
+	}
+	if _, err := NewObserver(66, 0).Uncertainty(p("2017-06-20T12:00:00Z"), EventSunrise); !errors.Is(err, ErrAlwaysAbove) {
+		t.Errorf("got %v in the midnight sun, want ErrAlwaysAbove", err)
+	}
+}
+
+func TestDeltaTError(t *testing.T) {
+	if got := deltaTError(2000); got != 0 {
+		t.Errorf("got ±%vs in 2000, want 0", got)
+	}
+	if got := deltaTError(-500); got < 400 || got > 500 {
+		t.Errorf("got ±%.0fs in 500 BC, want about 450", got)
+	}
+}
@@ -0,0 +1,21 @@
+package astrotime
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEarthRadiusVector(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 25.b: 1992 October 13.0 TD.
+	if got := earthRadiusVector(2448908.5); math.Abs(got-0.99760775) > 1e-7 {
+		t.Errorf("got %.8f AU, want 0.99760775", got)
+	}
+}
+
+func TestEarthHeliocentric(t *testing.T) {
+	// Meeus example 25.b: L = 19.907372°, B = −0.000179°.
+	lon, lat, r := earthHeliocentric(2448908.5)
+	if math.Abs(lon-19.907372) > 1e-6 || math.Abs(lat+0.000179) > 1e-6 || math.Abs(r-0.99760775) > 1e-7 {
+		t.Errorf("got %.6f°, %.6f°, %.8f AU, want 19.907372°, -0.000179°, 0.99760775 AU", lon, lat, r)
+	}
+}
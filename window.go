@@ -0,0 +1,75 @@
+package astrotime
+
+import (
+	"math"
+	"time"
+)
+
+// Window is a window or facade that direct sun shines into from a range of
+// directions about the one it faces.
+type Window struct {
+	// Facing is the azimuth the window faces, in degrees clockwise from
+	// north.
+	Facing float64
+
+	// Width is how far to either side of Facing, in degrees, the sun can
+	// stand and still shine in. Zero means 90°, the whole sky in front of
+	// a wall; deep reveals narrow it.
+	Width float64
+
+	// MinAltitude and MaxAltitude bound the altitudes, in degrees, of the
+	// sun that shines in: a MinAltitude for the buildings opposite, a
+	// MaxAltitude for an overhang or balcony above. A MaxAltitude of zero
+	// means 90°.
+	MinAltitude, MaxAltitude float64
+}
+
+// admits reports whether sun at the azimuth and altitude shines in through
+// the window.
+func (w Window) admits(azimuth, altitude float64) bool {
+	width, high := w.Width, w.MaxAltitude
+	if width == 0 {
+		width = 90
+	}
+	if high == 0 {
+		high = 90
+	}
+	off := math.Abs(math.Mod(azimuth-w.Facing+540, 360) - 180)
+	return off < width && altitude >= w.MinAltitude && altitude <= high
+}
+
+// WindowSun returns the intervals of the calendar day of t during which
+// direct sun shines in through the window, clear of the obstructions, as
+// DirectSun finds them.
+func (o Observer) WindowSun(t time.Time, w Window, obstructions ...Obstruction) ([]Interval, error) {
+	return o.sunlit(t, func(azimuth, altitude float64) bool {
+		if !w.admits(azimuth, altitude) {
+			return false
+		}
+		for _, ob := range obstructions {
+			if ob.hides(azimuth, altitude) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// WindowSunRange returns the intervals of direct sun through the window on
+// each day from the day of start to the day of end, inclusive, one slice
+// per day as SunTimesRange gives SunTimes, and the total time the sun
+// shines in over the range.
+func (o Observer) WindowSunRange(start, end time.Time, w Window, obstructions ...Obstruction) (days [][]Interval, total time.Duration, err error) {
+	err = o.eachDay(start, end, func(d sunDay) {
+		// The days between valid dates are valid.
+		day, _ := o.WindowSun(d.t, w, obstructions...)
+		for _, iv := range day {
+			total += iv.Duration()
+		}
+		days = append(days, day)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return days, total, nil
+}
@@ -0,0 +1,70 @@
+package astrotime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWindowSun(t *testing.T) {
+	o := NewObserver(51.4769, -0.0005)
+	day := p("2024-03-20T12:00:00Z")
+	for _, test := range []struct {
+		w            Window
+		obstructions []Obstruction
+		want         []string
+	}{
+		// At the equinox the sun rises and sets nearly due east and west,
+		// so a south window gets it all day.
+		{Window{Facing: 180}, nil, []string{"2024-03-20T06:07:31Z", "2024-03-20T18:06:26Z"}},
+		{Window{Facing: 90}, nil, []string{"2024-03-20T06:07:07Z", "2024-03-20T12:07:17Z"}},
+		{Window{Facing: 270, Width: 45}, nil, []string{"2024-03-20T14:39:02Z", "2024-03-20T18:08:28Z"}},
+		// A balcony above shades it from the midday sun.
+		{Window{Facing: 180, MaxAltitude: 30}, nil, []string{"2024-03-20T06:07:31Z", "2024-03-20T09:40:00Z", "2024-03-20T14:35:15Z", "2024-03-20T18:06:26Z"}},
+		{Window{Facing: 180}, []Obstruction{{From: 170, To: 190, Altitude: 50}}, []string{"2024-03-20T06:07:31Z", "2024-03-20T11:35:56Z", "2024-03-20T12:38:38Z", "2024-03-20T18:06:26Z"}},
+		{Window{Facing: 0}, nil, nil},
+	} {
+		got, err := o.WindowSun(day, test.w, test.obstructions...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(test.want)/2 {
+			t.Errorf("%+v: got %v, want %v", test.w, got, test.want)
+			continue
+		}
+		for i, iv := range got {
+			if !iv.Start.Equal(p(test.want[2*i])) || !iv.End.Equal(p(test.want[2*i+1])) {
+				t.Errorf("%+v: got %v, want %v", test.w, got, test.want)
+			}
+		}
+	}
+}
+
+func TestWindowSunRange(t *testing.T) {
+	o := NewObserver(51.4769, -0.0005)
+	start, end := p("2024-06-01T00:00:00Z"), p("2024-06-30T00:00:00Z")
+	// A north window gets early morning and late evening sun in summer.
+	w := Window{Facing: 0}
+	days, total, err := o.WindowSunRange(start, end, w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(days) != 30 {
+		t.Fatalf("got %d days, want 30", len(days))
+	}
+	var sum time.Duration
+	for i, got := range days {
+		want, _ := o.WindowSun(start.AddDate(0, 0, i), w)
+		if len(got) != 2 || len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+			t.Errorf("day %d: got %v, want %v", i, got, want)
+		}
+		for _, iv := range got {
+			sum += iv.Duration()
+		}
+	}
+	if want := 209*time.Hour + 2*time.Minute + 42*time.Second; total != want || sum != want {
+		t.Errorf("got total %s, summing to %s, want %s", total, sum, want)
+	}
+	if _, _, err := NewObserver(91, 0).WindowSunRange(start, end, w); err == nil {
+		t.Error("got no error for an invalid latitude")
+	}
+}
@@ -0,0 +1,213 @@
+// Package worldmap renders maps of the earth shaded by day, twilight and
+// night at an instant, as dashboards show them, in the equirectangular
+// projection: longitude from −180° at the left edge to 180° at the right,
+// latitude from 90° at the top to −90° at the bottom, the map twice as wide
+// as it is high.
+//
+//	m := worldmap.Map{Time: time.Now(), Width: 1024}
+//	err := m.WritePNG(w)
+//
+// The shading follows the sun's altitude at each point, found from the
+// subsolar point as astrotime.Terminator finds the terminator. Maps carry
+// no coastlines; give a Background, an equirectangular image of the
+// earth, to shade over.
+package worldmap
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+const degToRad = math.Pi / 180
+
+// DefaultWidth is the width of a Map in pixels by default.
+const DefaultWidth = 720
+
+// The shades of a map by default, laid over the background.
+var (
+	// DefaultShades darken the background progressively through the
+	// twilights into night, leaving the day clear.
+	DefaultShades = [...]color.Color{
+		astrotime.Night:                color.NRGBA{0, 0, 32, 0xb8},
+		astrotime.AstronomicalTwilight: color.NRGBA{0, 0, 32, 0x98},
+		astrotime.NauticalTwilight:     color.NRGBA{0, 0, 32, 0x70},
+		astrotime.CivilTwilight:        color.NRGBA{0, 0, 32, 0x40},
+		astrotime.Day:                  color.NRGBA{},
+	}
+
+	// DefaultBackground is the colour of a map without a Background.
+	DefaultBackground color.Color = color.RGBA{0x8c, 0xb8, 0xe0, 0xff}
+)
+
+// Map is a map of the earth shaded by the twilight phase at each place at
+// an instant.
+type Map struct {
+	Time time.Time
+
+	// Width is the width of the map in pixels, or DefaultWidth if zero. It
+	// is half as high.
+	Width int
+
+	// Background, if set, is drawn under the shading, scaled to the map.
+	// It should be equirectangular and span the whole earth.
+	Background image.Image
+
+	// Shades are the colours laid over the background in each twilight
+	// phase, indexed by it, or DefaultShades if all are nil.
+	Shades [5]color.Color
+}
+
+// size returns the width and height of the map.
+func (m Map) size() (width, height int) {
+	width = m.Width
+	if width <= 0 {
+		width = DefaultWidth
+	}
+	return width, max(1, width/2)
+}
+
+// shades returns the colours of the map's twilight phases.
+func (m Map) shades() [5]color.Color {
+	for _, c := range m.Shades {
+		if c != nil {
+			return m.Shades
+		}
+	}
+	return DefaultShades
+}
+
+// phaser calculates the twilight phase at any place at the instant t.
+type phaser struct {
+	sinDec, cosDec, lon float64
+}
+
+func newPhaser(t time.Time) phaser {
+	dec, lon := astrotime.SubsolarPoint(t)
+	return phaser{math.Sin(degToRad * dec), math.Cos(degToRad * dec), lon}
+}
+
+// phase returns the twilight phase at the latitude and longitude, by the
+// geometric altitude of the sun's centre, with sunrise and sunset at
+// −0.833° for standard refraction.
+func (p phaser) phase(lat, lon float64) astrotime.TwilightPhase {
+	phi := degToRad * lat
+	sinAlt := math.Sin(phi)*p.sinDec + math.Cos(phi)*p.cosDec*math.Cos(degToRad*(lon-p.lon))
+	alt := math.Asin(math.Max(-1, math.Min(1, sinAlt))) / degToRad
+	switch {
+	case alt > -0.833:
+		return astrotime.Day
+	case alt > -6:
+		return astrotime.CivilTwilight
+	case alt > -12:
+		return astrotime.NauticalTwilight
+	case alt > -18:
+		return astrotime.AstronomicalTwilight
+	}
+	return astrotime.Night
+}
+
+// Phases returns the twilight phase at the centre of each pixel of the
+// map, row by row from the top.
+func (m Map) Phases() [][]astrotime.TwilightPhase {
+	width, height := m.size()
+	p := newPhaser(m.Time)
+	rows := make([][]astrotime.TwilightPhase, height)
+	for y := range rows {
+		rows[y] = make([]astrotime.TwilightPhase, width)
+		lat := 90 - (float64(y)+0.5)*180/float64(height)
+		for x := range rows[y] {
+			rows[y][x] = p.phase(lat, (float64(x)+0.5)*360/float64(width)-180)
+		}
+	}
+	return rows
+}
+
+// Image renders the map.
+func (m Map) Image() *image.RGBA {
+	width, height := m.size()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	if m.Background != nil {
+		b := m.Background.Bounds()
+		for y := 0; y < height; y++ {
+			for x := 0; x < width; x++ {
+				img.Set(x, y, m.Background.At(b.Min.X+x*b.Dx()/width, b.Min.Y+y*b.Dy()/height))
+			}
+		}
+	} else {
+		draw.Draw(img, img.Bounds(), image.NewUniform(DefaultBackground), image.Point{}, draw.Src)
+	}
+	shades := m.shades()
+	var uniforms [5]*image.Uniform
+	for i, c := range shades {
+		if c != nil {
+			uniforms[i] = image.NewUniform(c)
+		}
+	}
+	for y, row := range m.Phases() {
+		for x, phase := range row {
+			if u := uniforms[phase]; u != nil {
+				draw.Draw(img, image.Rect(x, y, x+1, y+1), u, image.Point{}, draw.Over)
+			}
+		}
+	}
+	return img
+}
+
+// WritePNG writes the map to w as a PNG image.
+func (m Map) WritePNG(w io.Writer) error {
+	return png.Encode(w, m.Image())
+}
+
+// WriteSVG writes the map to w as an SVG document: the shading of each
+// row of pixels as rectangles, one for each run of a twilight phase, over
+// a rectangle of the background colour. A Background image is not
+// included; the shades of the phases are classed "night",
+// "astronomical", "nautical", "civil" and "day" for restyling with CSS.
+func (m Map) WriteSVG(w io.Writer) error {
+	width, height := m.size()
+	shades := m.shades()
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+"\n", width, height, width, height)
+	fmt.Fprintf(bw, `<rect width="%d" height="%d" fill="%s"/>`+"\n", width, height, hex(DefaultBackground))
+	for y, row := range m.Phases() {
+		for x := 0; x < width; {
+			phase, run := row[x], 1
+			for x+run < width && row[x+run] == phase {
+				run++
+			}
+			if c := shades[phase]; c != nil {
+				if _, _, _, a := c.RGBA(); a > 0 {
+					fmt.Fprintf(bw, `<rect class="%s" x="%d" y="%d" width="%d" height="1" fill="%s" fill-opacity="%.3f"/>`+"\n",
+						classes[phase], x, y, run, hex(c), float64(a)/0xffff)
+				}
+			}
+			x += run
+		}
+	}
+	bw.WriteString("</svg>\n")
+	return bw.Flush()
+}
+
+// classes are the SVG classes of the twilight phases.
+var classes = [...]string{
+	astrotime.Night:                "night",
+	astrotime.AstronomicalTwilight: "astronomical",
+	astrotime.NauticalTwilight:     "nautical",
+	astrotime.CivilTwilight:        "civil",
+	astrotime.Day:                  "day",
+}
+
+// hex formats the colour, without its alpha, as #rrggbb.
+func hex(c color.Color) string {
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	return fmt.Sprintf("#%02x%02x%02x", n.R, n.G, n.B)
+}
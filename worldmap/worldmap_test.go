@@ -0,0 +1,103 @@
+package worldmap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+var equinox = time.Date(2024, 3, 20, 12, 0, 0, 0, time.UTC)
+
+func TestPhases(t *testing.T) {
+	m := Map{Time: equinox, Width: 360}
+	rows := m.Phases()
+	if len(rows) != 180 || len(rows[0]) != 360 {
+		t.Fatalf("got %d×%d phases, want 360×180", len(rows[0]), len(rows))
+	}
+	lat, lon := astrotime.SubsolarPoint(equinox)
+	at := func(lat, lon float64) astrotime.TwilightPhase {
+		return rows[int(math.Floor(90-lat))][(int(lon+180)+360)%360]
+	}
+	tests := []struct {
+		name     string
+		lat, lon float64
+		want     astrotime.TwilightPhase
+	}{
+		{"subsolar", lat, lon, astrotime.Day},
+		{"antipode", -lat, lon + 180, astrotime.Night},
+		{"dusk", 0, lon + 93, astrotime.CivilTwilight},
+		{"dawn", 0, lon - 99, astrotime.NauticalTwilight},
+		{"night", 0, lon - 105, astrotime.AstronomicalTwilight},
+	}
+	for _, tt := range tests {
+		if got := at(tt.lat, tt.lon); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestImage(t *testing.T) {
+	bg := image.NewUniform(color.RGBA{0xff, 0xff, 0xff, 0xff})
+	m := Map{Time: equinox, Width: 100, Background: bg}
+	img := m.Image()
+	if got := img.Bounds(); got != image.Rect(0, 0, 100, 50) {
+		t.Fatalf("got bounds %v, want %v", got, image.Rect(0, 0, 100, 50))
+	}
+	// Noon at Greenwich is clear, midnight on the date line shaded.
+	if got := img.RGBAAt(50, 25); got != (color.RGBA{0xff, 0xff, 0xff, 0xff}) {
+		t.Errorf("day: got %v, want white", got)
+	}
+	if got := img.RGBAAt(0, 25); got.R > 0x50 || got.B < got.R {
+		t.Errorf("night: got %v, want dark blue", got)
+	}
+}
+
+func TestWritePNG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (Map{Time: equinox, Width: 64}).WritePNG(&buf); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := png.DecodeConfig(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Width != 64 || cfg.Height != 32 {
+		t.Errorf("got %d×%d, want 64×32", cfg.Width, cfg.Height)
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (Map{Time: equinox}).WriteSVG(&buf); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, `width="720" height="360"`) {
+		t.Errorf("missing default size in %.100q", out)
+	}
+	for _, class := range []string{"night", "astronomical", "nautical", "civil"} {
+		if !strings.Contains(out, `class="`+class+`"`) {
+			t.Errorf("no %s shading", class)
+		}
+	}
+	if strings.Contains(out, `class="day"`) {
+		t.Error("day shaded with the default shades")
+	}
+	d := xml.NewDecoder(&buf)
+	for {
+		if _, err := d.Token(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("malformed SVG: %v", err)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package zmanim
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// Defaults of Shabbos.
+const (
+	DefaultCandleLighting = 18 * time.Minute
+	DefaultHavdalahAngle  = 8.5
+)
+
+// Shabbos configures the times of candle lighting, before sunset on the
+// eve of Shabbos or a festival, and of havdalah, at nightfall when it ends.
+type Shabbos struct {
+	// CandleOffset is how long before sunset the candles are lit,
+	// DefaultCandleLighting if zero; Jerusalem customarily uses 40
+	// minutes.
+	CandleOffset time.Duration
+
+	// HavdalahAngle is the depression of the sun at havdalah, in degrees,
+	// DefaultHavdalahAngle if zero.
+	HavdalahAngle float64
+
+	// HavdalahOffset, if positive, sets havdalah this long after sunset,
+	// such as 42 or 72 minutes, instead of by HavdalahAngle.
+	HavdalahOffset time.Duration
+}
+
+// CandleLighting calculates the time of candle lighting seen by o on the
+// day t.
+func (s Shabbos) CandleLighting(o astrotime.Observer, t time.Time) (time.Time, error) {
+	sunset, err := o.Sunset(t)
+	if err != nil {
+		return time.Time{}, err
+	}
+	before := s.CandleOffset
+	if before == 0 {
+		before = DefaultCandleLighting
+	}
+	return sunset.Add(-before), nil
+}
+
+// Havdalah calculates the time of havdalah seen by o on the day t.
+func (s Shabbos) Havdalah(o astrotime.Observer, t time.Time) (time.Time, error) {
+	if s.HavdalahOffset > 0 {
+		sunset, err := o.Sunset(t)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return sunset.Add(s.HavdalahOffset), nil
+	}
+	angle := s.HavdalahAngle
+	if angle == 0 {
+		angle = DefaultHavdalahAngle
+	}
+	return o.AltitudeSetting(t, -angle)
+}
+
+// Lighting is a candle lighting and the havdalah at the end of the next
+// day.
+type Lighting struct {
+	// Date is midnight at the start of the day the candles are lit, in the
+	// observer's time zone.
+	Date time.Time
+
+	CandleLighting, Havdalah time.Time
+
+	// Err is the error for the candle lighting, or failing that the
+	// havdalah, which is then the zero Time: ErrAlwaysAbove or
+	// ErrAlwaysBelow near the poles.
+	Err error
+}
+
+// Schedule calculates the candle lightings seen by o on each Friday from
+// the day of start to the day of end, inclusive, and on each of eves, the
+// eves of festivals, which the package leaves to callers since it has no
+// Hebrew calendar, in date order. A date given twice, as a festival
+// beginning on Friday night, has one lighting. A festival of two days has
+// its candle lighting on the second evening too, which the caller gives
+// as an eve; havdalah is always that of the next day.
+func (s Shabbos) Schedule(o astrotime.Observer, start, end time.Time, eves ...time.Time) []Lighting {
+	loc := o.Location
+	if loc == nil {
+		loc = start.Location()
+	}
+	day := func(t time.Time) time.Time {
+		t = t.In(loc)
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	}
+	first, last := day(start), day(end)
+
+	dates := map[time.Time]bool{}
+	friday := first.AddDate(0, 0, (int(time.Friday)-int(first.Weekday())+7)%7)
+	for d := friday; !d.After(last); d = d.AddDate(0, 0, 7) {
+		dates[d] = true
+	}
+	for _, e := range eves {
+		if d := day(e); !d.Before(first) && !d.After(last) {
+			dates[d] = true
+		}
+	}
+	sorted := make([]time.Time, 0, len(dates))
+	for d := range dates {
+		sorted = append(sorted, d)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Before(sorted[j]) })
+
+	lightings := make([]Lighting, len(sorted))
+	for i, d := range sorted {
+		l := Lighting{Date: d}
+		var herr error
+		l.CandleLighting, l.Err = s.CandleLighting(o, d)
+		l.Havdalah, herr = s.Havdalah(o, d.AddDate(0, 0, 1))
+		if l.Err == nil {
+			l.Err = herr
+		}
+		lightings[i] = l
+	}
+	return lightings
+}
@@ -0,0 +1,85 @@
+package zmanim
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+func TestCandleLightingHavdalah(t *testing.T) {
+	friday := time.Date(2024, 3, 22, 0, 0, 0, 0, jerusalem.Location)
+	saturday := friday.AddDate(0, 0, 1)
+	sunset, _ := jerusalem.Sunset(friday)
+	satSunset, _ := jerusalem.Sunset(saturday)
+	tzeis, _ := jerusalem.AltitudeSetting(saturday, -8.5)
+
+	for _, tt := range []struct {
+		name              string
+		s                 Shabbos
+		candles, havdalah time.Time
+	}{
+		{"default", Shabbos{}, sunset.Add(-18 * time.Minute), tzeis},
+		{"Jerusalem", Shabbos{CandleOffset: 40 * time.Minute, HavdalahOffset: 72 * time.Minute}, sunset.Add(-40 * time.Minute), satSunset.Add(72 * time.Minute)},
+	} {
+		c, err := tt.s.CandleLighting(jerusalem, friday)
+		if err != nil || !c.Equal(tt.candles) {
+			t.Errorf("%s: got candle lighting %v, %v, want %v", tt.name, c, err, tt.candles)
+		}
+		h, err := tt.s.Havdalah(jerusalem, saturday)
+		if err != nil || !h.Equal(tt.havdalah) {
+			t.Errorf("%s: got havdalah %v, %v, want %v", tt.name, h, err, tt.havdalah)
+		}
+	}
+	if c, _ := (Shabbos{}).CandleLighting(jerusalem, friday); c.Format("15:04") != "17:34" {
+		t.Errorf("got candle lighting %v, want 17:34", c.Format("15:04"))
+	}
+	if h, _ := (Shabbos{HavdalahAngle: 7.083}).Havdalah(jerusalem, saturday); !h.Before(tzeis) {
+		t.Errorf("havdalah at 7.083° %v is not before 8.5° %v", h, tzeis)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	loc := jerusalem.Location
+	start := time.Date(2024, 4, 1, 0, 0, 0, 0, loc)
+	end := time.Date(2024, 4, 30, 23, 0, 0, 0, loc)
+	// Pesach 5784 began on the evening of Monday 22 April; the seventh day
+	// began on Sunday 28 April. The eve of 1 May lies outside the range.
+	eves := []time.Time{
+		time.Date(2024, 4, 22, 0, 0, 0, 0, loc),
+		time.Date(2024, 4, 28, 0, 0, 0, 0, loc),
+		time.Date(2024, 5, 1, 0, 0, 0, 0, loc),
+	}
+	got := (Shabbos{}).Schedule(jerusalem, start, end, eves...)
+	want := []string{"2024-04-05", "2024-04-12", "2024-04-19", "2024-04-22", "2024-04-26", "2024-04-28"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d lightings, want %d", len(got), len(want))
+	}
+	for i, l := range got {
+		if s := l.Date.Format("2006-01-02"); s != want[i] || l.Err != nil {
+			t.Errorf("lighting %d on %s, %v, want %s", i, s, l.Err, want[i])
+		}
+		if !l.Havdalah.After(l.CandleLighting.Add(24*time.Hour)) || l.Havdalah.After(l.CandleLighting.Add(26*time.Hour)) {
+			t.Errorf("%s: havdalah %v does not follow candle lighting %v by a day", want[i], l.Havdalah, l.CandleLighting)
+		}
+	}
+
+	// A festival on Friday night is lit once.
+	if got := (Shabbos{}).Schedule(jerusalem, start, start.AddDate(0, 0, 6), time.Date(2024, 4, 5, 18, 0, 0, 0, loc)); len(got) != 1 {
+		t.Errorf("got %d lightings, want 1", len(got))
+	}
+}
+
+func TestSchedulePolar(t *testing.T) {
+	o := astrotime.Observer{Lat: 69.65, Lon: 18.96, Location: time.UTC}
+	got := (Shabbos{}).Schedule(o, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC))
+	if len(got) != 4 {
+		t.Fatalf("got %d lightings, want 4", len(got))
+	}
+	for _, l := range got {
+		if !errors.Is(l.Err, astrotime.ErrAlwaysAbove) || !l.CandleLighting.IsZero() {
+			t.Errorf("%v: got %v, %v, want ErrAlwaysAbove in the midnight sun", l.Date, l.CandleLighting, l.Err)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Package zmanim calculates the times of day of Jewish law: dawn, the
+// earliest time for tallis and tefillin, the latest times for the morning
+// Shema and prayer, midday, the times for Mincha, plag hamincha and
+// nightfall.
+//
+// Dawn, misheyakir and nightfall are reckoned by depressions of the sun
+// below the horizon. The other times are reckoned in proportional hours
+// (shaos zmaniyos), each a twelfth of the day: from sunrise to sunset in
+// the reckoning of the Vilna Gaon (GRA), or between two depressions of the
+// sun, such as from dawn to nightfall at 16.1° in that of the Magen
+// Avraham. Authorities differ on every angle, so each can be set.
+//
+// Shabbos gives the times of candle lighting and havdalah, for a day or
+// for every Friday and festival eve over a range of days.
+package zmanim
+
+import (
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+// Config sets the angles the zmanim are reckoned by, in degrees below the
+// horizon.
+type Config struct {
+	// Alos is the depression of the sun at dawn, alos hashachar.
+	Alos float64
+
+	// Misheyakir is the depression of the sun at misheyakir, the earliest
+	// time for tallis and tefillin.
+	Misheyakir float64
+
+	// Tzeis is the depression of the sun at nightfall, tzeis hakochavim.
+	Tzeis float64
+
+	// Day is the depression of the sun at the start and end of the day the
+	// proportional hours divide: zero for sunrise and sunset, when the
+	// observer's refraction and elevation apply.
+	Day float64
+}
+
+// Common configurations.
+var (
+	// GRA reckons the hours from sunrise to sunset, with dawn at 16.1°,
+	// misheyakir at 11.5° and nightfall at 8.5°.
+	GRA = Config{Alos: 16.1, Misheyakir: 11.5, Tzeis: 8.5}
+
+	// MagenAvraham reckons the hours from dawn to nightfall at 16.1°.
+	MagenAvraham = Config{Alos: 16.1, Misheyakir: 11.5, Tzeis: 8.5, Day: 16.1}
+)
+
+// Zmanim are the zmanim of a day.
+type Zmanim struct {
+	Alos           time.Time // dawn
+	Misheyakir     time.Time // earliest tallis and tefillin
+	Sunrise        time.Time
+	SofZmanShema   time.Time // latest Shema, after 3 hours
+	SofZmanTefilla time.Time // latest Shacharis, after 4 hours
+	Chatzos        time.Time // midday, after 6 hours
+	MinchaGedola   time.Time // earliest Mincha, after 6½ hours
+	MinchaKetana   time.Time // after 9½ hours
+	PlagHamincha   time.Time // after 10¾ hours
+	Sunset         time.Time
+	Tzeis          time.Time // nightfall
+
+	// Hour is the length of a proportional hour.
+	Hour time.Duration
+}
+
+// Calculate calculates the zmanim seen by o on the day t by the config.
+// Times which do not happen that day, as dawn in the summer at high
+// latitudes, are zero, and so are those reckoned in hours if the day the
+// hours divide does not begin or end; the error is that of the first
+// missing time, ErrAlwaysAbove or ErrAlwaysBelow.
+func Calculate(o astrotime.Observer, t time.Time, c Config) (Zmanim, error) {
+	var (
+		z     Zmanim
+		first error
+	)
+	keep := func(field *time.Time, s time.Time, err error) {
+		if err != nil {
+			if first == nil {
+				first = err
+			}
+			return
+		}
+		*field = s
+	}
+
+	s, err := o.AltitudeRising(t, -c.Alos)
+	keep(&z.Alos, s, err)
+	s, err = o.AltitudeRising(t, -c.Misheyakir)
+	keep(&z.Misheyakir, s, err)
+	s, err = o.Sunrise(t)
+	keep(&z.Sunrise, s, err)
+	s, err = o.Sunset(t)
+	keep(&z.Sunset, s, err)
+	s, err = o.AltitudeSetting(t, -c.Tzeis)
+	keep(&z.Tzeis, s, err)
+
+	start, end := z.Sunrise, z.Sunset
+	if c.Day != 0 {
+		start, err = o.AltitudeRising(t, -c.Day)
+		keep(&start, start, err)
+		end, err = o.AltitudeSetting(t, -c.Day)
+		keep(&end, end, err)
+	}
+	if start.IsZero() || end.IsZero() {
+		return z, first
+	}
+	z.Hour = end.Sub(start) / 12
+	at := func(hours float64) time.Time {
+		return start.Add(time.Duration(hours * float64(z.Hour))).Truncate(time.Second)
+	}
+	z.SofZmanShema = at(3)
+	z.SofZmanTefilla = at(4)
+	z.Chatzos = at(6)
+	z.MinchaGedola = at(6.5)
+	z.MinchaKetana = at(9.5)
+	z.PlagHamincha = at(10.75)
+	return z, first
+}
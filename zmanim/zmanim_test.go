@@ -0,0 +1,89 @@
+package zmanim
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dntj/astrotime"
+)
+
+var jerusalem = astrotime.Observer{Lat: 31.7767, Lon: 35.2345, Location: time.FixedZone("IST", 2*3600)}
+
+func TestCalculateGRA(t *testing.T) {
+	day := time.Date(2024, 3, 20, 0, 0, 0, 0, jerusalem.Location)
+	z, err := Calculate(jerusalem, day, GRA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name string
+		got  time.Time
+		want string
+	}{
+		{"Alos", z.Alos, "04:30"},
+		{"Misheyakir", z.Misheyakir, "04:52"},
+		{"Sunrise", z.Sunrise, "05:42"},
+		{"SofZmanShema", z.SofZmanShema, "08:44"},
+		{"SofZmanTefilla", z.SofZmanTefilla, "09:45"},
+		{"Chatzos", z.Chatzos, "11:46"},
+		{"MinchaGedola", z.MinchaGedola, "12:16"},
+		{"MinchaKetana", z.MinchaKetana, "15:19"},
+		{"PlagHamincha", z.PlagHamincha, "16:34"},
+		{"Sunset", z.Sunset, "17:50"},
+		{"Tzeis", z.Tzeis, "18:26"},
+	} {
+		if s := tt.got.Format("15:04"); s != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, s, tt.want)
+		}
+	}
+
+	// The hours divide sunrise to sunset into twelve, and Chatzos is close
+	// to solar noon.
+	if d := z.Sunset.Sub(z.Sunrise) - 12*z.Hour; d < 0 || d > 12*time.Nanosecond {
+		t.Errorf("12 hours of %v leave %v of the day", z.Hour, d)
+	}
+	noon, _ := jerusalem.EventTime(day, astrotime.EventSolarNoon)
+	if d := z.Chatzos.Sub(noon); d < -time.Minute || d > time.Minute {
+		t.Errorf("Chatzos %v is %v from solar noon", z.Chatzos, d)
+	}
+}
+
+func TestCalculateMagenAvraham(t *testing.T) {
+	day := time.Date(2024, 3, 20, 0, 0, 0, 0, jerusalem.Location)
+	gra, _ := Calculate(jerusalem, day, GRA)
+	mga, err := Calculate(jerusalem, day, MagenAvraham)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mga.SofZmanShema.Before(gra.SofZmanShema) {
+		t.Errorf("Magen Avraham Shema %v is not before the GRA's %v", mga.SofZmanShema, gra.SofZmanShema)
+	}
+	if want := mga.Alos.Add(3 * mga.Hour).Truncate(time.Second); !mga.SofZmanShema.Equal(want) {
+		t.Errorf("got Shema %v, want %v, three hours after dawn", mga.SofZmanShema, want)
+	}
+	if mga.Hour <= gra.Hour {
+		t.Errorf("got hour %v, want longer than the GRA's %v", mga.Hour, gra.Hour)
+	}
+}
+
+func TestCalculateHighLatitude(t *testing.T) {
+	o := astrotime.Observer{Lat: 55.7558, Lon: 37.6173}
+	day := time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC)
+
+	z, err := Calculate(o, day, GRA)
+	if !errors.Is(err, astrotime.ErrAlwaysAbove) {
+		t.Errorf("got %v, want ErrAlwaysAbove", err)
+	}
+	if !z.Alos.IsZero() || z.Sunrise.IsZero() || z.PlagHamincha.IsZero() || z.Tzeis.IsZero() {
+		t.Errorf("got %+v, want all but dawn", z)
+	}
+
+	z, err = Calculate(o, day, MagenAvraham)
+	if !errors.Is(err, astrotime.ErrAlwaysAbove) {
+		t.Errorf("got %v, want ErrAlwaysAbove", err)
+	}
+	if !z.SofZmanShema.IsZero() || z.Hour != 0 {
+		t.Errorf("got Shema %v, hour %v, want none without dawn", z.SofZmanShema, z.Hour)
+	}
+}